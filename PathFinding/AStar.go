@@ -1,20 +1,19 @@
 package PathFinding
 
 import (
+	"container/heap"
+	"context"
+	. "github.com/edouard127/mc-go-1.12.2/data/World"
 	. "github.com/edouard127/mc-go-1.12.2/maths"
 	. "github.com/edouard127/mc-go-1.12.2/struct"
-	"math"
+	"time"
 )
 
 type AStar struct {
 	// Start is the start node
 	Start *Node
-	// End is the end node
-	End *Node
-	// OpenList is the list of nodes to be evaluated
-	OpenList []*Node
-	// ClosedList is the list of nodes already evaluated
-	ClosedList []*Node
+	// Goal is where the path ends
+	Goal Goal
 	// Path is the path from start to end
 	Path *Path
 	// PathFound is true if a path is found
@@ -23,116 +22,201 @@ type AStar struct {
 	MaxNodes int
 	// NodesEvaluated is the number of nodes evaluated
 	NodesEvaluated int
+	// MaxFallDamage is the damage the player can take when it drops, in half hearts.
+	// With 0, it never falls more than SafeFall blocks, except in water.
+	MaxFallDamage float64
+	// Actions allow the path to break and place blocks, nil to go only through the world as it is
+	Actions *Actions
+	// AllowPartial makes Compute return the path to the node the closest to the goal when the goal isn't reached,
+	// because of MaxNodes, of the context or of the unloaded chunks
+	AllowPartial bool
+	// Partial is true if Path goes toward the goal without reaching it
+	Partial bool
+	// Elapsed is the time spent computing the path
+	Elapsed time.Duration
+	// Err is the error of the context if it stopped the search
+	Err error
+
+	open   nodeHeap          // the nodes to be evaluated, the cheapest first
+	nodes  map[Vector3]*Node // every node reached, by position
+	closed map[Vector3]bool  // the nodes already evaluated
+	best   *Node             // the evaluated node the closest to the goal
 }
 
-// Compute finds the best path from start to end in Minecraft world using A* algorithm
+// Compute finds the fastest path from start to the goal in Minecraft world using A* algorithm.
+// The nodes are the positions of the player's feet, and the costs are in ticks.
 func Compute(IStar *AStar, g *Game) *AStar {
-	for len(IStar.OpenList) > 0 {
+	return ComputeContext(context.Background(), IStar, g)
+}
+
+// ComputeContext is Compute stopping when ctx is done, with IStar.Err set to ctx.Err()
+func ComputeContext(ctx context.Context, IStar *AStar, g *Game) *AStar {
+	defer func(start time.Time) { IStar.Elapsed += time.Since(start) }(time.Now())
+	w := &g.World
+	for IStar.open.Len() > 0 {
+		// Check the context from time to time, it's slower than a node
+		if IStar.NodesEvaluated%64 == 0 && ctx.Err() != nil {
+			IStar.Err = ctx.Err()
+			break
+		}
 		// Get the node with the lowest cost
-		var currentNode *Node
-		var currentNodeIndex int
-		for i, node := range IStar.OpenList {
-			if currentNode == nil || node.Cost < currentNode.Cost {
-				currentNode = node
-				currentNodeIndex = i
-			}
+		currentNode := heap.Pop(&IStar.open).(*Node)
+		IStar.closed[currentNode.Position] = true
+		// Check if the node is an end
+		if IStar.Goal.IsEnd(currentNode.Position) {
+			IStar.PathFound, IStar.Partial = true, false
+			IStar.Path.trace(currentNode)
+			return IStar
+		}
+		if b := IStar.best; b == nil || currentNode.Heuristic < b.Heuristic || currentNode.Heuristic == b.Heuristic && currentNode.Cost < b.Cost {
+			IStar.best = currentNode
 		}
-		// Remove the node from the open list
-		IStar.OpenList = append(IStar.OpenList[:currentNodeIndex], IStar.OpenList[currentNodeIndex+1:]...)
-		// Add the node to the closed list
-		IStar.ClosedList = append(IStar.ClosedList, currentNode)
-		// Check if the node is the end node
-		if currentNode.Position.X == IStar.End.Position.X && currentNode.Position.Y == IStar.End.Position.Y && currentNode.Position.Z == IStar.End.Position.Z {
-			IStar.PathFound = true
+		IStar.NodesEvaluated++
+		if IStar.NodesEvaluated > IStar.MaxNodes {
 			break
 		}
-		// Get the neighbors of the node
-		neighbors := currentNode.GetNeighbors()
-		for _, neighbor := range neighbors {
-			// Check if the neighbor is in the closed list
-			if IStar.Contains(IStar.ClosedList, neighbor) {
+		for _, neighbor := range IStar.GetNeighbors(w, currentNode) {
+			if IStar.closed[neighbor.Position] {
 				continue
 			}
-			// Check if the neighbor is in the open list
-			if IStar.Contains(IStar.OpenList, neighbor) {
+			cost := currentNode.Cost + neighbor.Cost
+			if n, ok := IStar.nodes[neighbor.Position]; ok {
+				// Already in the open list, keep the cheapest way to it
+				if cost < n.Cost {
+					n.Cost = cost
+					n.Move, n.Break, n.Place = neighbor.Move, neighbor.Break, neighbor.Place
+					n.Placed = currentNode.Placed + len(neighbor.Place)
+					n.Parent = currentNode
+					heap.Fix(&IStar.open, n.index)
+				}
 				continue
 			}
-			// Add the neighbor to the open list
-			neighbor.Cost = currentNode.Cost + 1 + neighbor.GetCost(IStar.End)
-			IStar.OpenList = append(IStar.OpenList, neighbor)
-		}
-		IStar.NodesEvaluated++
-		if IStar.NodesEvaluated > IStar.MaxNodes {
-			break
+			neighbor.Cost = cost
+			neighbor.Placed = currentNode.Placed + len(neighbor.Place)
+			neighbor.Heuristic = IStar.Goal.Heuristic(neighbor.Position)
+			neighbor.Parent = currentNode
+			IStar.nodes[neighbor.Position] = neighbor
+			heap.Push(&IStar.open, neighbor)
 		}
 	}
-	if IStar.PathFound {
-		var currentNode *Node
-		for _, node := range IStar.ClosedList {
-			if node.Position.X == IStar.End.Position.X && node.Position.Y == IStar.End.Position.Y && node.Position.Z == IStar.End.Position.Z {
-				currentNode = node
-				break
-			}
-		}
-		for currentNode != nil {
-			IStar.Path.Nodes = append(IStar.Path.Nodes, currentNode)
-		}
-		IStar.Path.BackTrace()
+	if IStar.AllowPartial && IStar.best != nil && IStar.best != IStar.Start {
+		IStar.Partial = true
+		IStar.Path.trace(IStar.best)
 	}
 	return IStar
 }
 
-func NewAStar(start, end *Node) *AStar {
-	return &AStar{
+// ComputeAsync run ComputeContext in a goroutine, and send IStar to the channel returned when it's done.
+// The game keeps changing the blocks during the search, which reads them safely.
+// A GoalEntity is searched where the entity is when ComputeAsync is called,
+// so it must be called from the goroutine of HandleGame, or before HandleGame runs.
+// IStar.Actions mustn't be used by another search at the same time.
+func ComputeAsync(ctx context.Context, IStar *AStar, g *Game) <-chan *AStar {
+	IStar.Goal = fixed(IStar.Goal)
+	done := make(chan *AStar, 1)
+	go func() {
+		done <- ComputeContext(ctx, IStar, g)
+	}()
+	return done
+}
+
+func NewAStar(start *Node, goal Goal) *AStar {
+	a := &AStar{
 		Start:          start,
-		End:            end,
-		OpenList:       []*Node{start},
-		ClosedList:     []*Node{},
+		Goal:           goal,
 		Path:           &Path{},
 		PathFound:      false,
 		MaxNodes:       10000,
 		NodesEvaluated: 0,
+		nodes:          map[Vector3]*Node{start.Position: start},
+		closed:         make(map[Vector3]bool),
 	}
+	start.Cost = 0
+	start.Heuristic = goal.Heuristic(start.Position)
+	heap.Push(&a.open, start)
+	return a
 }
 
-func (n *Node) GetNeighbors() []*Node {
-	// Get nearby nodes
-	var neighbors []*Node
-	for x := -1; x <= 1; x++ {
-		for y := -1; y <= 1; y++ {
-			for z := -1; z <= 1; z++ {
-				if x == 0 && y == 0 && z == 0 {
-					continue
-				}
-				neighbors = append(neighbors, &Node{
-					Position: Vector3{
-						X: n.Position.X + float64(x),
-						Y: n.Position.Y + float64(y),
-						Z: n.Position.Z + float64(z),
-					},
-					Cost: n.Cost + 1,
-				})
-			}
+// Blocks are the blocks the path goes through: a World, or a World with the changes of a path, see withChanges
+type Blocks interface {
+	GetBlock(v3 Vector3) Block
+	IsLoaded(v3 Vector3) bool
+}
+
+// IsPassable return true if the player can go through the block at v3
+func IsPassable(w Blocks, v3 Vector3) bool {
+	if !w.IsLoaded(v3) {
+		return false
+	}
+	b := w.GetBlock(v3)
+	return (!b.IsSolid() || b.IsClimbable()) && !b.IsLava() && b.Id != 51 // fire
+}
+
+// CanStand return true if the player can stand with its feet at v3:
+// the floor is solid and there is room for its head
+func CanStand(w Blocks, v3 Vector3) bool {
+	return solidFloor(w, v3.Add(Vector3{Y: -1})) && IsPassable(w, v3) && IsPassable(w, v3.Add(Vector3{Y: 1}))
+}
+
+// solidFloor return true if the player can stand on the block at v3.
+// The player can't stand on a fence.
+func solidFloor(w Blocks, v3 Vector3) bool {
+	b := w.GetBlock(v3)
+	if !b.IsSolid() || b.IsLava() {
+		return false
+	}
+	for _, box := range b.CollisionBoxes() {
+		if box.Max.Y > 1 {
+			return false
 		}
 	}
-	return neighbors
+	return true
 }
 
+// trace set the nodes of the path from the start to end, following the parents
+func (p *Path) trace(end *Node) {
+	p.Nodes = p.Nodes[:0]
+	for n := end; n != nil; n = n.Parent {
+		p.Nodes = append(p.Nodes, n)
+	}
+	p.BackTrace()
+}
+
+// BackTrace reverse the nodes, to go from the end to the start or the other way
 func (p *Path) BackTrace() {
-	for i := len(p.Nodes) - 1; i >= 0; i-- {
-		p.Nodes = append(p.Nodes, p.Nodes[i])
+	for i, j := 0, len(p.Nodes)-1; i < j; i, j = i+1, j-1 {
+		p.Nodes[i], p.Nodes[j] = p.Nodes[j], p.Nodes[i]
 	}
 }
 
-func (a *AStar) Contains(nodes []*Node, node *Node) bool {
-	for _, n := range nodes {
-		if n.Position.X == node.Position.X && n.Position.Y == node.Position.Y && n.Position.Z == node.Position.Z {
-			return true
-		}
+// nodeHeap is a binary heap of nodes, for container/heap
+type nodeHeap []*Node
+
+func (h nodeHeap) Len() int { return len(h) }
+
+func (h nodeHeap) Less(i, j int) bool {
+	fi, fj := h[i].Cost+h[i].Heuristic, h[j].Cost+h[j].Heuristic
+	if fi == fj {
+		return h[i].Heuristic < h[j].Heuristic
 	}
-	return false
+	return fi < fj
+}
+
+func (h nodeHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index, h[j].index = i, j
+}
+
+func (h *nodeHeap) Push(x any) {
+	n := x.(*Node)
+	n.index = len(*h)
+	*h = append(*h, n)
 }
 
-func (n *Node) GetCost(end *Node) int {
-	return int(math.Abs(n.Position.X-end.Position.X) + math.Abs(n.Position.Y-end.Position.Y) + math.Abs(n.Position.Z-end.Position.Z))
+func (h *nodeHeap) Pop() any {
+	old := *h
+	n := old[len(old)-1]
+	old[len(old)-1] = nil
+	*h = old[:len(old)-1]
+	return n
 }
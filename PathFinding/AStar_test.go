@@ -0,0 +1,407 @@
+package PathFinding
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	. "github.com/edouard127/mc-go-1.12.2/data"
+	. "github.com/edouard127/mc-go-1.12.2/data/World"
+	. "github.com/edouard127/mc-go-1.12.2/data/entities"
+	. "github.com/edouard127/mc-go-1.12.2/maths"
+	. "github.com/edouard127/mc-go-1.12.2/struct"
+)
+
+// flatGame return a game with a floor of stone at y = 63
+func flatGame() *Game {
+	g := &Game{World: World{Columns: make(map[ChunkPos]*Chunk)}}
+	g.World.LoadChunk(ChunkPos{0, 0}, EmptyChunk(16), 0xFFFF, true)
+	for x := 0; x < 16; x++ {
+		for z := 0; z < 16; z++ {
+			g.World.SetBlock(Vector3{X: float64(x), Y: 63, Z: float64(z)}, Block{Id: 1})
+		}
+	}
+	return g
+}
+
+func TestCompute(t *testing.T) {
+	g := flatGame()
+	// A wall at z = 5 with a gap at x = 10, and lava on the shortest way to the gap
+	for x := 0; x < 16; x++ {
+		if x != 10 {
+			g.World.SetBlock(Vector3{X: float64(x), Y: 64, Z: 5}, Block{Id: 1})
+			g.World.SetBlock(Vector3{X: float64(x), Y: 65, Z: 5}, Block{Id: 1})
+		}
+	}
+	g.World.SetBlock(Vector3{X: 10, Y: 63, Z: 3}, Block{Id: 11})
+	g.World.SetBlock(Vector3{X: 9, Y: 63, Z: 4}, Block{Id: 11})
+	// A step to climb after the wall
+	g.World.SetBlock(Vector3{X: 2, Y: 64, Z: 8}, Block{Id: 1})
+
+	start, end := Vector3{X: 2, Y: 64, Z: 2}, Vector3{X: 2, Y: 65, Z: 8}
+	a := Compute(NewAStar(&Node{Position: start}, GoalBlock{end}), g)
+	if !a.PathFound {
+		t.Fatal("no path found")
+	}
+	nodes := a.Path.Nodes
+	if nodes[0].Position != start || nodes[len(nodes)-1].Position != end {
+		t.Fatalf("path from %v to %v", nodes[0].Position, nodes[len(nodes)-1].Position)
+	}
+	var cost float64
+	for i := 1; i < len(nodes); i++ {
+		if nodes[i].Parent != nodes[i-1] {
+			t.Fatalf("node %d isn't after its parent", i)
+		}
+		if g.World.GetBlock(nodes[i].Position.Add(Vector3{Y: -1})).IsLava() {
+			t.Fatalf("path goes over lava at %v", nodes[i].Position)
+		}
+		d := nodes[i].Position.Sub(nodes[i-1].Position)
+		switch nodes[i].Move {
+		case MoveWalk:
+			cost += WalkOneBlock
+		case MoveDiagonal:
+			cost += WalkOneBlock * math.Sqrt2
+		case MoveStepUp:
+			cost += StepUp
+		case MoveParkour:
+			cost += WalkOneBlock * math.Abs(d.X+d.Z)
+		default:
+			t.Fatalf("unexpected move %v by %v", nodes[i].Move, d)
+		}
+	}
+	// At worst, straight to the gap around the lava, then straight to the step
+	worst := 2*WalkOneBlock + 7*WalkOneBlock*math.Sqrt2 + 2*WalkOneBlock + 7*WalkOneBlock*math.Sqrt2 + StepUp
+	if got := nodes[len(nodes)-1].Cost; math.Abs(cost-got) > 1e-9 || got > worst {
+		t.Errorf("path costs %v, sum of the moves %v, at worst %v", got, cost, worst)
+	}
+
+	// Walled in
+	for _, d := range horizontal {
+		g.World.SetBlock(end.Add(d), Block{Id: 1})
+		g.World.SetBlock(end.Add(d).Add(Vector3{Y: 1}), Block{Id: 1})
+	}
+	a = NewAStar(&Node{Position: start}, GoalBlock{end})
+	if Compute(a, g).PathFound || a.NodesEvaluated == 0 {
+		t.Errorf("path found through walls after %d nodes", a.NodesEvaluated)
+	}
+}
+
+func TestAStar_GetNeighbors(t *testing.T) {
+	g := flatGame()
+	w := &g.World
+	// A fall of 5 blocks at x = 5, of 3 blocks at x = 6, and water at x = 7
+	for y := 59; y <= 63; y++ {
+		for x := 5; x <= 7; x++ {
+			w.SetBlock(Vector3{X: float64(x), Y: float64(y), Z: 2}, Block{})
+		}
+	}
+	w.SetBlock(Vector3{X: 5, Y: 58, Z: 2}, Block{Id: 1})
+	w.SetBlock(Vector3{X: 6, Y: 60, Z: 2}, Block{Id: 1})
+	w.SetBlock(Vector3{X: 7, Y: 59, Z: 2}, Block{Id: 9})
+	w.SetBlock(Vector3{X: 7, Y: 60, Z: 2}, Block{Id: 9})
+	// A gap of 2 blocks
+	w.SetBlock(Vector3{X: 2, Y: 63, Z: 10}, Block{})
+	w.SetBlock(Vector3{X: 2, Y: 63, Z: 11}, Block{})
+	// A ladder, against a wall on its east
+	for y := 64; y <= 66; y++ {
+		w.SetBlock(Vector3{X: 11, Y: float64(y), Z: 2}, Block{Id: 1})
+		w.SetBlock(Vector3{X: 10, Y: float64(y), Z: 2}, Block{Id: 65, Metadata: 4})
+	}
+	w.SetBlock(Vector3{X: 11, Y: 67, Z: 3}, Block{Id: 1}) // a corner for the diagonal
+	// A ladder under a ceiling
+	for y := 64; y <= 66; y++ {
+		w.SetBlock(Vector3{X: 14, Y: float64(y), Z: 7}, Block{Id: 1})
+		w.SetBlock(Vector3{X: 13, Y: float64(y), Z: 7}, Block{Id: 65, Metadata: 4})
+	}
+	w.SetBlock(Vector3{X: 13, Y: 67, Z: 7}, Block{Id: 1})
+
+	type move struct {
+		to   Vector3
+		move Move
+	}
+	for _, test := range []struct {
+		from    Vector3
+		damage  float64
+		want    []move
+		notWant []move
+	}{
+		{
+			from:    Vector3{X: 4, Y: 64, Z: 2},
+			want:    []move{{Vector3{X: 3, Y: 64, Z: 2}, MoveWalk}, {Vector3{X: 3, Y: 64, Z: 3}, MoveDiagonal}},
+			notWant: []move{{Vector3{X: 5, Y: 59, Z: 2}, MoveDrop}},
+		},
+		{
+			from:   Vector3{X: 4, Y: 64, Z: 2},
+			damage: 2,
+			want:   []move{{Vector3{X: 5, Y: 59, Z: 2}, MoveDrop}},
+		},
+		{
+			from: Vector3{X: 6, Y: 64, Z: 1},
+			want: []move{{Vector3{X: 6, Y: 61, Z: 2}, MoveDrop}},
+		},
+		{
+			from: Vector3{X: 7, Y: 64, Z: 1},
+			want: []move{{Vector3{X: 7, Y: 60, Z: 2}, MoveDrop}},
+		},
+		{
+			from: Vector3{X: 7, Y: 59, Z: 2},
+			want: []move{{Vector3{X: 7, Y: 60, Z: 2}, MoveSwim}},
+		},
+		{
+			from: Vector3{X: 7, Y: 60, Z: 2},
+			want: []move{{Vector3{X: 7, Y: 59, Z: 2}, MoveSwim}, {Vector3{X: 6, Y: 61, Z: 2}, MoveStepUp}},
+		},
+		{
+			from: Vector3{X: 2, Y: 64, Z: 9},
+			want: []move{{Vector3{X: 2, Y: 64, Z: 12}, MoveParkour}},
+		},
+		{
+			from:    Vector3{X: 10, Y: 64, Z: 1},
+			want:    []move{{Vector3{X: 10, Y: 64, Z: 2}, MoveWalk}},
+			notWant: []move{{Vector3{X: 11, Y: 64, Z: 2}, MoveDiagonal}},
+		},
+		{
+			from: Vector3{X: 10, Y: 65, Z: 2},
+			want: []move{{Vector3{X: 10, Y: 66, Z: 2}, MoveClimb}, {Vector3{X: 10, Y: 64, Z: 2}, MoveDescend}},
+		},
+		{
+			from: Vector3{X: 10, Y: 66, Z: 2},
+			want: []move{{Vector3{X: 10, Y: 67, Z: 2}, MoveClimb}},
+		},
+		{
+			from:    Vector3{X: 10, Y: 67, Z: 2},
+			want:    []move{{Vector3{X: 11, Y: 67, Z: 2}, MoveWalk}},
+			notWant: []move{{Vector3{X: 11, Y: 67, Z: 3}, MoveDiagonal}},
+		},
+		{
+			from:    Vector3{X: 13, Y: 65, Z: 7},
+			want:    []move{{Vector3{X: 13, Y: 64, Z: 7}, MoveDescend}},
+			notWant: []move{{Vector3{X: 13, Y: 66, Z: 7}, MoveClimb}},
+		},
+	} {
+		a := NewAStar(&Node{Position: test.from}, GoalBlock{})
+		a.MaxFallDamage = test.damage
+		got := make(map[move]bool)
+		for _, n := range a.GetNeighbors(w, &Node{Position: test.from}) {
+			got[move{n.Position, n.Move}] = true
+		}
+		for _, m := range test.want {
+			if !got[m] {
+				t.Errorf("from %v: no %v to %v in %v", test.from, m.move, m.to, got)
+			}
+		}
+		for _, m := range test.notWant {
+			if got[m] {
+				t.Errorf("from %v: unexpected %v to %v", test.from, m.move, m.to)
+			}
+		}
+	}
+}
+
+func TestFallCost(t *testing.T) {
+	// The vanilla player falls 0.0784, 0.1552 then 0.2305 blocks in the first 3 ticks
+	if c := FallCost(0.0784 + 0.1552 + 0.2305); math.Abs(c-3) > 1e-3 {
+		t.Errorf("FallCost = %v, want 3", c)
+	}
+	for n := 1.0; n < 20; n++ {
+		if FallCost(n+1) <= FallCost(n) || FallCost(n+1)-FallCost(n) > FallCost(n)-FallCost(n-1) {
+			t.Fatalf("falling %v blocks isn't accelerating", n)
+		}
+	}
+}
+
+func TestCompute_Actions(t *testing.T) {
+	g := flatGame()
+	// A wall of stone 5 blocks high across the chunk at z = 5, then a bottomless trench 4 blocks wide
+	for x := 0; x < 16; x++ {
+		for y := 64; y <= 68; y++ {
+			g.World.SetBlock(Vector3{X: float64(x), Y: float64(y), Z: 5}, Block{Id: 1})
+		}
+		for z := 8; z < 12; z++ {
+			g.World.SetBlock(Vector3{X: float64(x), Y: 63, Z: float64(z)}, Block{})
+		}
+	}
+	start, end := &Node{Position: Vector3{X: 2, Y: 64, Z: 2}}, GoalBlock{Vector3{X: 2, Y: 64, Z: 14}}
+	actions := &Actions{
+		Dig:        true,
+		Tools:      []ToolItem{ToolItems[257]},
+		Digger:     Digger{OnGround: true},
+		NeverBreak: DefaultNeverBreak,
+		PlaceCost:  DefaultPlaceCost,
+	}
+	compute := func() *AStar {
+		a := NewAStar(start, end)
+		a.Actions = actions
+		return Compute(a, g)
+	}
+
+	if Compute(NewAStar(start, end), g).PathFound {
+		t.Fatal("path found without breaking the wall")
+	}
+	if compute().PathFound {
+		t.Fatal("path found over the trench without placing blocks")
+	}
+	actions.MaxPlace = 4
+	actions.NeverBreak = map[uint]bool{1: true}
+	if compute().PathFound {
+		t.Fatal("path found breaking stone")
+	}
+
+	actions.NeverBreak = DefaultNeverBreak
+	a := compute()
+	if !a.PathFound {
+		t.Fatal("no path found breaking and placing blocks")
+	}
+	// Each node can be reached once the blocks before are broken and placed
+	placed := 0
+	for _, n := range a.Path.Nodes {
+		for _, v3 := range n.Break {
+			if g.World.GetBlock(v3).Id != 1 {
+				t.Fatalf("break %v at %v", g.World.GetBlock(v3), v3)
+			}
+			g.World.SetBlock(v3, Block{})
+		}
+		for _, v3 := range n.Place {
+			if !g.World.GetBlock(v3).IsAir() {
+				t.Fatalf("place on %v at %v", g.World.GetBlock(v3), v3)
+			}
+			g.World.SetBlock(v3, Block{Id: 4})
+			placed++
+		}
+		if !CanBeAt(&g.World, n.Position) {
+			t.Fatalf("%v to %v, where the player can't be", n.Move, n.Position)
+		}
+	}
+	if placed == 0 || placed > 4 || a.Path.Nodes[len(a.Path.Nodes)-1].Placed != placed {
+		t.Errorf("placed %d blocks", placed)
+	}
+
+	// The iron pickaxe is faster than the hand
+	if c, _ := actions.DigCost(Block{Id: 1}); c != 9 {
+		t.Errorf("dig stone in %v ticks, want 9", c)
+	}
+	if _, ok := actions.DigCost(Block{Id: 54}); ok {
+		t.Error("chests can be broken")
+	}
+}
+
+func TestCompute_DigAndPillar(t *testing.T) {
+	newGame := func() *Game {
+		g := flatGame()
+		for x := 0; x < 16; x++ {
+			for z := 0; z < 16; z++ {
+				for y := 50; y < 63; y++ {
+					g.World.SetBlock(Vector3{X: float64(x), Y: float64(y), Z: float64(z)}, Block{Id: 1})
+				}
+			}
+		}
+		return g
+	}
+	start := Vector3{X: 8, Y: 64, Z: 8}
+	for _, goal := range []Goal{
+		GoalY{Y: 60}, // dig down through the blocks dug before
+		GoalY{Y: 67}, // pillar up on the blocks placed before
+		GoalBlock{Vector3{X: 10, Y: 61, Z: 8}},
+	} {
+		g := newGame()
+		a := NewAStar(&Node{Position: start}, goal)
+		a.Actions = &Actions{Dig: true, Tools: []ToolItem{ToolItems[257]}, Digger: Digger{OnGround: true}, MaxPlace: 4, PlaceCost: DefaultPlaceCost}
+		if Compute(a, g); !a.PathFound {
+			t.Errorf("%#v: no path found in %d nodes", goal, a.NodesEvaluated)
+			continue
+		}
+		// Each node can be reached once the blocks before are broken and placed
+		for _, n := range a.Path.Nodes {
+			for _, v3 := range n.Break {
+				g.World.SetBlock(v3, Block{})
+			}
+			for _, v3 := range n.Place {
+				g.World.SetBlock(v3, Block{Id: 4})
+			}
+			if !CanBeAt(&g.World, n.Position) {
+				t.Errorf("%#v: %v to %v, where the player can't be", goal, n.Move, n.Position)
+				break
+			}
+		}
+	}
+}
+
+func TestGoal(t *testing.T) {
+	g := flatGame()
+	// A block to reach, on the floor
+	g.World.SetBlock(Vector3{X: 8, Y: 64, Z: 8}, Block{Id: 1})
+	zombie := &Entity{Position: Vector3{X: 12.3, Y: 64, Z: 3.7}}
+	start := Vector3{X: 2, Y: 64, Z: 2}
+
+	for _, test := range []struct {
+		goal Goal
+		end  Vector3
+	}{
+		{GoalBlock{Vector3{X: 5, Y: 64, Z: 2}}, Vector3{X: 5, Y: 64, Z: 2}},
+		{GoalNear{Vector3{X: 10, Y: 64, Z: 2}, 3}, Vector3{X: 7, Y: 64, Z: 2}},
+		{GoalXZ{X: 2, Z: 6}, Vector3{X: 2, Y: 64, Z: 6}},
+		{GoalY{Y: 64}, start},
+		{GoalAdjacent{Vector3{X: 8, Y: 64, Z: 8}}, Vector3{X: 7, Y: 64, Z: 7}},
+		{GoalEntity{zombie, 2}, Vector3{X: 10, Y: 64, Z: 3}},
+		{GoalAvoid{Vector3{X: 2, Y: 64, Z: 4}, 4}, Vector3{X: 1, Y: 64, Z: -1}},
+		{GoalAny{GoalBlock{Vector3{X: 14, Y: 64, Z: 14}}, GoalBlock{Vector3{X: 2, Y: 64, Z: 5}}}, Vector3{X: 2, Y: 64, Z: 5}},
+	} {
+		a := Compute(NewAStar(&Node{Position: start}, test.goal), g)
+		if !a.PathFound {
+			t.Errorf("%T: no path found", test.goal)
+			continue
+		}
+		end := a.Path.Nodes[len(a.Path.Nodes)-1]
+		if !test.goal.IsEnd(end.Position) || test.goal.Heuristic(end.Position) != 0 {
+			t.Errorf("%T: path ends at %v, not at the goal", test.goal, end.Position)
+		}
+		// The nodes are at most 2 blocks away from the end, any of them is as fast
+		if d := end.Position.Sub(test.end); math.Abs(d.X)+math.Abs(d.Y)+math.Abs(d.Z) > 2 {
+			t.Errorf("%T: path ends at %v, want near %v", test.goal, end.Position, test.end)
+		}
+		for _, n := range a.Path.Nodes {
+			if h := test.goal.Heuristic(n.Position); h > end.Cost-n.Cost+1e-9 {
+				t.Errorf("%T: heuristic %v at %v is more than the cost %v", test.goal, h, n.Position, end.Cost-n.Cost)
+			}
+		}
+	}
+}
+
+func TestComputeContext(t *testing.T) {
+	g := flatGame()
+	start, goal := Vector3{X: 2, Y: 64, Z: 2}, GoalBlock{Vector3{X: 40, Y: 64, Z: 6}} // out of the loaded chunk
+
+	a := NewAStar(&Node{Position: start}, goal)
+	a.AllowPartial = true
+	result := ComputeAsync(context.Background(), a, g)
+	// The game changes blocks out of the way during the search
+	for x := 0; x < 16; x++ {
+		g.World.SetBlock(Vector3{X: float64(x), Y: 70, Z: 15}, Block{Id: 1})
+	}
+	a = <-result
+	if a.PathFound || !a.Partial || a.Err != nil {
+		t.Fatalf("found %v, partial %v, err %v", a.PathFound, a.Partial, a.Err)
+	}
+	if end := a.Path.Nodes[len(a.Path.Nodes)-1].Position; end != (Vector3{X: 15, Y: 64, Z: 6}) {
+		t.Errorf("partial path ends at %v", end)
+	}
+	if a.NodesEvaluated == 0 || a.Elapsed <= 0 {
+		t.Errorf("%d nodes in %v", a.NodesEvaluated, a.Elapsed)
+	}
+
+	// Without partial paths
+	a = Compute(NewAStar(&Node{Position: start}, goal), g)
+	if a.PathFound || a.Partial || len(a.Path.Nodes) != 0 {
+		t.Errorf("found %v, partial %v, %d nodes", a.PathFound, a.Partial, len(a.Path.Nodes))
+	}
+
+	// Stopped by the context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	a = NewAStar(&Node{Position: start}, goal)
+	a.AllowPartial = true
+	a = ComputeContext(ctx, a, g)
+	if a.Err != context.Canceled || a.NodesEvaluated != 0 || a.Partial {
+		t.Errorf("err %v, %d nodes, partial %v", a.Err, a.NodesEvaluated, a.Partial)
+	}
+}
@@ -0,0 +1,183 @@
+package PathFinding
+
+import (
+	. "github.com/edouard127/mc-go-1.12.2/data"
+	. "github.com/edouard127/mc-go-1.12.2/data/World"
+	. "github.com/edouard127/mc-go-1.12.2/maths"
+	. "github.com/edouard127/mc-go-1.12.2/struct"
+)
+
+// Actions allow the path to go through the world by changing it: digging the blocks in the way,
+// bridging over gaps and pillaring up with throwaway blocks
+type Actions struct {
+	// Dig allows to break blocks
+	Dig bool
+	// Digger is how the player digs, the held item is replaced by the best tool
+	Digger Digger
+	// Tools are the tools the player has in its inventory
+	Tools []ToolItem
+	// NeverBreak are the IDs of the blocks the path must not break
+	NeverBreak map[uint]bool
+
+	// MaxPlace is the number of blocks the path can place, 0 to never place
+	MaxPlace int
+	// PlaceCost is the cost of placing a block, in ticks
+	PlaceCost float64
+
+	digCosts map[BlocksState]float64 // the best dig cost by block, -1 if it can't be broken
+}
+
+// DefaultNeverBreak are the blocks which hold items or set the spawn point:
+// chests, beds, furnaces, dispensers, hoppers, shulker boxes...
+var DefaultNeverBreak = map[uint]bool{
+	23: true, 26: true, 54: true, 61: true, 62: true, 117: true, 130: true, 146: true, 154: true, 158: true,
+	219: true, 220: true, 221: true, 222: true, 223: true, 224: true, 225: true, 226: true,
+	227: true, 228: true, 229: true, 230: true, 231: true, 232: true, 233: true, 234: true,
+}
+
+// Throwaway are the IDs of the items the path can place: stone, dirt, cobblestone and netherrack
+var Throwaway = map[int]bool{1: true, 3: true, 4: true, 87: true}
+
+// DefaultPlaceCost is the time to stop, look and place a block
+const DefaultPlaceCost = 20
+
+// NewActions return the actions the player of g can do with its inventory:
+// digging with its tools, and placing as many throwaway blocks as it has.
+func NewActions(g *Game) *Actions {
+	a := &Actions{
+		Dig:        true,
+		Digger:     g.Digger(),
+		NeverBreak: DefaultNeverBreak,
+		PlaceCost:  DefaultPlaceCost,
+	}
+	a.Digger.Held = ToolItem{}
+	a.Digger.OnGround, a.Digger.InWater = true, false
+	for _, slot := range g.GetPlayer().Inventory {
+		if tool, ok := ToolItems[slot.ID]; ok && slot.Count > 0 {
+			a.Tools = append(a.Tools, tool)
+		}
+		if Throwaway[slot.ID] {
+			a.MaxPlace += int(slot.Count)
+		}
+	}
+	return a
+}
+
+// DigCost return the time to break b with the best tool, in ticks, and false if it mustn't be broken
+func (a *Actions) DigCost(b Block) (float64, bool) {
+	if !a.Dig || a.NeverBreak[b.Id] {
+		return 0, false
+	}
+	if c, ok := a.digCosts[b.State()]; ok {
+		return c, c >= 0
+	}
+	best := b.DigTicks(a.Digger) // by hand
+	for _, tool := range a.Tools {
+		d := a.Digger
+		d.Held = tool
+		if t := b.DigTicks(d); t >= 0 && (best < 0 || t < best) {
+			best = t
+		}
+	}
+	if a.digCosts == nil {
+		a.digCosts = make(map[BlocksState]float64)
+	}
+	// The block is broken the tick after the last one
+	c := float64(best + 1)
+	if best < 0 {
+		c = -1
+	}
+	a.digCosts[b.State()] = c
+	return c, c >= 0
+}
+
+// clear return the cost of breaking what is solid at each position, and false if one can't be broken.
+// The blocks under a liquid or a falling block aren't broken.
+func (a *Actions) clear(w Blocks, positions ...Vector3) (float64, bool) {
+	var cost float64
+	for _, v3 := range positions {
+		if IsPassable(w, v3) {
+			continue
+		}
+		b := w.GetBlock(v3)
+		if b.IsLava() || !w.IsLoaded(v3) {
+			return 0, false
+		}
+		if above := w.GetBlock(v3.Add(Vector3{Y: 1})); above.IsLiquid() || above.Id == 12 || above.Id == 13 {
+			return 0, false
+		}
+		c, ok := a.DigCost(b)
+		if !ok {
+			return 0, false
+		}
+		cost += c
+	}
+	return cost, true
+}
+
+// needsBreak return the positions of the blocks to break among positions
+func needsBreak(w Blocks, positions ...Vector3) (ret []Vector3) {
+	for _, v3 := range positions {
+		if !IsPassable(w, v3) {
+			ret = append(ret, v3)
+		}
+	}
+	return
+}
+
+// canPlace return true if one more block can be placed at v3 after the path to n
+func (a *Actions) canPlace(w Blocks, n *Node, v3 Vector3) bool {
+	return n.Placed < a.MaxPlace && w.IsLoaded(v3) && w.GetBlock(v3).IsReplaceable() && !w.GetBlock(v3).IsLava()
+}
+
+// actionMoves add the moves which need to break or place blocks, when they aren't possible without.
+// w has the changes of the path to n.
+func (a *Actions) actionMoves(w Blocks, n *Node, add func(*Node)) {
+	pos := n.Position
+	up := Vector3{Y: 1}
+	head := pos.Add(Vector3{Y: 2}) // where the head goes when jumping
+	onGround := CanStand(w, pos)
+
+	for _, d := range horizontal {
+		next := pos.Add(d)
+		floor := next.Sub(up)
+		if !CanBeAt(w, next) && solidFloor(w, floor) {
+			// Walk, breaking what's in the way
+			if c, ok := a.clear(w, next, next.Add(up)); ok {
+				add(&Node{Position: next, Move: MoveWalk, Cost: WalkOneBlock + c, Break: needsBreak(w, next, next.Add(up))})
+			}
+		} else if onGround && !solidFloor(w, floor) && a.canPlace(w, n, floor) {
+			// Bridge, sneaking backward to the edge to place the block against the current floor
+			if c, ok := a.clear(w, next, next.Add(up)); ok {
+				add(&Node{
+					Position: next, Move: MoveBridge, Cost: WalkOneBlock*2 + a.PlaceCost + c,
+					Break: needsBreak(w, next, next.Add(up)), Place: []Vector3{floor},
+				})
+			}
+		}
+		// Step up, breaking what's in the way
+		if onGround && solidFloor(w, next) && !(CanBeAt(w, next.Add(up)) && IsPassable(w, head)) {
+			cells := []Vector3{next.Add(up), next.Add(Vector3{Y: 2}), head}
+			if c, ok := a.clear(w, cells...); ok {
+				add(&Node{Position: next.Add(up), Move: MoveStepUp, Cost: StepUp + c, Break: needsBreak(w, cells...)})
+			}
+		}
+	}
+
+	if !onGround {
+		return
+	}
+	// Pillar up, jumping and placing a block under the feet
+	if a.canPlace(w, n, pos) {
+		if c, ok := a.clear(w, head); ok {
+			add(&Node{Position: pos.Add(up), Move: MovePillar, Cost: JumpOneBlock + a.PlaceCost + c, Break: needsBreak(w, head), Place: []Vector3{pos}})
+		}
+	}
+	// Dig down, through the floor
+	floor := pos.Sub(up)
+	if solidFloor(w, floor.Sub(up)) {
+		if c, ok := a.clear(w, floor); ok {
+			add(&Node{Position: floor, Move: MoveDrop, Cost: c + FallCost(1), Break: []Vector3{floor}})
+		}
+	}
+}
@@ -0,0 +1,455 @@
+package PathFinding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	. "github.com/edouard127/mc-go-1.12.2/data"
+	. "github.com/edouard127/mc-go-1.12.2/data/World"
+	. "github.com/edouard127/mc-go-1.12.2/maths"
+	. "github.com/edouard127/mc-go-1.12.2/struct"
+	"math"
+	"time"
+)
+
+// PathStartedEvent sent when the executor starts to follow a path.
+// Replan is true if the path was computed again on the way.
+type PathStartedEvent struct {
+	Path   *Path
+	Replan bool
+}
+
+// PathProgressEvent sent when the player reaches a node of the path
+type PathProgressEvent struct {
+	Node      *Node
+	Remaining int // the number of nodes left
+}
+
+// PathFinishedEvent sent when the player reaches the goal
+type PathFinishedEvent struct {
+	Position Vector3
+}
+
+// PathFailedEvent sent when the executor gives up, Err is what Run returns
+type PathFailedEvent struct {
+	Err error
+}
+
+// ErrNoPath is returned when the goal can't be reached from where the player is
+var ErrNoPath = errors.New("no path found")
+
+// Executor moves the player along a path, node by node, 20 times a second.
+// The path is computed again when the player is pushed away from it, by a mob or by a teleport of the server,
+// when it's stuck, and when a block changes on its way.
+type Executor struct {
+	Game *Game
+	Goal Goal
+	// Path is the path followed, computed by Run if it's nil
+	Path *Path
+	// MaxNodes, MaxFallDamage and Actions are used to compute the paths, see AStar
+	MaxNodes      int
+	MaxFallDamage float64
+	Actions       *Actions
+	// Timeout is the longest time to compute a path, 0 for no limit. The player stands still during the search,
+	// which runs in its own goroutine while the game goes on.
+	// When the goal is far or in unloaded chunks, the player follows the path toward it and computes the rest at the end.
+	Timeout time.Duration
+	// MaxReplans is how many times the path can be computed again before failing
+	MaxReplans int
+
+	ctx     context.Context
+	index   int        // the index of the node the player goes to
+	ticks   int        // the ticks since the player left the previous node
+	replans int        // the number of paths computed again
+	action  chan error // the result of the dig or the place in progress, nil if there is none
+
+	search <-chan *AStar        // the result of the path search in progress, nil if there is none
+	found  func(a *AStar) error // called with the result of the search
+	cancel context.CancelFunc   // stops the search
+}
+
+// NewExecutor return an executor bringing the player of g to goal
+func NewExecutor(g *Game, goal Goal) *Executor {
+	return &Executor{Game: g, Goal: goal, MaxNodes: 10000, MaxReplans: 10, Timeout: 250 * time.Millisecond}
+}
+
+// Run follow the path until the player reaches the goal, and send the Path events to Game.Events.
+// The executor runs at the start of each tick, in the goroutine of HandleGame, and the path searches in their own.
+// Return an error wrapping ErrNoPath if the goal can't be reached, or after MaxReplans paths computed again,
+// ErrStopped if HandleGame returns and ctx.Err() if ctx is done first. The player stops in all cases.
+// Run mustn't be called from the goroutine of HandleGame.
+func (e *Executor) Run(ctx context.Context) error {
+	e.ctx = ctx
+	result := make(chan error, 1)
+	e.Game.OnTick(func() bool {
+		done, err := e.tick()
+		if err == nil && !done {
+			if err = ctx.Err(); err == nil {
+				return true
+			}
+		}
+		e.stopSearch()
+		if err != nil {
+			e.Game.SetControls(Controls{})
+			e.Game.Events.Publish(PathFailedEvent{Err: err})
+		}
+		result <- err
+		return false
+	})
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err() // the player stops at the next tick
+	case <-e.Game.Done():
+		return ErrStopped
+	}
+}
+
+// tick set the controls of the player for the next tick, return true once the goal is reached
+func (e *Executor) tick() (bool, error) {
+	g := e.Game
+	w := &g.World
+	p := g.GetPlayer()
+	if e.search != nil {
+		select {
+		case a := <-e.search:
+			e.stopSearch()
+			if err := e.found(a); err != nil {
+				return false, err
+			}
+			if e.search != nil {
+				return false, nil // searching again
+			}
+		default:
+			return false, nil // the player waits for the path
+		}
+	}
+	if e.Path == nil {
+		e.plan(false)
+		return false, nil
+	}
+	if e.action != nil {
+		select {
+		case err := <-e.action:
+			e.action = nil
+			if err != nil {
+				return false, e.replan()
+			}
+		default:
+			return false, nil // still digging or placing
+		}
+	}
+
+	// Move to the next node once this one is reached
+	for e.index < len(e.Path.Nodes) && e.reached(e.Path.Nodes[e.index]) {
+		n := e.Path.Nodes[e.index]
+		e.index++
+		e.ticks = 0
+		g.Events.Publish(PathProgressEvent{Node: n, Remaining: len(e.Path.Nodes) - e.index})
+	}
+	if e.index >= len(e.Path.Nodes) && !e.Goal.IsEnd(feet(p.Position)) {
+		// the end of a partial path, compute the next part
+		e.plan(true)
+		return false, nil
+	}
+	if e.index >= len(e.Path.Nodes) {
+		g.SetControls(Controls{})
+		g.Events.Publish(PathFinishedEvent{Position: p.Position})
+		return true, nil
+	}
+
+	n := e.Path.Nodes[e.index]
+	prev := n // a path given to Run, the player walks to its first node
+	if e.index > 0 {
+		prev = e.Path.Nodes[e.index-1]
+	}
+	e.ticks++
+	if e.offPath(prev, n) || blocked(w, e.Path.Nodes[e.index:]) >= 0 || e.ticks > 40+3*int(math.Max(n.Cost-prev.Cost, 0)) {
+		return false, e.replan()
+	}
+
+	// Break the blocks in the way before moving
+	for _, v3 := range n.Break {
+		if !IsPassable(w, v3) {
+			if err := e.holdTool(w.GetBlock(v3)); err != nil {
+				return false, err
+			}
+			e.start(func(ctx context.Context) error {
+				return g.DigContext(ctx, v3)
+			})
+			return false, nil
+		}
+	}
+	g.SetControls(e.controls(prev, n))
+	return false, nil
+}
+
+// plan start to compute the path from the player to the goal, or toward it.
+// replan is true if the player was already following a path.
+func (e *Executor) plan(replan bool) {
+	e.compute(e.MaxNodes, e.Goal, true, func(a *AStar) error {
+		if !a.PathFound && !a.Partial {
+			return fmt.Errorf("path from %v: %w", a.Start.Position, ErrNoPath)
+		}
+		e.Path, e.index, e.ticks = a.Path, 1, 0
+		e.Game.Events.Publish(PathStartedEvent{Path: e.Path, Replan: replan})
+		return nil
+	})
+}
+
+// replan compute a path back to one of the next nodes still valid, to keep the rest of the path.
+// The whole path is computed again if the player can't go back to it.
+// The player must be on the ground, in water or on a ladder, otherwise replan waits for it.
+func (e *Executor) replan() error {
+	if ph := e.Game.Physics; !e.Game.GetPlayer().OnGround && !ph.InWater && !ph.OnLadder {
+		e.Game.SetControls(Controls{})
+		return nil
+	}
+	if e.replans++; e.replans > e.MaxReplans {
+		return fmt.Errorf("path computed again %d times: %w", e.MaxReplans, ErrNoPath)
+	}
+	e.rejoin()
+	return nil
+}
+
+// rejoin start to compute a path from the player to the next nodes, to replace the path to them.
+// The nodes after a block changed are kept if they are all still valid.
+// The whole path is computed again if the player can't go back to them.
+func (e *Executor) rejoin() {
+	w := &e.Game.World
+	rest := e.Path.Nodes[e.index:]
+	if b := blocked(w, rest); b >= 0 {
+		rest = rest[b+1:]
+	}
+	if len(rest) == 0 || blocked(w, rest) >= 0 {
+		e.plan(true)
+		return
+	}
+	var goal GoalAny
+	for i := 0; i < len(rest) && i < 32; i++ {
+		goal = append(goal, GoalBlock{rest[i].Position})
+	}
+	e.compute(2000, goal, false, func(a *AStar) error {
+		if a.PathFound {
+			end := a.Path.Nodes[len(a.Path.Nodes)-1]
+			for i, n := range rest {
+				if n.Position == end.Position {
+					e.Path = &Path{Nodes: append(a.Path.Nodes, rest[i+1:]...)}
+					e.index, e.ticks = 1, 0
+					e.Game.Events.Publish(PathStartedEvent{Path: e.Path, Replan: true})
+					return nil
+				}
+			}
+		}
+		e.plan(true)
+		return nil
+	})
+}
+
+// compute start to search a path from the block of the player's feet to goal, within Timeout.
+// The player stops, and found is called with the result by the tick which receives it.
+func (e *Executor) compute(maxNodes int, goal Goal, partial bool, found func(a *AStar) error) {
+	ctx := e.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if e.Timeout > 0 {
+		ctx, e.cancel = context.WithTimeout(ctx, e.Timeout)
+	}
+	e.Game.SetControls(Controls{})
+	a := NewAStar(&Node{Position: feet(e.Game.GetPlayer().Position)}, goal)
+	a.MaxNodes, a.MaxFallDamage, a.Actions, a.AllowPartial = maxNodes, e.MaxFallDamage, e.Actions, partial
+	e.search, e.found = ComputeAsync(ctx, a, e.Game), found
+}
+
+// stopSearch forget the search in progress, it stops if it's still running
+func (e *Executor) stopSearch() {
+	if e.cancel != nil {
+		e.cancel()
+	}
+	e.search, e.cancel = nil, nil
+}
+
+// feet return the node of a player at v3, the blocks lower than 0.2 like soul sand or farmland count as full
+func feet(v3 Vector3) Vector3 {
+	return Vector3{X: math.Floor(v3.X), Y: math.Floor(v3.Y + 0.2), Z: math.Floor(v3.Z)}
+}
+
+// center return the position of the feet in the center of the block of n
+func center(n *Node) Vector3 {
+	return n.Position.Add(Vector3{X: 0.5, Z: 0.5})
+}
+
+// reached return true if the player is in the center of n, standing, swimming or holding a ladder.
+// The last node needs to be closer.
+func (e *Executor) reached(n *Node) bool {
+	p := e.Game.GetPlayer()
+	radius := 0.35
+	if n == e.Path.Nodes[len(e.Path.Nodes)-1] {
+		radius = 0.2
+	}
+	d := center(n).Sub(p.Position)
+	return math.Hypot(d.X, d.Z) < radius && feet(p.Position).Y == n.Position.Y &&
+		(p.OnGround || e.Game.Physics.InWater || e.Game.Physics.OnLadder)
+}
+
+// offPath return true if the player isn't on its way from prev to n anymore
+func (e *Executor) offPath(prev, n *Node) bool {
+	pos := e.Game.GetPlayer().Position
+	if pos.Y < math.Min(prev.Position.Y, n.Position.Y)-1 || pos.Y > math.Max(prev.Position.Y, n.Position.Y)+2 {
+		return true
+	}
+	// the horizontal distance to the segment from the center of prev to the center of n
+	a, b := center(prev), center(n)
+	ab, ap := b.Sub(a), pos.Sub(a)
+	t := 0.0
+	if l := ab.X*ab.X + ab.Z*ab.Z; l > 0 {
+		t = math.Max(0, math.Min(1, (ap.X*ab.X+ap.Z*ab.Z)/l))
+	}
+	return math.Hypot(ap.X-ab.X*t, ap.Z-ab.Z*t) > 1
+}
+
+// blocked return the index of the first node which the world doesn't allow anymore, or -1:
+// a block to go through is solid and isn't to be broken, or the floor is gone
+func blocked(w *World, nodes []*Node) int {
+	placed := make(map[Vector3]bool)
+	for i, n := range nodes {
+		for _, v3 := range n.Place {
+			placed[v3] = true
+		}
+		for _, v3 := range [...]Vector3{n.Position, n.Position.Add(Vector3{Y: 1})} {
+			if !IsPassable(w, v3) && !contains(n.Break, v3) {
+				return i
+			}
+		}
+		floor := n.Position.Add(Vector3{Y: -1})
+		if !solidFloor(w, floor) && !placed[floor] && !contains(n.Break, floor) && !IsWater(w, n.Position) && !IsClimbable(w, n.Position) {
+			return i
+		}
+	}
+	return -1
+}
+
+func contains(positions []Vector3, v3 Vector3) bool {
+	for _, p := range positions {
+		if p == v3 {
+			return true
+		}
+	}
+	return false
+}
+
+// controls return the keys to press to go from prev to n, and turn the player toward n
+func (e *Executor) controls(prev, n *Node) Controls {
+	g := e.Game
+	w := &g.World
+	p := g.GetPlayer()
+	target := center(n)
+	d := target.Sub(p.Position)
+	dist := math.Hypot(d.X, d.Z)
+
+	var c Controls
+	if dist > 0.05 {
+		p.SetYaw(float32(-math.Atan2(d.X, d.Z) / math.Pi * 180))
+		c.Forward = 1
+		if e.index == len(e.Path.Nodes)-1 {
+			c.Forward = math.Min(1, dist)
+		}
+	}
+	switch n.Move {
+	case MoveStepUp:
+		c.Jump = g.Physics.CollidedHorizontally || g.Physics.InWater
+	case MoveParkour:
+		c.Sprint = true
+		// jump from the edge of the block
+		from := center(prev)
+		dir := target.Sub(from)
+		along := (p.Position.X-from.X)*dir.X + (p.Position.Z-from.Z)*dir.Z
+		c.Jump = p.OnGround && along/math.Hypot(dir.X, dir.Z) > 0.6
+	case MoveClimb:
+		// climb by walking against the block holding the ladder
+		for _, h := range horizontal {
+			if wall := prev.Position.Add(h); solidFloor(w, wall) {
+				p.SetYaw(float32(-math.Atan2(h.X, h.Z) / math.Pi * 180))
+				c.Forward = 1
+				break
+			}
+		}
+		c.Jump = g.Physics.InWater
+	case MoveSwim:
+		c.Jump = p.Position.Y < n.Position.Y+0.2
+	case MoveBridge:
+		c.Sneak = true
+		floor := n.Position.Add(Vector3{Y: -1})
+		if w.GetBlock(floor).IsReplaceable() {
+			// sneak to the edge, where the side of the floor is seen, and place the block against it
+			if from := center(prev); math.Abs(p.Position.X-from.X)+math.Abs(p.Position.Z-from.Z) > 0.55 {
+				held := e.holdThrowaway()
+				e.start(func(ctx context.Context) error {
+					if !held {
+						return fmt.Errorf("no throwaway block in the hotbar")
+					}
+					return g.PlaceBlockContext(ctx, floor)
+				})
+				return Controls{Sneak: true}
+			}
+		}
+	case MovePillar:
+		c.Forward = 0
+		c.Jump = p.OnGround
+		// place the block under the feet at the top of the jump
+		if p.Position.Y >= n.Position.Y && w.GetBlock(prev.Position).IsReplaceable() {
+			held := e.holdThrowaway()
+			e.start(func(ctx context.Context) error {
+				if !held {
+					return fmt.Errorf("no throwaway block in the hotbar")
+				}
+				return g.PlaceBlockContext(ctx, prev.Position)
+			})
+			return Controls{}
+		}
+	}
+	return c
+}
+
+// start run f in a goroutine, the executor waits for it before moving the player again
+func (e *Executor) start(f func(ctx context.Context) error) {
+	e.Game.SetControls(Controls{})
+	ctx := e.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	done := make(chan error, 1)
+	e.action = done
+	go func() { done <- f(ctx) }()
+}
+
+// holdTool hold the item of the hotbar which breaks b the fastest
+func (e *Executor) holdTool(b Block) error {
+	p := e.Game.GetPlayer()
+	d := e.Game.Digger()
+	best, bestTicks := -1, -1
+	for i := 0; i < 9 && 36+i < len(p.Inventory); i++ {
+		d.Held = ToolItems[p.Inventory[36+i].ID]
+		d.Efficiency = p.Inventory[36+i].EnchantmentLevel(EnchEfficiency)
+		if t := b.DigTicks(d); t >= 0 && (bestTicks < 0 || t < bestTicks) {
+			best, bestTicks = i, t
+		}
+	}
+	if best >= 0 {
+		return e.Game.SelectHotbar(best)
+	}
+	return nil
+}
+
+// holdThrowaway hold a throwaway block of the hotbar, return false if there is none
+func (e *Executor) holdThrowaway() bool {
+	p := e.Game.GetPlayer()
+	for i := 0; i < 9 && 36+i < len(p.Inventory); i++ {
+		if s := p.Inventory[36+i]; Throwaway[s.ID] && s.Count > 0 {
+			return e.Game.SelectHotbar(i) == nil
+		}
+	}
+	return false
+}
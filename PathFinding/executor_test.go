@@ -0,0 +1,177 @@
+package PathFinding
+
+import (
+	"errors"
+	. "github.com/edouard127/mc-go-1.12.2/data/World"
+	. "github.com/edouard127/mc-go-1.12.2/maths"
+	"github.com/edouard127/mc-go-1.12.2/protocol"
+	. "github.com/edouard127/mc-go-1.12.2/struct"
+	"testing"
+	"time"
+)
+
+// step run a tick of the executor, and the next ones while it waits for a path search.
+// The ticks of a game go on during the search, they only wait for it here to not depend on the time it takes.
+func step(e *Executor) (bool, error) {
+	done, err := e.tick()
+	for err == nil && !done && e.search != nil {
+		time.Sleep(time.Millisecond)
+		done, err = e.tick()
+	}
+	return done, err
+}
+
+// execute run the executor and the physics of the player tick by tick, without a server.
+// change is called before each tick to change the world or push the player.
+func execute(t *testing.T, e *Executor, change func(tick int)) []Event {
+	g := e.Game
+	ch, sub := SubscribeChan[Event](g.Events, 1000, PolicyBlock)
+	for tick := 0; tick < 1200; tick++ {
+		change(tick)
+		done, err := step(e)
+		if err != nil {
+			t.Fatalf("tick %d: %v", tick, err)
+		}
+		if done {
+			sub.Unsubscribe()
+			var events []Event
+			for ev := range ch {
+				events = append(events, ev)
+			}
+			return events
+		}
+		g.Physics.Tick(&g.World, g.GetPlayer())
+	}
+	t.Fatalf("goal not reached, the player is at %v", g.GetPlayer().Position)
+	return nil
+}
+
+func TestExecutor(t *testing.T) {
+	newGame := func() *Game {
+		g := flatGame()
+		g.Events = NewEventBus()
+		g.Player.Food = 20
+		// A wall at z = 5 with a gap at x = 10, and a step after it
+		for x := 0; x < 16; x++ {
+			if x != 10 {
+				g.World.SetBlock(Vector3{X: float64(x), Y: 64, Z: 5}, Block{Id: 1})
+				g.World.SetBlock(Vector3{X: float64(x), Y: 65, Z: 5}, Block{Id: 1})
+			}
+		}
+		g.World.SetBlock(Vector3{X: 2, Y: 64, Z: 8}, Block{Id: 1})
+		g.Player.SetPosition(Vector3{X: 2.5, Y: 64, Z: 2.5})
+		return g
+	}
+	goal := GoalBlock{Vector3{X: 2, Y: 65, Z: 8}}
+
+	for _, test := range []struct {
+		name   string
+		change func(g *Game, e *Executor, tick int)
+		replan bool
+	}{
+		{"walk", func(*Game, *Executor, int) {}, false},
+		{"knockback", func(g *Game, e *Executor, tick int) {
+			if tick == 30 {
+				HandleEntityVelocity(g, &protocol.EntityVelocity{EntityID: g.Player.ID, Velocity: [3]int16{-6000, 3200, 0}})
+			}
+		}, true},
+		{"block change", func(g *Game, e *Executor, tick int) {
+			// wall up the gap when the player goes to it
+			if tick == 10 {
+				g.World.SetBlock(Vector3{X: 10, Y: 64, Z: 5}, Block{Id: 1})
+				g.World.SetBlock(Vector3{X: 0, Y: 64, Z: 5}, Block{Id: 0})
+				g.World.SetBlock(Vector3{X: 0, Y: 65, Z: 5}, Block{Id: 0})
+			}
+		}, true},
+	} {
+		g := newGame()
+		e := NewExecutor(g, goal)
+		events := execute(t, e, func(tick int) { test.change(g, e, tick) })
+
+		if p := g.GetPlayer().Position; feet(p) != goal.Position {
+			t.Errorf("%s: the player stops at %v", test.name, p)
+		}
+		if _, ok := events[0].(PathStartedEvent); !ok {
+			t.Errorf("%s: first event %#v", test.name, events[0])
+		}
+		if _, ok := events[len(events)-1].(PathFinishedEvent); !ok {
+			t.Errorf("%s: last event %#v", test.name, events[len(events)-1])
+		}
+		replanned := false
+		for _, ev := range events {
+			if ev, ok := ev.(PathStartedEvent); ok && ev.Replan {
+				replanned = true
+			}
+		}
+		if replanned != test.replan {
+			t.Errorf("%s: path computed again: %v", test.name, replanned)
+		}
+		last := events[len(events)-2].(PathProgressEvent)
+		if last.Node.Position != goal.Position || last.Remaining != 0 {
+			t.Errorf("%s: last progress %v", test.name, last)
+		}
+	}
+}
+
+func TestExecutor_Partial(t *testing.T) {
+	g := flatGame()
+	g.Player.SetPosition(Vector3{X: 2.5, Y: 64, Z: 2.5})
+	// out of the loaded chunk, the player goes to its edge and stops there
+	e := NewExecutor(g, GoalBlock{Vector3{X: 40, Y: 64, Z: 2}})
+	for tick := 0; tick < 600; tick++ {
+		_, err := step(e)
+		if errors.Is(err, ErrNoPath) {
+			if p := feet(g.GetPlayer().Position); p != (Vector3{X: 15, Y: 64, Z: 2}) {
+				t.Errorf("the player stops at %v", p)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		g.Physics.Tick(&g.World, g.GetPlayer())
+	}
+	t.Fatalf("the player is still going at %v", g.GetPlayer().Position)
+}
+
+func TestExecutor_givenPath(t *testing.T) {
+	g := flatGame()
+	g.Events = NewEventBus()
+	start, end := Vector3{X: 2, Y: 64, Z: 2}, Vector3{X: 6, Y: 64, Z: 2}
+	a := Compute(NewAStar(&Node{Position: start}, GoalBlock{end}), g)
+	if !a.PathFound {
+		t.Fatal("no path found")
+	}
+	// off the center of the first node, and falling on it
+	g.Player.SetPosition(Vector3{X: 2.1, Y: 64.5, Z: 2.9})
+	e := NewExecutor(g, GoalBlock{end})
+	e.Path = a.Path
+	execute(t, e, func(int) {})
+	if p := g.GetPlayer().Position; feet(p) != end {
+		t.Errorf("the player stops at %v", p)
+	}
+}
+
+func TestExecutor_search(t *testing.T) {
+	g := flatGame()
+	g.Events = NewEventBus()
+	g.Player.SetPosition(Vector3{X: 2.5, Y: 64, Z: 2.5})
+	g.Physics.Forward = 1
+	e := NewExecutor(g, GoalBlock{Vector3{X: 12, Y: 64, Z: 12}})
+
+	// The first tick starts the search and returns, the player waits for it
+	if done, err := e.tick(); done || err != nil || e.search == nil || e.Path != nil {
+		t.Fatalf("first tick: %v, %v, searching %v", done, err, e.search != nil)
+	}
+	if g.Physics.Forward != 0 {
+		t.Error("the player walks during the search")
+	}
+	for e.Path == nil {
+		if _, err := e.tick(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if e.search != nil || len(e.Path.Nodes) < 2 {
+		t.Errorf("path of %d nodes, searching %v", len(e.Path.Nodes), e.search != nil)
+	}
+}
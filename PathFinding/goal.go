@@ -0,0 +1,171 @@
+package PathFinding
+
+import (
+	. "github.com/edouard127/mc-go-1.12.2/data/entities"
+	. "github.com/edouard127/mc-go-1.12.2/maths"
+	"math"
+)
+
+// Goal is where a path ends
+type Goal interface {
+	// IsEnd return true if the path can end with the feet of the player at v3
+	IsEnd(v3 Vector3) bool
+	// Heuristic estimates the cost in ticks from v3 to the goal.
+	// The path is the fastest if it's never more than the real cost.
+	Heuristic(v3 Vector3) float64
+}
+
+// estimate is the cost of going by d, sprinting horizontally and jumping up.
+// No move is faster, falling is almost free.
+func estimate(d Vector3) float64 {
+	return math.Max(math.Hypot(d.X, d.Z)*SprintOneBlock, math.Max(d.Y, 0)*minUpCost)
+}
+
+// GoalBlock is reached with the feet in the block at Position
+type GoalBlock struct {
+	Position Vector3
+}
+
+func (g GoalBlock) IsEnd(v3 Vector3) bool { return v3 == g.Position }
+
+func (g GoalBlock) Heuristic(v3 Vector3) float64 { return estimate(g.Position.Sub(v3)) }
+
+// GoalNear is reached with the feet within Radius blocks of Position
+type GoalNear struct {
+	Position Vector3
+	Radius   float64
+}
+
+func (g GoalNear) IsEnd(v3 Vector3) bool {
+	d := g.Position.Sub(v3)
+	return d.X*d.X+d.Y*d.Y+d.Z*d.Z <= g.Radius*g.Radius
+}
+
+func (g GoalNear) Heuristic(v3 Vector3) float64 {
+	d := g.Position.Sub(v3)
+	l := math.Sqrt(d.X*d.X + d.Y*d.Y + d.Z*d.Z)
+	if l <= g.Radius {
+		return 0
+	}
+	// the closest point of the sphere
+	return estimate(d.Mul(Vector3{X: 1 - g.Radius/l, Y: 1 - g.Radius/l, Z: 1 - g.Radius/l}))
+}
+
+// GoalXZ is reached in the column X, Z at any height
+type GoalXZ struct {
+	X, Z float64
+}
+
+func (g GoalXZ) IsEnd(v3 Vector3) bool { return v3.X == g.X && v3.Z == g.Z }
+
+func (g GoalXZ) Heuristic(v3 Vector3) float64 {
+	return estimate(Vector3{X: g.X - v3.X, Z: g.Z - v3.Z})
+}
+
+// GoalY is reached with the feet at the level Y, anywhere
+type GoalY struct {
+	Y float64
+}
+
+func (g GoalY) IsEnd(v3 Vector3) bool { return v3.Y == g.Y }
+
+func (g GoalY) Heuristic(v3 Vector3) float64 { return estimate(Vector3{Y: g.Y - v3.Y}) }
+
+// GoalAdjacent is reached when the feet or the head of the player are next to a face of the block at Position,
+// to dig it or to open it
+type GoalAdjacent struct {
+	Position Vector3
+}
+
+func (g GoalAdjacent) IsEnd(v3 Vector3) bool {
+	for _, part := range [...]Vector3{v3, v3.Add(Vector3{Y: 1})} {
+		d := g.Position.Sub(part)
+		if math.Abs(d.X)+math.Abs(d.Y)+math.Abs(d.Z) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+func (g GoalAdjacent) Heuristic(v3 Vector3) float64 {
+	// the feet end at most one block away horizontally,
+	// and between the top of the block and 2 blocks under it
+	d := g.Position.Sub(v3)
+	closer := func(x, min, max float64) float64 {
+		return x - math.Max(min, math.Min(x, max))
+	}
+	return estimate(Vector3{X: closer(d.X, -1, 1), Y: closer(d.Y, -1, 2), Z: closer(d.Z, -1, 1)})
+}
+
+// GoalEntity is reached within Radius blocks of the entity, where it is when the goal is checked.
+// The path must be computed again as the entity moves.
+type GoalEntity struct {
+	Entity *Entity
+	Radius float64
+}
+
+func (g GoalEntity) near() GoalNear {
+	p := g.Entity.Position
+	return GoalNear{Position: Vector3{X: math.Floor(p.X), Y: math.Floor(p.Y), Z: math.Floor(p.Z)}, Radius: g.Radius}
+}
+
+func (g GoalEntity) IsEnd(v3 Vector3) bool { return g.near().IsEnd(v3) }
+
+func (g GoalEntity) Heuristic(v3 Vector3) float64 { return g.near().Heuristic(v3) }
+
+// fixed return goal with the entities replaced by where they are now,
+// for a search which doesn't run in the goroutine of the game
+func fixed(goal Goal) Goal {
+	switch g := goal.(type) {
+	case GoalEntity:
+		return g.near()
+	case GoalAny:
+		f := make(GoalAny, len(g))
+		for i := range g {
+			f[i] = fixed(g[i])
+		}
+		return f
+	}
+	return goal
+}
+
+// GoalAvoid is reached farther than Distance blocks from Center, to flee from it
+type GoalAvoid struct {
+	Center   Vector3
+	Distance float64
+}
+
+func (g GoalAvoid) IsEnd(v3 Vector3) bool {
+	d := v3.Sub(g.Center)
+	return d.X*d.X+d.Y*d.Y+d.Z*d.Z > g.Distance*g.Distance
+}
+
+func (g GoalAvoid) Heuristic(v3 Vector3) float64 {
+	d := v3.Sub(g.Center)
+	l := math.Sqrt(d.X*d.X + d.Y*d.Y + d.Z*d.Z)
+	if l >= g.Distance {
+		return 0
+	}
+	// falling is the fastest way for long distances
+	return math.Min((g.Distance-l)*SprintOneBlock, FallCost(g.Distance-l))
+}
+
+// GoalAny is reached when any of its goals is
+type GoalAny []Goal
+
+func (g GoalAny) IsEnd(v3 Vector3) bool {
+	for _, goal := range g {
+		if goal.IsEnd(v3) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g GoalAny) Heuristic(v3 Vector3) float64 {
+	h := math.Inf(1)
+	for _, goal := range g {
+		h = math.Min(h, goal.Heuristic(v3))
+	}
+	return h
+}
@@ -0,0 +1,259 @@
+package PathFinding
+
+import (
+	. "github.com/edouard127/mc-go-1.12.2/data/World"
+	. "github.com/edouard127/mc-go-1.12.2/maths"
+	"math"
+)
+
+// Move is how the player goes from a node to the next
+type Move byte
+
+const (
+	MoveStart    Move = iota // the first node of a path
+	MoveWalk                 // to a block next to it at the same level
+	MoveDiagonal             // to a block in diagonal at the same level
+	MoveStepUp               // jump on the block next to it
+	MoveDrop                 // walk off the edge and fall some blocks
+	MoveClimb                // up a ladder or a vine
+	MoveDescend              // down a ladder or a vine
+	MoveSwim                 // through water, in any direction
+	MoveParkour              // sprint-jump over a gap of 1 to 3 blocks
+	MoveBridge               // walk to the block next to it after placing its floor
+	MovePillar               // jump and place a block under the feet
+)
+
+func (m Move) String() string {
+	switch m {
+	case MoveStart:
+		return "start"
+	case MoveWalk:
+		return "walk"
+	case MoveDiagonal:
+		return "diagonal"
+	case MoveStepUp:
+		return "step up"
+	case MoveDrop:
+		return "drop"
+	case MoveClimb:
+		return "climb"
+	case MoveDescend:
+		return "descend"
+	case MoveSwim:
+		return "swim"
+	case MoveParkour:
+		return "parkour"
+	case MoveBridge:
+		return "bridge"
+	case MovePillar:
+		return "pillar"
+	default:
+		return "unknown move"
+	}
+}
+
+// The cost of the moves, in ticks, from the speeds of a vanilla player in blocks per second
+var (
+	WalkOneBlock    = 20 / 4.317
+	SprintOneBlock  = 20 / 5.612
+	SwimOneBlock    = 20 / 2.2
+	LadderUp        = 20 / 2.35
+	LadderDown      = 20 / 3.0
+	WalkOffEdge     = WalkOneBlock * 0.8              // from the center of a block to falling from its edge
+	CenterAfterFall = WalkOneBlock - WalkOffEdge      // from where the player lands to the center of the block
+	JumpOneBlock    = FallCost(1.25) - FallCost(0.25) // the time to go up by a jump
+	StepUp          = WalkOneBlock/2 + math.Max(JumpOneBlock, WalkOneBlock/2)
+)
+
+// FallCost return the number of ticks to fall distance blocks from a standstill,
+// with a fraction for the last tick
+func FallCost(distance float64) float64 {
+	var fallen, speed float64
+	for tick := 0.0; ; tick++ {
+		speed = (speed + Gravity) * AirDrag
+		if fallen+speed >= distance {
+			return tick + (distance-fallen)/speed
+		}
+		fallen += speed
+	}
+}
+
+// SafeFall is the height the player falls without damage
+const SafeFall = 3
+
+// minUpCost is the cheapest way to go up a block
+var minUpCost = math.Min(JumpOneBlock, math.Min(LadderUp, SwimOneBlock))
+
+// horizontal is the four directions the player can walk to
+var horizontal = [...]Vector3{{X: 1}, {X: -1}, {Z: 1}, {Z: -1}}
+
+// GetNeighbors return the nodes the player can move to from n, with the cost of the move in ticks.
+// The blocks broken and placed by the path to n are air and solid.
+func (a *AStar) GetNeighbors(world *World, n *Node) []*Node {
+	w := withChanges(world, n)
+	var neighbors []*Node
+	add := func(v3 Vector3, m Move, cost float64) {
+		neighbors = append(neighbors, &Node{Position: v3, Move: m, Cost: cost})
+	}
+	if a.Actions != nil {
+		a.Actions.actionMoves(w, n, func(node *Node) {
+			neighbors = append(neighbors, node)
+		})
+	}
+	pos := n.Position
+	up, down := pos.Add(Vector3{Y: 1}), pos.Add(Vector3{Y: -1})
+	inWater := IsWater(w, pos)
+	standing := CanStand(w, pos)
+
+	for _, d := range horizontal {
+		next := pos.Add(d)
+		switch {
+		case inWater && CanBeAt(w, next):
+			add(next, MoveSwim, SwimOneBlock)
+		case CanBeAt(w, next):
+			add(next, MoveWalk, WalkOneBlock)
+		case CanBeAt(w, next.Add(Vector3{Y: 1})) && IsPassable(w, pos.Add(Vector3{Y: 2})) && (standing || inWater):
+			// the head goes above the current position while jumping
+			add(next.Add(Vector3{Y: 1}), MoveStepUp, StepUp)
+		case IsPassable(w, next) && IsPassable(w, next.Add(Vector3{Y: 1})):
+			if v3, ok := a.landing(w, next); ok {
+				add(v3, MoveDrop, WalkOffEdge+math.Max(FallCost(next.Y-v3.Y), CenterAfterFall))
+			}
+			if standing {
+				a.parkour(w, pos, d, add)
+			}
+		}
+	}
+
+	// Diagonals, the two blocks on the sides must be free or the player hits the corner
+	for _, dx := range horizontal[:2] {
+		for _, dz := range horizontal[2:] {
+			next := pos.Add(dx).Add(dz)
+			if CanStand(w, next) && standing && free(w, pos.Add(dx)) && free(w, pos.Add(dz)) {
+				add(next, MoveDiagonal, WalkOneBlock*math.Sqrt2)
+			}
+		}
+	}
+
+	// Vertically
+	switch {
+	case inWater && CanBeAt(w, up):
+		add(up, MoveSwim, SwimOneBlock)
+	case IsClimbable(w, pos) && free(w, up) && (IsClimbable(w, up) || CanBeAt(w, up)):
+		// the head goes above up, a ladder can end under a ceiling
+		add(up, MoveClimb, LadderUp)
+	}
+	switch {
+	case inWater && CanBeAt(w, down):
+		add(down, MoveSwim, SwimOneBlock)
+	case IsClimbable(w, down) && CanBeAt(w, down):
+		add(down, MoveDescend, LadderDown)
+	}
+	return neighbors
+}
+
+// landing return where the player lands falling from v3 if it doesn't hurt it more than AStar.MaxFallDamage,
+// or anywhere in water
+func (a *AStar) landing(w Blocks, v3 Vector3) (Vector3, bool) {
+	for y := v3.Y - 1; y >= 0 && v3.Y-y <= 256; y-- {
+		at := Vector3{X: v3.X, Y: y, Z: v3.Z}
+		switch {
+		case IsWater(w, at):
+			return at, true
+		case !IsPassable(w, at):
+			return Vector3{}, false
+		case CanStand(w, at) || IsClimbable(w, at):
+			// each block over SafeFall hurts half a heart
+			return at, v3.Y-y-SafeFall <= a.MaxFallDamage
+		}
+	}
+	return Vector3{}, false
+}
+
+// parkour add the jumps over gaps of 1 to 3 blocks in the direction d, at the same level.
+// A gap of 3 needs to sprint.
+func (a *AStar) parkour(w Blocks, pos, d Vector3, add func(Vector3, Move, float64)) {
+	if !IsPassable(w, pos.Add(Vector3{Y: 2})) {
+		return
+	}
+	for gap := 1; gap <= 3; gap++ {
+		cell := pos.Add(d.Mul(Vector3{X: float64(gap), Z: float64(gap)}))
+		// the jump goes half a block higher than the head
+		if !IsPassable(w, cell) || !IsPassable(w, cell.Add(Vector3{Y: 1})) || !IsPassable(w, cell.Add(Vector3{Y: 2})) {
+			return
+		}
+		if CanStand(w, cell) {
+			return // not a gap anymore
+		}
+		next := cell.Add(d)
+		if CanStand(w, next) {
+			cost := WalkOneBlock * float64(gap+1)
+			if gap == 3 {
+				cost = SprintOneBlock * float64(gap+1)
+			}
+			add(next, MoveParkour, cost)
+			return
+		}
+	}
+}
+
+// placedBlock is what withChanges puts where the path places a block
+var placedBlock = Block{Id: 1}
+
+// changedBlocks is a World with the blocks broken and placed by a path
+type changedBlocks struct {
+	Blocks
+	changes map[Vector3]Block
+}
+
+func (c changedBlocks) GetBlock(v3 Vector3) Block {
+	if b, ok := c.changes[v3]; ok {
+		return b
+	}
+	return c.Blocks.GetBlock(v3)
+}
+
+// withChanges return w as it is once the player reaches n: the blocks broken by the path are air
+// and the ones it places are solid. The last change of a position is kept.
+func withChanges(w Blocks, n *Node) Blocks {
+	var changes map[Vector3]Block
+	set := func(v3 Vector3, b Block) {
+		if changes == nil {
+			changes = make(map[Vector3]Block)
+		}
+		if _, ok := changes[v3]; !ok {
+			changes[v3] = b
+		}
+	}
+	for ; n != nil; n = n.Parent {
+		for _, v3 := range n.Place {
+			set(v3, placedBlock)
+		}
+		for _, v3 := range n.Break {
+			set(v3, Block{})
+		}
+	}
+	if changes == nil {
+		return w
+	}
+	return changedBlocks{w, changes}
+}
+
+// free return true if the feet and the head of the player can be in v3
+func free(w Blocks, v3 Vector3) bool {
+	return IsPassable(w, v3) && IsPassable(w, v3.Add(Vector3{Y: 1}))
+}
+
+// CanBeAt return true if the player can stay with its feet at v3:
+// standing on a block, swimming or holding a ladder
+func CanBeAt(w Blocks, v3 Vector3) bool {
+	return CanStand(w, v3) || (free(w, v3) && (IsWater(w, v3) || IsClimbable(w, v3)))
+}
+
+func IsWater(w Blocks, v3 Vector3) bool {
+	return w.GetBlock(v3).IsWater()
+}
+
+func IsClimbable(w Blocks, v3 Vector3) bool {
+	return w.GetBlock(v3).IsClimbable()
+}
@@ -5,8 +5,16 @@ import (
 )
 
 type Node struct {
-	Position Vector3
-	Cost     int
+	Position  Vector3
+	Cost      float64   // the cost from the start, or of the move to the node for a neighbor
+	Heuristic float64   // the estimated cost to the end
+	Parent    *Node     // the node before in the path
+	Move      Move      // how the player comes from the parent
+	Break     []Vector3 // the blocks to break during the move
+	Place     []Vector3 // the blocks to place during the move
+	Placed    int       // the number of blocks placed since the start
+
+	index int // the index in the open list
 }
 
 type Path struct {
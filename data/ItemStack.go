@@ -0,0 +1,134 @@
+package data
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/edouard127/mc-go-1.12.2/nbt"
+)
+
+// ItemStack is the item in a slot, with what the server sends in its NBT
+type ItemStack struct {
+	ID     int // 0 for an empty slot
+	Count  byte
+	Damage int16 // the durability used by a tool, or the variant of the item like the color of wool
+
+	DisplayName  string // given with an anvil, empty for the default name
+	Lore         []string
+	Enchantments []Enchantment
+	Unbreakable  bool
+
+	// NBT is the raw tag of the item, nil if it has none.
+	// It has what isn't decoded in the fields above, like the pages of a book.
+	NBT []byte
+}
+
+// Enchantment is an enchantment of an item, with its level
+type Enchantment struct {
+	ID    int16 `nbt:"id"`
+	Level int16 `nbt:"lvl"`
+}
+
+// Enchantment IDs
+const (
+	EnchProtection           = 0
+	EnchFireProtection       = 1
+	EnchFeatherFalling       = 2
+	EnchBlastProtection      = 3
+	EnchProjectileProtection = 4
+	EnchRespiration          = 5
+	EnchAquaAffinity         = 6
+	EnchThorns               = 7
+	EnchDepthStrider         = 8
+	EnchFrostWalker          = 9
+	EnchBindingCurse         = 10
+	EnchSharpness            = 16
+	EnchSmite                = 17
+	EnchBaneOfArthropods     = 18
+	EnchKnockback            = 19
+	EnchFireAspect           = 20
+	EnchLooting              = 21
+	EnchSweepingEdge         = 22
+	EnchEfficiency           = 32
+	EnchSilkTouch            = 33
+	EnchUnbreaking           = 34
+	EnchFortune              = 35
+	EnchPower                = 48
+	EnchPunch                = 49
+	EnchFlame                = 50
+	EnchInfinity             = 51
+	EnchLuckOfTheSea         = 61
+	EnchLure                 = 62
+	EnchMending              = 70
+	EnchVanishingCurse       = 71
+)
+
+// itemTag is the part of the NBT of an item decoded in ItemStack
+type itemTag struct {
+	Display struct {
+		Name string   `nbt:"Name"`
+		Lore []string `nbt:"Lore"`
+	} `nbt:"display"`
+	Ench        []Enchantment `nbt:"ench"`
+	Unbreakable bool          `nbt:"Unbreakable"`
+}
+
+// NewItemStack return the item of a slot sent by the server, tag is its NBT or nil.
+// If the tag can't be read, the item is returned with only its raw NBT.
+func NewItemStack(id int, count byte, damage int16, tag []byte) (ItemStack, error) {
+	s := ItemStack{ID: id, Count: count, Damage: damage, NBT: tag}
+	if len(tag) == 0 {
+		s.NBT = nil
+		return s, nil
+	}
+	var t itemTag
+	if err := nbt.Unmarshal(tag, &t); err != nil {
+		return s, fmt.Errorf("read NBT of item %d fail: %v", id, err)
+	}
+	s.DisplayName, s.Lore = t.Display.Name, t.Display.Lore
+	s.Enchantments = t.Ench
+	s.Unbreakable = t.Unbreakable
+	return s, nil
+}
+
+// Tag return the NBT to send the item to the server.
+// It's NBT if it's set, else it's made from the fields, nil if they are empty.
+func (s ItemStack) Tag() ([]byte, error) {
+	if s.NBT != nil {
+		return s.NBT, nil
+	}
+	tag := map[string]interface{}{}
+	display := map[string]interface{}{}
+	if s.DisplayName != "" {
+		display["Name"] = s.DisplayName
+	}
+	if len(s.Lore) > 0 {
+		display["Lore"] = s.Lore
+	}
+	if len(display) > 0 {
+		tag["display"] = display
+	}
+	if len(s.Enchantments) > 0 {
+		tag["ench"] = s.Enchantments
+	}
+	if s.Unbreakable {
+		tag["Unbreakable"] = byte(1)
+	}
+	if len(tag) == 0 {
+		return nil, nil
+	}
+	var buf bytes.Buffer
+	if err := nbt.NewEncoder(&buf).Encode(tag, ""); err != nil {
+		return nil, fmt.Errorf("write NBT of item %d fail: %v", s.ID, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EnchantmentLevel return the level of an enchantment of the item, 0 if it doesn't have it
+func (s ItemStack) EnchantmentLevel(id int16) int {
+	for _, e := range s.Enchantments {
+		if e.ID == id {
+			return int(e.Level)
+		}
+	}
+	return 0
+}
@@ -0,0 +1,110 @@
+package data
+
+// The types of the mobs in Spawn Mob packets
+const (
+	MobElderGuardian  = 4
+	MobWitherSkeleton = 5
+	MobStray          = 6
+	MobHusk           = 23
+	MobZombieVillager = 27
+	MobSkeletonHorse  = 28
+	MobZombieHorse    = 29
+	MobArmorStand     = 30
+	MobDonkey         = 31
+	MobMule           = 32
+	MobEvoker         = 33
+	MobVex            = 34
+	MobVindicator     = 35
+	MobIllusioner     = 36
+	MobCreeper        = 50
+	MobSkeleton       = 51
+	MobSpider         = 52
+	MobGiant          = 53
+	MobZombie         = 54
+	MobSlime          = 55
+	MobGhast          = 56
+	MobZombiePigman   = 57
+	MobEnderman       = 58
+	MobCaveSpider     = 59
+	MobSilverfish     = 60
+	MobBlaze          = 61
+	MobMagmaCube      = 62
+	MobEnderDragon    = 63
+	MobWither         = 64
+	MobBat            = 65
+	MobWitch          = 66
+	MobEndermite      = 67
+	MobGuardian       = 68
+	MobShulker        = 69
+	MobPig            = 90
+	MobSheep          = 91
+	MobCow            = 92
+	MobChicken        = 93
+	MobSquid          = 94
+	MobWolf           = 95
+	MobMooshroom      = 96
+	MobSnowGolem      = 97
+	MobOcelot         = 98
+	MobIronGolem      = 99
+	MobHorse          = 100
+	MobRabbit         = 101
+	MobPolarBear      = 102
+	MobLlama          = 103
+	MobParrot         = 104
+	MobVillager       = 120
+)
+
+// MobSizes contains the width and the height of the adult mobs by type.
+// Slimes and magma cubes are the smallest ones, their size is in their metadata.
+var MobSizes = map[byte][2]float64{
+	MobElderGuardian:  {1.9975, 1.9975},
+	MobWitherSkeleton: {0.7, 2.4},
+	MobStray:          {0.6, 1.99},
+	MobHusk:           {0.6, 1.95},
+	MobZombieVillager: {0.6, 1.95},
+	MobSkeletonHorse:  {1.3964844, 1.6},
+	MobZombieHorse:    {1.3964844, 1.6},
+	MobArmorStand:     {0.5, 1.975},
+	MobDonkey:         {1.3964844, 1.6},
+	MobMule:           {1.3964844, 1.6},
+	MobEvoker:         {0.6, 1.95},
+	MobVex:            {0.4, 0.8},
+	MobVindicator:     {0.6, 1.95},
+	MobIllusioner:     {0.6, 1.95},
+	MobCreeper:        {0.6, 1.7},
+	MobSkeleton:       {0.6, 1.99},
+	MobSpider:         {1.4, 0.9},
+	MobGiant:          {3.6, 11.7},
+	MobZombie:         {0.6, 1.95},
+	MobSlime:          {0.51000005, 0.51000005},
+	MobGhast:          {4, 4},
+	MobZombiePigman:   {0.6, 1.95},
+	MobEnderman:       {0.6, 2.9},
+	MobCaveSpider:     {0.7, 0.5},
+	MobSilverfish:     {0.4, 0.3},
+	MobBlaze:          {0.6, 1.8},
+	MobMagmaCube:      {0.51000005, 0.51000005},
+	MobEnderDragon:    {16, 8},
+	MobWither:         {0.9, 3.5},
+	MobBat:            {0.5, 0.9},
+	MobWitch:          {0.6, 1.95},
+	MobEndermite:      {0.4, 0.3},
+	MobGuardian:       {0.85, 0.85},
+	MobShulker:        {1, 1},
+	MobPig:            {0.9, 0.9},
+	MobSheep:          {0.9, 1.3},
+	MobCow:            {0.9, 1.4},
+	MobChicken:        {0.4, 0.7},
+	MobSquid:          {0.8, 0.8},
+	MobWolf:           {0.6, 0.85},
+	MobMooshroom:      {0.9, 1.4},
+	MobSnowGolem:      {0.7, 1.9},
+	MobOcelot:         {0.6, 0.7},
+	MobIronGolem:      {1.4, 2.7},
+	MobHorse:          {1.3964844, 1.6},
+	MobRabbit:         {0.4, 0.5},
+	MobPolarBear:      {1.3, 1.4},
+	MobLlama:          {0.9, 1.87},
+	MobParrot:         {0.5, 0.9},
+	MobVillager:       {0.6, 1.95},
+}
@@ -1,9 +1,14 @@
 package data
 
 const (
+	Boat             = 1
 	Item             = 2
+	AreaEffectCloud  = 3
 	Minecarts        = 10
+	EnderCrystal     = 51
 	Arrow            = 60
+	Snowball         = 61
+	Egg              = 62
 	Fireball         = 63
 	SmallFireball    = 64
 	WitherSkull      = 66
@@ -11,13 +16,47 @@ const (
 	LlamaSpit        = 68
 	FallingBlock     = 70
 	ItemFrame        = 71
+	EyeOfEnder       = 72
 	Potion           = 73
 	ExperienceBottle = 75
+	FireworkRocket   = 76
+	LeashKnot        = 77
+	ArmorStand       = 78
+	EvocationFangs   = 79
 	FishingFloat     = 90
 	SpectralArrow    = 91
 	DragonFireball   = 93
 )
 
+// ObjectSizes contains the width and the height of the objects by type
+var ObjectSizes = map[byte][2]float64{
+	Boat:             {1.375, 0.5625},
+	Item:             {0.25, 0.25},
+	AreaEffectCloud:  {6, 0.5},
+	Minecarts:        {0.98, 0.7},
+	EnderCrystal:     {2, 2},
+	Arrow:            {0.5, 0.5},
+	Snowball:         {0.25, 0.25},
+	Egg:              {0.25, 0.25},
+	Fireball:         {1, 1},
+	SmallFireball:    {0.3125, 0.3125},
+	WitherSkull:      {0.3125, 0.3125},
+	ShulkerBullet:    {0.3125, 0.3125},
+	LlamaSpit:        {0.25, 0.25},
+	FallingBlock:     {0.98, 0.98},
+	ItemFrame:        {0.5, 0.5},
+	EyeOfEnder:       {0.25, 0.25},
+	Potion:           {0.25, 0.25},
+	ExperienceBottle: {0.25, 0.25},
+	FireworkRocket:   {0.25, 0.25},
+	LeashKnot:        {0.375, 0.5},
+	ArmorStand:       {0.5, 1.975},
+	EvocationFangs:   {0.5, 0.8},
+	FishingFloat:     {0.25, 0.25},
+	SpectralArrow:    {0.5, 0.5},
+	DragonFireball:   {1, 1},
+}
+
 type CreateObject struct {
 	EntityID int32
 	ObjectID [2]int64
@@ -5,12 +5,19 @@ import (
 	. "github.com/edouard127/mc-go-1.12.2/items"
 )
 
-// Slot indicates a slot in a window
-type Slot struct {
-	ID    int
-	Count byte
+// Slot is the content of a slot in a window, the zero value is an empty slot
+type Slot = ItemStack
+
+// IsBlock return true if the item can be placed as a block
+func (s Slot) IsBlock() bool {
+	return s.Count > 0 && (s.ID > 0 && s.ID < 256 || blockItems[s.ID])
 }
-type slotNBT struct {
+
+// blockItems are the 1.12.2 items which aren't blocks but place one, like doors or redstone
+var blockItems = map[int]bool{
+	323: true, 324: true, 330: true, 331: true, 338: true, 354: true, 355: true, 356: true, // sign, doors, redstone, sugar cane, cake, bed, repeater
+	379: true, 380: true, 390: true, 397: true, 404: true, 425: true, // brewing stand, cauldron, flower pot, skull, comparator, banner
+	427: true, 428: true, 429: true, 430: true, 431: true, // wooden doors
 }
 
 func (s Slot) String() string {
@@ -0,0 +1,35 @@
+package data
+
+import "github.com/edouard127/mc-go-1.12.2/data/World/block/legacy"
+
+// ToolItem is an item which digs some blocks faster
+type ToolItem struct {
+	Tool  legacy.Tool
+	Level int     // 0 wood or gold, 1 stone, 2 iron, 3 diamond
+	Speed float64 // the dig speed on the blocks the tool is made for
+}
+
+// ToolItems contains the tools by 1.12.2 item ID
+var ToolItems = map[int]ToolItem{
+	268: {legacy.ToolSword, 0, 2}, // wooden
+	269: {legacy.ToolShovel, 0, 2},
+	270: {legacy.ToolPickaxe, 0, 2},
+	271: {legacy.ToolAxe, 0, 2},
+	272: {legacy.ToolSword, 1, 4}, // stone
+	273: {legacy.ToolShovel, 1, 4},
+	274: {legacy.ToolPickaxe, 1, 4},
+	275: {legacy.ToolAxe, 1, 4},
+	267: {legacy.ToolSword, 2, 6}, // iron
+	256: {legacy.ToolShovel, 2, 6},
+	257: {legacy.ToolPickaxe, 2, 6},
+	258: {legacy.ToolAxe, 2, 6},
+	276: {legacy.ToolSword, 3, 8}, // diamond
+	277: {legacy.ToolShovel, 3, 8},
+	278: {legacy.ToolPickaxe, 3, 8},
+	279: {legacy.ToolAxe, 3, 8},
+	283: {legacy.ToolSword, 0, 12}, // golden
+	284: {legacy.ToolShovel, 0, 12},
+	285: {legacy.ToolPickaxe, 0, 12},
+	286: {legacy.ToolAxe, 0, 12},
+	359: {legacy.ToolShears, 0, 1.5},
+}
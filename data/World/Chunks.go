@@ -18,6 +18,11 @@ type Chunk struct {
 	HeightMaps  HeightMaps
 	BlockEntity []BlockEntity
 	Status      ChunkStatus
+	// Biomes of a 1.12.2 column, one byte per XZ position.
+	// Could be nil if the server never sent a full chunk.
+	Biomes []byte // len() == 256
+
+	lastUsed uint64
 }
 
 func EmptyChunk(secs int) *Chunk {
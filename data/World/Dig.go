@@ -0,0 +1,95 @@
+package World
+
+import (
+	. "github.com/edouard127/mc-go-1.12.2/data"
+	"github.com/edouard127/mc-go-1.12.2/data/World/block/legacy"
+	"math"
+)
+
+// Digger is what changes how fast a player breaks a block
+type Digger struct {
+	Held          ToolItem // the zero value is the hand
+	Efficiency    int      // the level of the Efficiency enchantment of the held item
+	Haste         int      // the level of the effects, 0 if the player doesn't have it
+	MiningFatigue int
+	OnGround      bool
+	InWater       bool // the head of the player is in water
+	AquaAffinity  bool // the helmet has the Aqua Affinity enchantment
+	Creative      bool
+}
+
+// CanHarvest return true if the block drops something when it's broken with the held item
+func (b Block) CanHarvest(d Digger) bool {
+	p := b.Physics()
+	if !p.RequiresTool {
+		return true
+	}
+	if b.Id == 30 && d.Held.Tool == legacy.ToolShears { // cobweb
+		return true
+	}
+	return d.Held.Tool == p.Tool && d.Held.Level >= p.HarvestLevel
+}
+
+// digSpeed return how fast the held item digs the block, 1 for the hand
+func (b Block) digSpeed(d Digger) float64 {
+	switch {
+	case d.Held.Tool == legacy.ToolShears && (b.Id == 30 || b.Id == 18 || b.Id == 161): // cobweb and leaves
+		return 15
+	case d.Held.Tool == legacy.ToolShears && b.Id == 35: // wool
+		return 5
+	case d.Held.Tool == legacy.ToolSword && b.Id == 30:
+		return 15
+	case d.Held.Tool == legacy.ToolSword && (b.Id == 18 || b.Id == 161 || b.Id == 106 || b.Id == 86 || b.Id == 103):
+		return 1.5 // leaves, vines, pumpkins and melons
+	case d.Held.Tool != legacy.ToolNone && d.Held.Tool == b.Physics().Tool:
+		return d.Held.Speed
+	}
+	return 1
+}
+
+// DigTicks return the number of ticks to break the block, 0 if it breaks instantly.
+// Return -1 if it can't be broken.
+// This follows the 1.12.2 client, so the server accepts the block as broken when it's over.
+func (b Block) DigTicks(d Digger) int {
+	hardness := b.Hardness()
+	if hardness < 0 {
+		return -1
+	}
+	if d.Creative || hardness == 0 {
+		return 0
+	}
+
+	speed := b.digSpeed(d)
+	if speed > 1 && d.Efficiency > 0 {
+		speed += float64(d.Efficiency*d.Efficiency + 1)
+	}
+	if d.Haste > 0 {
+		speed *= 1 + float64(d.Haste)*0.2
+	}
+	switch d.MiningFatigue {
+	case 0:
+	case 1:
+		speed *= 0.3
+	case 2:
+		speed *= 0.09
+	case 3:
+		speed *= 0.0027
+	default:
+		speed *= 0.00081
+	}
+	if d.InWater && !d.AquaAffinity {
+		speed /= 5
+	}
+	if !d.OnGround {
+		speed /= 5
+	}
+
+	damage := speed / hardness / 100 // progress per tick
+	if b.CanHarvest(d) {
+		damage = speed / hardness / 30
+	}
+	if damage >= 1 {
+		return 0
+	}
+	return int(math.Ceil(1 / damage))
+}
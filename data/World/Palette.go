@@ -2,6 +2,7 @@ package World
 
 import (
 	"github.com/edouard127/mc-go-1.12.2/data/World/block"
+	"github.com/edouard127/mc-go-1.12.2/data/World/block/legacy"
 	"io"
 	"math/bits"
 	"strconv"
@@ -62,6 +63,44 @@ func NewStatesPaletteContainerWithData(length int, data []uint64, pat []BlocksSt
 	}
 }
 
+// NewStatesPaletteContainerWithIndices create a container from palette indices
+// already unpacked from a data array, such as the one sent in a 1.12.2 Chunk Data packet.
+// If pat is nil, the indices are global state IDs and the global palette is used.
+func NewStatesPaletteContainerWithIndices(indices []int, pat []BlocksState) *PaletteContainer[BlocksState] {
+	var n int
+	var p palette[BlocksState]
+	if pat == nil {
+		n = legacy.BitsPerBlock
+		p = &globalPalette[BlocksState]{}
+	} else {
+		n = statesCfg{}.bits(bits.Len(uint(len(pat) - 1)))
+		values := make([]BlocksState, len(pat), 1<<n)
+		copy(values, pat)
+		switch n {
+		case 0:
+			p = &singleValuePalette[BlocksState]{values[0]}
+		case 4:
+			p = &linearPalette[BlocksState]{values: values, bits: n}
+		default:
+			ids := make(map[BlocksState]int, len(values))
+			for i, v := range values {
+				ids[v] = i
+			}
+			p = &hashPalette[BlocksState]{ids: ids, values: values, bits: n}
+		}
+	}
+	data := NewBitStorage(n, len(indices), nil)
+	for i, v := range indices {
+		data.Set(i, v)
+	}
+	return &PaletteContainer[BlocksState]{
+		bits:    n,
+		config:  statesCfg{},
+		palette: p,
+		data:    data,
+	}
+}
+
 func NewBiomesPaletteContainer(length int, defaultValue BiomesState) *PaletteContainer[BiomesState] {
 	return &PaletteContainer[BiomesState]{
 		bits:    0,
@@ -102,27 +141,28 @@ func (p *PaletteContainer[T]) Set(i int, v T) {
 		p.data.Set(i, vv)
 	} else {
 		// resize
-		oldLen := p.data.Len()
+		n := p.config.bits(vv)
+		length := p.data.Len()
 		newPalette := PaletteContainer[T]{
-			bits:    vv,
+			bits:    n,
 			config:  p.config,
-			palette: p.config.create(vv),
-			data:    NewBitStorage(vv, oldLen+1, nil),
+			palette: p.config.create(n),
+			data:    NewBitStorage(n, length, nil),
 		}
 		// copy
-		for i := 0; i < oldLen; i++ {
-			raw := p.data.Get(i)
-			if vv, ok := newPalette.palette.id(T(raw)); !ok {
+		for j := 0; j < length; j++ {
+			old := p.palette.value(p.data.Get(j))
+			if vv, ok := newPalette.palette.id(old); !ok {
 				panic("not reachable")
 			} else {
-				newPalette.data.Set(i, vv)
+				newPalette.data.Set(j, vv)
 			}
 		}
 
 		if vv, ok := newPalette.palette.id(v); !ok {
 			panic("not reachable")
 		} else {
-			newPalette.data.Set(oldLen, vv)
+			newPalette.data.Set(i, vv)
 		}
 		*p = newPalette
 	}
@@ -144,7 +184,7 @@ func (s statesCfg) bits(bits int) int {
 	case 5, 6, 7, 8:
 		return bits
 	default:
-		return bits
+		return legacy.BitsPerBlock
 	}
 }
 
@@ -0,0 +1,290 @@
+package World
+
+import (
+	. "github.com/edouard127/mc-go-1.12.2/data/entities"
+	. "github.com/edouard127/mc-go-1.12.2/maths"
+	"math"
+)
+
+// Movement constants of the vanilla client, per tick
+const (
+	Gravity      = 0.08
+	AirDrag      = 0.98
+	StepHeight   = 0.6
+	JumpVelocity = 0.42
+	WalkSpeed    = 0.1  // the movement speed attribute of a player
+	AirSpeed     = 0.02 // the acceleration of a player in the air
+)
+
+// Controls are the keys held by the player
+type Controls struct {
+	Forward float64 // 1 to walk forward, -1 to walk backward
+	Strafe  float64 // 1 to walk to the left, -1 to the right
+	Jump    bool
+	Sneak   bool
+	Sprint  bool
+}
+
+// PlayerPhysics simulate the movement of the player one tick at a time like the vanilla client does,
+// so that the server accepts it.
+type PlayerPhysics struct {
+	Controls
+
+	Sprinting            bool
+	InWater, InLava      bool
+	OnLadder             bool
+	CollidedHorizontally bool
+	CollidedVertically   bool
+
+	jumpTicks int // the player can't jump again before it's 0
+}
+
+// Tick move the player p in w by one tick
+func (ph *PlayerPhysics) Tick(w *World, p *Player) {
+	if !w.IsLoaded(p.Position) {
+		// The vanilla client doesn't move until the chunk is loaded
+		return
+	}
+	v := p.Velocity
+	if math.Abs(v.X) < 0.003 {
+		v.X = 0
+	}
+	if math.Abs(v.Y) < 0.003 {
+		v.Y = 0
+	}
+	if math.Abs(v.Z) < 0.003 {
+		v.Z = 0
+	}
+
+	bb := p.BoundingBox()
+	ph.InWater = w.containsBlock(bb.Grow(Vector3{X: -0.001, Y: -0.401, Z: -0.001}), Block.IsWater)
+	ph.InLava = w.containsBlock(bb.Grow(Vector3{X: -0.1, Y: -0.4, Z: -0.1}), Block.IsLava)
+	ph.OnLadder = w.onLadder(p)
+
+	forward, strafe := ph.Forward, ph.Strafe
+	if ph.Sneak {
+		forward, strafe = forward*0.3, strafe*0.3
+	}
+	// Sprinting needs to walk forward and to not be hungry
+	if ph.Sprint && forward >= 0.8 && p.Food > 6 && !ph.CollidedHorizontally {
+		ph.Sprinting = true
+	} else if forward < 0.8 || p.Food <= 6 || ph.CollidedHorizontally {
+		ph.Sprinting = false
+	}
+
+	yaw := float64(p.Rotation.X) * math.Pi / 180
+	if ph.jumpTicks > 0 {
+		ph.jumpTicks--
+	}
+	if ph.Jump {
+		switch {
+		case ph.InWater || ph.InLava:
+			v.Y += 0.04
+		case p.OnGround && ph.jumpTicks == 0:
+			v.Y = JumpVelocity + 0.1*float64(p.EffectLevel(EffectJumpBoost))
+			if ph.Sprinting {
+				v.X -= math.Sin(yaw) * 0.2
+				v.Z += math.Cos(yaw) * 0.2
+			}
+			ph.jumpTicks = 10
+		}
+	} else {
+		ph.jumpTicks = 0
+	}
+	forward, strafe = forward*0.98, strafe*0.98
+
+	switch {
+	case ph.InWater || ph.InLava:
+		y := p.Position.Y
+		v = moveRelative(v, forward, strafe, 0.02, yaw)
+		v = ph.move(w, p, v)
+		drag := 0.8
+		if !ph.InWater {
+			drag = 0.5
+		}
+		v = Vector3{X: v.X * drag, Y: v.Y*drag - 0.02, Z: v.Z * drag}
+		// Jump out of the liquid when swimming against the bank
+		if ph.CollidedHorizontally && w.isFree(p.BoundingBox().Offset(Vector3{X: v.X, Y: v.Y + 0.6 - p.Position.Y + y, Z: v.Z})) {
+			v.Y = 0.3
+		}
+	default:
+		slip := 0.91
+		speed := AirSpeed
+		if ph.Sprinting {
+			speed += AirSpeed * 0.3
+		}
+		if p.OnGround {
+			slip = w.GetBlock(p.Position.Add(Vector3{Y: -1})).Slipperiness() * 0.91
+			speed = ph.moveSpeed(p) * 0.16277136 / (slip * slip * slip)
+		}
+		v = moveRelative(v, forward, strafe, speed, yaw)
+		if ph.OnLadder {
+			v.X = math.Max(-0.15, math.Min(v.X, 0.15))
+			v.Z = math.Max(-0.15, math.Min(v.Z, 0.15))
+			v.Y = math.Max(v.Y, -0.15)
+			if ph.Sneak && v.Y < 0 {
+				v.Y = 0
+			}
+		}
+		v = ph.move(w, p, v)
+		if ph.CollidedHorizontally && w.onLadder(p) {
+			v.Y = 0.2
+		}
+		v = Vector3{X: v.X * slip, Y: (v.Y - Gravity) * AirDrag, Z: v.Z * slip}
+	}
+	p.SetVelocity(v)
+}
+
+// moveSpeed return the movement speed attribute of the player with its modifiers
+func (ph *PlayerPhysics) moveSpeed(p *Player) float64 {
+	speed := WalkSpeed
+	if ph.Sprinting {
+		speed *= 1.3
+	}
+	speed *= 1 + 0.2*float64(p.EffectLevel(EffectSpeed))
+	speed *= 1 - 0.15*float64(p.EffectLevel(EffectSlowness))
+	return math.Max(speed, 0)
+}
+
+// moveRelative accelerate v by the inputs, in the direction of yaw
+func moveRelative(v Vector3, forward, strafe, speed, yaw float64) Vector3 {
+	f := forward*forward + strafe*strafe
+	if f < 1e-4 {
+		return v
+	}
+	f = speed / math.Max(math.Sqrt(f), 1)
+	forward, strafe = forward*f, strafe*f
+	sin, cos := math.Sin(yaw), math.Cos(yaw)
+	return Vector3{X: v.X + strafe*cos - forward*sin, Y: v.Y, Z: v.Z + forward*cos + strafe*sin}
+}
+
+// move the player by v, stopped by the blocks, and return what's left of v
+func (ph *PlayerPhysics) move(w *World, p *Player, v Vector3) Vector3 {
+	bb := p.BoundingBox()
+	dx, dy, dz := v.X, v.Y, v.Z
+
+	// Sneaking players don't fall from the edge of blocks
+	if ph.Sneak && p.OnGround {
+		for dx != 0 && !w.collides(bb.Offset(Vector3{X: dx, Y: -StepHeight})) {
+			dx = towardZero(dx)
+		}
+		for dz != 0 && !w.collides(bb.Offset(Vector3{Y: -StepHeight, Z: dz})) {
+			dz = towardZero(dz)
+		}
+		for dx != 0 && dz != 0 && !w.collides(bb.Offset(Vector3{X: dx, Y: -StepHeight, Z: dz})) {
+			dx, dz = towardZero(dx), towardZero(dz)
+		}
+	}
+	ox, oy, oz := dx, dy, dz
+
+	moved, dx, dy, dz := w.clip(bb, dx, dy, dz)
+	onGround := oy != dy && oy < 0
+	if (p.OnGround || onGround) && (ox != dx || oz != dz) {
+		// Try to step up the obstacle
+		stepped, sx, sy, sz := w.clip(bb, ox, StepHeight, oz)
+		down := -sy
+		for _, box := range w.collidingBoxes(stepped.Expand(Vector3{Y: down})) {
+			down = box.YOffset(stepped, down)
+		}
+		stepped = stepped.Offset(Vector3{Y: down})
+		if sx*sx+sz*sz > dx*dx+dz*dz {
+			moved, dx, dy, dz = stepped, sx, sy+down, sz
+		}
+	}
+
+	ph.CollidedHorizontally = ox != dx || oz != dz
+	ph.CollidedVertically = oy != dy
+	if ox != dx {
+		v.X = 0
+	}
+	if oy != dy {
+		v.Y = 0
+	}
+	if oz != dz {
+		v.Z = 0
+	}
+	p.Entity.SetPosition(Vector3{
+		X: (moved.Min.X + moved.Max.X) / 2,
+		Y: moved.Min.Y,
+		Z: (moved.Min.Z + moved.Max.Z) / 2,
+	}, ph.CollidedVertically && oy < 0)
+	return v
+}
+
+// clip move bb along Y, X then Z, and stop at the first block on each axis
+func (w *World) clip(bb AABB, dx, dy, dz float64) (AABB, float64, float64, float64) {
+	boxes := w.collidingBoxes(bb.Expand(Vector3{X: dx, Y: dy, Z: dz}))
+	for _, box := range boxes {
+		dy = box.YOffset(bb, dy)
+	}
+	bb = bb.Offset(Vector3{Y: dy})
+	for _, box := range boxes {
+		dx = box.XOffset(bb, dx)
+	}
+	bb = bb.Offset(Vector3{X: dx})
+	for _, box := range boxes {
+		dz = box.ZOffset(bb, dz)
+	}
+	bb = bb.Offset(Vector3{Z: dz})
+	return bb, dx, dy, dz
+}
+
+func towardZero(d float64) float64 {
+	switch {
+	case d < 0.05 && d >= -0.05:
+		return 0
+	case d > 0:
+		return d - 0.05
+	default:
+		return d + 0.05
+	}
+}
+
+// collidingBoxes return the collision boxes of the blocks which intersect bb
+func (w *World) collidingBoxes(bb AABB) (boxes []AABB) {
+	w.forEachBlock(bb, -1, func(v3 Vector3) {
+		for _, box := range w.CollisionBoxes(v3) {
+			if box.Intersects(bb) {
+				boxes = append(boxes, box)
+			}
+		}
+	})
+	return
+}
+
+// collides return true if bb collides with a block, the liquids don't count
+func (w *World) collides(bb AABB) bool {
+	return len(w.collidingBoxes(bb)) > 0
+}
+
+// isFree return true if bb doesn't collide with any block nor contain a liquid
+func (w *World) isFree(bb AABB) bool {
+	return len(w.collidingBoxes(bb)) == 0 && !w.containsBlock(bb, Block.IsLiquid)
+}
+
+// containsBlock return true if a block in bb matches f
+func (w *World) containsBlock(bb AABB, f func(Block) bool) (ok bool) {
+	w.forEachBlock(bb, 0, func(v3 Vector3) {
+		ok = ok || f(w.GetBlock(v3))
+	})
+	return
+}
+
+// forEachBlock call f with the position of each block in bb.
+// below is added to the lowest layer, to find the fences under bb.
+func (w *World) forEachBlock(bb AABB, below int, f func(Vector3)) {
+	x0, x1 := int(math.Floor(bb.Min.X)), int(math.Ceil(bb.Max.X))
+	y0, y1 := int(math.Floor(bb.Min.Y))+below, int(math.Ceil(bb.Max.Y))
+	z0, z1 := int(math.Floor(bb.Min.Z)), int(math.Ceil(bb.Max.Z))
+	for x := x0; x < x1; x++ {
+		for y := y0; y < y1; y++ {
+			for z := z0; z < z1; z++ {
+				f(Vector3{X: float64(x), Y: float64(y), Z: float64(z)})
+			}
+		}
+	}
+}
+
+func (w *World) onLadder(p *Player) bool {
+	return w.GetBlock(p.Position).IsClimbable()
+}
@@ -1,16 +1,26 @@
 package World
 
 import (
+	"fmt"
 	. "github.com/edouard127/mc-go-1.12.2/data"
+	"github.com/edouard127/mc-go-1.12.2/data/World/block/legacy"
 	. "github.com/edouard127/mc-go-1.12.2/data/entities"
 	. "github.com/edouard127/mc-go-1.12.2/maths"
+	"math"
+	"sort"
+	"sync"
 )
 
-// World record all the things in the World where player at
+// World record all the things in the World where player at.
+// The blocks can be read from other goroutines with GetBlock and IsLoaded while the game changes them,
+// so the columns must only be changed with the methods of World.
 type World struct {
 	Entities map[int32]*Entity
 	Columns  map[ChunkPos]*Chunk
 	Time     WorldTime
+
+	mu    sync.RWMutex // locked to change the columns and their blocks
+	clock uint64       // incremented each time a column is used, for LRU eviction
 }
 
 type WorldTime struct {
@@ -24,12 +34,142 @@ func (w *World) SetTime(t WorldTime) WorldTime {
 	return t
 }
 
+// LoadChunk store a column received from the server at pos.
+// If full is false, only the sections whose bit is set in mask
+// replace the ones of the column already loaded.
+func (w *World) LoadChunk(pos ChunkPos, c *Chunk, mask int32, full bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.clock++
+	old, ok := w.Columns[pos]
+	if full || !ok {
+		c.lastUsed = w.clock
+		w.Columns[pos] = c
+		return
+	}
+	old.lastUsed = w.clock
+	for i := range c.Sections {
+		if mask&(1<<uint(i)) != 0 {
+			old.Sections[i] = c.Sections[i]
+		}
+	}
+	// The block entities of the replaced sections are sent again
+	kept := old.BlockEntity[:0]
+	for _, e := range old.BlockEntity {
+		if mask&(1<<uint(e.Y/16)) == 0 {
+			kept = append(kept, e)
+		}
+	}
+	old.BlockEntity = append(kept, c.BlockEntity...)
+}
+
+// UnloadAll forget every loaded column, e.g. when the player changes dimension.
+func (w *World) UnloadAll() {
+	w.mu.Lock()
+	w.Columns = make(map[ChunkPos]*Chunk)
+	w.mu.Unlock()
+}
+
+// UnloadChunk forget the column at pos and the entities in it.
+func (w *World) UnloadChunk(pos ChunkPos) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.unloadChunk(pos)
+}
+
+func (w *World) unloadChunk(pos ChunkPos) {
+	delete(w.Columns, pos)
+	for id, e := range w.Entities {
+		if ChunkPosOf(e.Position) == pos {
+			delete(w.Entities, id)
+		}
+	}
+}
+
+// Evict unload the least recently used columns until at most max columns stay loaded.
+// Columns within viewDistance of center are never evicted, so more than max columns
+// could be kept. Return the positions of the unloaded columns.
+func (w *World) Evict(center ChunkPos, viewDistance, max int) (evicted []ChunkPos) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if max <= 0 || len(w.Columns) <= max {
+		return nil
+	}
+	var candidates []ChunkPos
+	for pos := range w.Columns {
+		dx, dz := int(pos[0]-center[0]), int(pos[1]-center[1])
+		if dx > viewDistance || dx < -viewDistance || dz > viewDistance || dz < -viewDistance {
+			candidates = append(candidates, pos)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return w.Columns[candidates[i]].lastUsed < w.Columns[candidates[j]].lastUsed
+	})
+	for _, pos := range candidates {
+		if len(w.Columns) <= max {
+			break
+		}
+		w.unloadChunk(pos)
+		evicted = append(evicted, pos)
+	}
+	return
+}
+
+// ChunkPosOf return the position of the column containing v3
+func ChunkPosOf(v3 Vector3) ChunkPos {
+	return ChunkPos{int32(math.Floor(v3.X)) >> 4, int32(math.Floor(v3.Z)) >> 4}
+}
+
+// Touch mark the column at pos as used, the columns used the least recently are evicted first
+func (w *World) Touch(pos ChunkPos) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if c, ok := w.Columns[pos]; ok {
+		w.clock++
+		c.lastUsed = w.clock
+	}
+}
+
+// section return the section holding the block at v3 and the index of the block in it.
+// Return nil if the column isn't loaded or v3 is out of the world height.
+func (w *World) section(v3 Vector3) (*Section, int) {
+	x, y, z := int(math.Floor(v3.X)), int(math.Floor(v3.Y)), int(math.Floor(v3.Z))
+	c, ok := w.Columns[ChunkPos{int32(x >> 4), int32(z >> 4)}]
+	if !ok || y < 0 || y >= len(c.Sections)*16 {
+		return nil, 0
+	}
+	return &c.Sections[y>>4], (y&15)<<8 | (z&15)<<4 | x&15
+}
+
+// IsLoaded return true if the column containing v3 is loaded
+func (w *World) IsLoaded(v3 Vector3) bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	_, ok := w.Columns[ChunkPosOf(v3)]
+	return ok
+}
+
+// SetBlock set the block at v3. Nothing happens if the column isn't loaded.
 func (w *World) SetBlock(v3 Vector3, b Block) {
-	// TODO
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	s, i := w.section(v3)
+	if s == nil {
+		return
+	}
+	if !BlockFromState(s.GetBlock(i)).IsAir() {
+		s.BlockCount--
+	}
+	if !b.IsAir() {
+		s.BlockCount++
+	}
+	s.States.Set(i, b.State())
 }
 
-func (w *World) UpdateBlock(chunk Vector2, v3 Vector3, id int32) {
-	// TODO
+// UpdateBlock set the block at v3 from a global state ID (id<<4 | meta)
+// as sent in Block Change and Multi Block Change packets.
+func (w *World) UpdateBlock(v3 Vector3, id int32) {
+	w.SetBlock(v3, BlockFromState(BlocksState(id)))
 }
 
 func (w *World) UpdateTime(t int64) {
@@ -83,6 +223,9 @@ func (w *World) CreateEntity(object CreateObject) *Entity {
 		},
 	}
 
+	if size, ok := ObjectSizes[object.TypeID]; ok {
+		e.Width, e.Height = size[0], size[1]
+	}
 	w.Entities[object.EntityID] = e
 
 	return e
@@ -98,13 +241,18 @@ type Block struct {
 	Metadata uint
 }
 
-func (b Block) IsAir() bool {
-	return b.Id == 0
+// BlockFromState split a global state ID (id<<4 | meta) into a Block
+func BlockFromState(s BlocksState) Block {
+	return Block{Id: uint(s >> 4), Metadata: uint(s & 0xF)}
 }
 
-func (b Block) IsSolid() bool {
-	// TODO: add block data
-	return b.Id != 0
+// State return the global state ID of the block
+func (b Block) State() BlocksState {
+	return BlocksState(b.Id<<4 | b.Metadata&0xF)
+}
+
+func (b Block) IsAir() bool {
+	return b.Id == 0
 }
 
 type ChunkLoc struct {
@@ -124,18 +272,170 @@ const (
 	East
 )
 
-// GetBlock return the block in the position (x, y, z)
+// Offset return the position of the block against this face, relative to the block
+func (f Face) Offset() Vector3 {
+	switch f {
+	case Bottom:
+		return Vector3{Y: -1}
+	case Top:
+		return Vector3{Y: 1}
+	case North:
+		return Vector3{Z: -1}
+	case South:
+		return Vector3{Z: 1}
+	case West:
+		return Vector3{X: -1}
+	default:
+		return Vector3{X: 1}
+	}
+}
+
+// Opposite return the face on the other side of the block
+func (f Face) Opposite() Face {
+	return f ^ 1
+}
+
+// GetBlock return the block in the position (x, y, z).
+// Return air if the column isn't loaded or the position is out of the world height.
 func (w *World) GetBlock(v3 Vector3) Block {
-	// TODO
-	return Block{}
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	s, i := w.section(v3)
+	if s == nil {
+		return Block{}
+	}
+	return BlockFromState(s.GetBlock(i))
+}
+
+// RayTraceResult is the block hit by a ray
+type RayTraceResult struct {
+	Block    Block
+	Position Vector3 // the position of the block
+	Hit      Vector3 // the exact point hit
+	Face     Face
+	Distance float64
+}
+
+// RayTrace cast a ray from v3 toward the yaw v2.X and the pitch v2.Y, and return
+// the first block whose collision boxes it hits within maxDistance.
+// Blocks without collision box, like liquids or flowers, are ignored.
+func (w *World) RayTrace(v3 Vector3, v2 Vector2, maxDistance float64) (RayTraceResult, bool) {
+	return w.RayTraceDirection(v3, GetVectorFromRotation(v2), maxDistance)
+}
+
+// RayTraceDirection is RayTrace along dir
+func (w *World) RayTraceDirection(origin, dir Vector3, maxDistance float64) (RayTraceResult, bool) {
+	l := math.Sqrt(dir.X*dir.X + dir.Y*dir.Y + dir.Z*dir.Z)
+	if l == 0 {
+		return RayTraceResult{}, false
+	}
+	dir = Vector3{X: dir.X / l, Y: dir.Y / l, Z: dir.Z / l}
+
+	// Amanatides and Woo's voxel traversal
+	pos := [3]float64{math.Floor(origin.X), math.Floor(origin.Y), math.Floor(origin.Z)}
+	o, d := [3]float64{origin.X, origin.Y, origin.Z}, [3]float64{dir.X, dir.Y, dir.Z}
+	var step, tMax, tDelta [3]float64
+	for i := range d {
+		switch {
+		case d[i] > 0:
+			step[i], tMax[i], tDelta[i] = 1, (pos[i]+1-o[i])/d[i], 1/d[i]
+		case d[i] < 0:
+			step[i], tMax[i], tDelta[i] = -1, (o[i]-pos[i])/-d[i], -1/d[i]
+		default:
+			tMax[i], tDelta[i] = math.Inf(1), math.Inf(1)
+		}
+	}
+
+	var best RayTraceResult
+	found := false
+	for t := 0.0; t <= maxDistance; {
+		block := Vector3{X: pos[0], Y: pos[1], Z: pos[2]}
+		// fences and walls are higher than one block, so the block under is tested too
+		for _, p := range [...]Vector3{block, block.Add(Vector3{Y: -1})} {
+			for _, box := range w.CollisionBoxes(p) {
+				bt, normal, ok := box.RayIntersect(origin, dir)
+				if ok && bt <= maxDistance && (!found || bt < best.Distance) {
+					found = true
+					best = RayTraceResult{
+						Block:    w.GetBlock(p),
+						Position: p,
+						Hit:      origin.Add(dir.Mul(Vector3{X: bt, Y: bt, Z: bt})),
+						Face:     faceOfNormal(normal),
+						Distance: bt,
+					}
+				}
+			}
+		}
+		// go to the next block
+		i := 0
+		if tMax[1] < tMax[i] {
+			i = 1
+		}
+		if tMax[2] < tMax[i] {
+			i = 2
+		}
+		if found && best.Distance <= tMax[i] {
+			return best, true
+		}
+		t = tMax[i]
+		pos[i] += step[i]
+		tMax[i] += tDelta[i]
+	}
+	return best, found
+}
+
+// RayTraceEntity cast a ray like RayTrace and return the first entity whose bounding box it hits,
+// and the point hit. The entities behind a block are ignored, as well as those the ray starts in.
+func (w *World) RayTraceEntity(v3 Vector3, v2 Vector2, maxDistance float64) (*Entity, Vector3, bool) {
+	dir := GetVectorFromRotation(v2)
+	if r, ok := w.RayTraceDirection(v3, dir, maxDistance); ok {
+		maxDistance = r.Distance
+	}
+	var (
+		closest *Entity
+		dist    float64
+	)
+	for _, e := range w.Entities {
+		if t, _, ok := e.BoundingBox().RayIntersect(v3, dir); ok && t <= maxDistance && (closest == nil || t < dist) {
+			closest, dist = e, t
+		}
+	}
+	if closest == nil {
+		return nil, Vector3{}, false
+	}
+	return closest, v3.Add(dir.Mul(Vector3{X: dist, Y: dist, Z: dist})), true
 }
 
-// RayTrace return the block that the ray hit
-func (w *World) RayTrace(v3 Vector3, v2 Vector2, maxDistance float64) (Block, Vector3, Face) {
-	// TODO
-	return Block{}, Vector3{}, Bottom
+func faceOfNormal(n Vector3) Face {
+	switch {
+	case n.Y < 0:
+		return Bottom
+	case n.Y > 0:
+		return Top
+	case n.Z < 0:
+		return North
+	case n.Z > 0:
+		return South
+	case n.X < 0:
+		return West
+	default:
+		return East
+	}
 }
 
+// Properties return the 1.12.2 block state with its properties decoded
+// from the metadata, or nil if the metadata isn't valid for this block.
+func (b Block) Properties() legacy.Block {
+	if b.Id >= uint(len(legacy.Names)) {
+		return nil
+	}
+	return legacy.ByStateID(legacy.StateID(b.State()))
+}
+
+// String return the name of the block, like "minecraft:stone"
 func (b Block) String() string {
-	return BlockNameByID[b.Id]
+	if b.Id < uint(len(legacy.Names)) && legacy.Names[b.Id] != "" {
+		return legacy.Names[b.Id]
+	}
+	return fmt.Sprintf("unknown block %d:%d", b.Id, b.Metadata)
 }
@@ -0,0 +1,250 @@
+package World
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/edouard127/mc-go-1.12.2/data"
+	"github.com/edouard127/mc-go-1.12.2/data/World/block/legacy"
+	. "github.com/edouard127/mc-go-1.12.2/data/entities"
+	. "github.com/edouard127/mc-go-1.12.2/maths"
+)
+
+func TestWorld_SetBlock(t *testing.T) {
+	w := World{Columns: make(map[ChunkPos]*Chunk)}
+	w.LoadChunk(ChunkPos{-1, 0}, EmptyChunk(16), 0xFFFF, true)
+	w.LoadChunk(ChunkPos{0, -1}, EmptyChunk(16), 0xFFFF, true)
+
+	stone := Block{Id: 1}
+	w.SetBlock(Vector3{X: -1, Y: 64, Z: 15}, stone)
+	if got := w.GetBlock(Vector3{X: -0.5, Y: 64.9, Z: 15.2}); got != stone {
+		t.Errorf("GetBlock at negative X: got %v, want %v", got, stone)
+	}
+	if got := w.Columns[ChunkPos{-1, 0}].Sections[4].GetBlock(0<<8 | 15<<4 | 15); got != stone.State() {
+		t.Errorf("block stored at the wrong index")
+	}
+	if got := w.GetBlock(Vector3{X: 0, Y: 64, Z: -1}); !got.IsAir() {
+		t.Errorf("unexpected block %v", got)
+	}
+
+	// Out of the world or in an unloaded column
+	for _, v3 := range []Vector3{{X: -1, Y: -1, Z: 15}, {X: -1, Y: 256, Z: 15}, {X: 16, Y: 64, Z: 0}} {
+		w.SetBlock(v3, stone)
+		if got := w.GetBlock(v3); !got.IsAir() {
+			t.Errorf("GetBlock(%v) = %v, want air", v3, got)
+		}
+	}
+
+	// Enough different states to go through every palette
+	for i := 0; i < 16*16*16; i++ {
+		v3 := Vector3{X: float64(i&15) - 16, Y: float64(i >> 8), Z: float64(i >> 4 & 15)}
+		w.UpdateBlock(v3, int32(i))
+	}
+	for i := 0; i < 16*16*16; i++ {
+		v3 := Vector3{X: float64(i&15) - 16, Y: float64(i >> 8), Z: float64(i >> 4 & 15)}
+		if got := w.GetBlock(v3); got != BlockFromState(BlocksState(i)) {
+			t.Fatalf("GetBlock(%v) = %v, want state %d", v3, got, i)
+		}
+	}
+	if n := w.Columns[ChunkPos{-1, 0}].Sections[0].States.bits; n != legacy.BitsPerBlock {
+		t.Errorf("global palette of %d bits, want the %d bits of 1.12.2", n, legacy.BitsPerBlock)
+	}
+	if n := w.Columns[ChunkPos{-1, 0}].Sections[0].BlockCount; n != 16*16*16-16 {
+		t.Errorf("BlockCount = %d, want %d", n, 16*16*16-16)
+	}
+}
+
+func TestWorld_Evict(t *testing.T) {
+	w := World{
+		Columns:  make(map[ChunkPos]*Chunk),
+		Entities: make(map[int32]*Entity),
+	}
+	for x := int32(-3); x <= 3; x++ {
+		w.LoadChunk(ChunkPos{x, 0}, EmptyChunk(16), 0xFFFF, true)
+	}
+	w.Entities[1] = &Entity{ID: 1, Position: Vector3{X: -40, Y: 64, Z: 3}}
+	w.Entities[2] = &Entity{ID: 2, Position: Vector3{X: 8, Y: 64, Z: 3}}
+	// use the column at x = 3 so it is evicted after x = -3, reading a block doesn't use it
+	w.GetBlock(Vector3{X: -40, Y: 64, Z: 0})
+	w.Touch(ChunkPos{3, 0})
+
+	evicted := w.Evict(ChunkPos{0, 0}, 1, 4)
+	want := []ChunkPos{{-3, 0}, {-2, 0}, {2, 0}}
+	if len(evicted) != len(want) {
+		t.Fatalf("evicted %v, want %v", evicted, want)
+	}
+	for i := range want {
+		if evicted[i] != want[i] {
+			t.Fatalf("evicted %v, want %v", evicted, want)
+		}
+	}
+	if _, ok := w.Columns[ChunkPos{3, 0}]; !ok {
+		t.Error("recently used column evicted")
+	}
+	if w.HasEntity(1) || !w.HasEntity(2) {
+		t.Error("entities of evicted columns not pruned")
+	}
+
+	// The view distance is never evicted
+	if evicted := w.Evict(ChunkPos{0, 0}, 2, 1); len(evicted) != 1 || len(w.Columns) != 3 {
+		t.Errorf("evicted %v, %d columns left", evicted, len(w.Columns))
+	}
+}
+
+func TestBlock_DigTicks(t *testing.T) {
+	var (
+		stone    = Block{Id: 1}
+		dirt     = Block{Id: 3}
+		obsidian = Block{Id: 49}
+		web      = Block{Id: 30}
+
+		woodenPickaxe  = ToolItems[270]
+		diamondPickaxe = ToolItems[278]
+	)
+	for _, test := range []struct {
+		name  string
+		b     Block
+		d     Digger
+		ticks int
+	}{
+		{"stone by hand", stone, Digger{OnGround: true}, 150},
+		{"stone with a wooden pickaxe", stone, Digger{Held: woodenPickaxe, OnGround: true}, 23},
+		{"stone with efficiency V", stone, Digger{Held: diamondPickaxe, Efficiency: 5, OnGround: true}, 2},
+		{"dirt with a pickaxe", dirt, Digger{Held: diamondPickaxe, OnGround: true}, 15},
+		{"obsidian with a diamond pickaxe", obsidian, Digger{Held: diamondPickaxe, OnGround: true}, 188},
+		{"obsidian with a wooden pickaxe", obsidian, Digger{Held: woodenPickaxe, OnGround: true}, 2500},
+		{"stone with haste II", stone, Digger{Held: woodenPickaxe, Haste: 2, OnGround: true}, 17},
+		{"stone with mining fatigue", stone, Digger{Held: woodenPickaxe, MiningFatigue: 1, OnGround: true}, 75},
+		{"stone in the air", stone, Digger{Held: woodenPickaxe}, 113},
+		{"stone in water", stone, Digger{Held: woodenPickaxe, InWater: true, OnGround: true}, 113},
+		{"aqua affinity", stone, Digger{Held: woodenPickaxe, InWater: true, AquaAffinity: true, OnGround: true}, 23},
+		{"cobweb with shears", web, Digger{Held: ToolItems[359], OnGround: true}, 8},
+		{"tall grass", Block{Id: 31, Metadata: 1}, Digger{}, 0},
+		{"gold pickaxe instantly", Block{Id: 87}, Digger{Held: ToolItems[285], OnGround: true}, 0},
+		{"creative", obsidian, Digger{Creative: true}, 0},
+		{"bedrock", Block{Id: 7}, Digger{Creative: true}, -1},
+	} {
+		if got := test.b.DigTicks(test.d); got != test.ticks {
+			t.Errorf("%s: got %d ticks, want %d", test.name, got, test.ticks)
+		}
+	}
+	if stone.CanHarvest(Digger{}) || !obsidian.CanHarvest(Digger{Held: diamondPickaxe}) || obsidian.CanHarvest(Digger{Held: ToolItems[257]}) {
+		t.Error("wrong harvest tool")
+	}
+}
+
+func TestWorld_RayTrace(t *testing.T) {
+	w := World{Columns: make(map[ChunkPos]*Chunk), Entities: make(map[int32]*Entity)}
+	w.LoadChunk(ChunkPos{0, 0}, EmptyChunk(16), 0xFFFF, true)
+	w.SetBlock(Vector3{X: 4, Y: 64, Z: 8}, Block{Id: 1})
+	w.SetBlock(Vector3{X: 8, Y: 64, Z: 8}, Block{Id: 85}) // fence, 1.5 blocks high
+	eye := Vector3{X: 4.5, Y: 65.62, Z: 4.5}
+
+	r, ok := w.RayTraceDirection(eye, Vector3{X: 0, Y: -1.12, Z: 3}, 5)
+	if !ok || r.Position != (Vector3{X: 4, Y: 64, Z: 8}) || r.Face != North {
+		t.Errorf("stone: got %+v, %v", r, ok)
+	}
+	if r, ok := w.RayTrace(eye, Vector2{X: 0, Y: 90}, 5); ok {
+		t.Errorf("hit %+v under the player", r)
+	}
+	if _, ok := w.RayTraceDirection(eye, Vector3{X: 0, Y: -1.12, Z: 3}, 3); ok {
+		t.Error("hit beyond max distance")
+	}
+	// The top of the fence is in the cell above it
+	r, ok = w.RayTraceDirection(Vector3{X: 8.5, Y: 66, Z: 6.5}, Vector3{X: 0, Y: -0.6, Z: 1.1}, 5)
+	if !ok || r.Position != (Vector3{X: 8, Y: 64, Z: 8}) || r.Face != North {
+		t.Errorf("fence: got %+v, %v", r, ok)
+	}
+
+	w.Entities[1] = &Entity{ID: 1, Position: Vector3{X: 4.5, Y: 64, Z: 6.5}, Width: 0.6, Height: 1.8}
+	if e, _, ok := w.RayTraceEntity(eye, Vector2{X: 0, Y: 0}, 5); !ok || e.ID != 1 {
+		t.Errorf("entity not hit")
+	}
+	if _, _, ok := w.RayTraceEntity(Vector3{X: 4.5, Y: 64.5, Z: 9.5}, Vector2{X: 180, Y: 0}, 5); ok {
+		t.Error("entity hit through a block")
+	}
+}
+
+func TestPlayerPhysics(t *testing.T) {
+	w := World{Columns: make(map[ChunkPos]*Chunk)}
+	w.LoadChunk(ChunkPos{0, 0}, EmptyChunk(16), 0xFFFF, true)
+	for x := 0; x < 16; x++ {
+		for z := 0; z < 16; z++ {
+			w.SetBlock(Vector3{X: float64(x), Y: 63, Z: float64(z)}, Block{Id: 1})
+		}
+	}
+	w.SetBlock(Vector3{X: 8, Y: 64, Z: 10}, Block{Id: 44}) // slab
+	w.SetBlock(Vector3{X: 8, Y: 64, Z: 13}, Block{Id: 1})  // a wall of two blocks
+	w.SetBlock(Vector3{X: 8, Y: 65, Z: 13}, Block{Id: 1})
+
+	var ph PlayerPhysics
+	p := &Player{Food: 20}
+	p.SetPosition(Vector3{X: 8.5, Y: 70, Z: 4.5})
+	for i := 0; i < 40 && !p.OnGround; i++ {
+		ph.Tick(&w, p)
+	}
+	if !p.OnGround || p.Position.Y != 64 || math.Abs(p.Velocity.Y+0.0784) > 1e-9 {
+		t.Fatalf("fall: at %v on ground %v velocity %v", p.Position, p.OnGround, p.Velocity)
+	}
+
+	// Jump on the spot
+	ph.Jump = true
+	ph.Tick(&w, p)
+	ph.Jump = false
+	top := p.Position.Y
+	for i := 0; i < 20; i++ {
+		ph.Tick(&w, p)
+		top = math.Max(top, p.Position.Y)
+	}
+	if top < 65.2 || top > 65.3 || p.Position.Y != 64 {
+		t.Errorf("jump: %f high, landed at %f", top-64, p.Position.Y)
+	}
+
+	// Walk south over the slab, until the wall
+	ph.Forward = 1
+	for i := 0; i < 10; i++ {
+		ph.Tick(&w, p)
+	}
+	if v := p.Velocity.Z / 0.546; v < 0.2 || v > 0.22 {
+		t.Errorf("walk speed: %f blocks per tick", v)
+	}
+	top = 0
+	for i := 0; i < 40; i++ {
+		ph.Tick(&w, p)
+		top = math.Max(top, p.Position.Y)
+	}
+	if top != 64.5 {
+		t.Errorf("didn't step on the slab")
+	}
+	if p.Position.Y != 64 || p.Position.Z != 12.7 || !ph.CollidedHorizontally {
+		t.Errorf("walk: at %v collided %v", p.Position, ph.CollidedHorizontally)
+	}
+}
+
+func TestPlayerPhysics_sneak(t *testing.T) {
+	w := World{Columns: make(map[ChunkPos]*Chunk)}
+	w.LoadChunk(ChunkPos{0, 0}, EmptyChunk(16), 0xFFFF, true)
+	for x := 0; x < 16; x++ {
+		for z := 0; z < 16; z++ {
+			b := Block{Id: 1}
+			if z >= 10 {
+				b = Block{Id: 9} // water at the level of the ground
+			}
+			w.SetBlock(Vector3{X: float64(x), Y: 63, Z: float64(z)}, b)
+		}
+	}
+
+	// Sneak south toward the water, the player stays on the stone
+	ph := PlayerPhysics{Controls: Controls{Forward: 1, Sneak: true}}
+	p := &Player{Food: 20}
+	p.SetPosition(Vector3{X: 8.5, Y: 64, Z: 7.5})
+	for i := 0; i < 40 && !p.OnGround; i++ {
+		ph.Tick(&w, p)
+	}
+	for i := 0; i < 60; i++ {
+		ph.Tick(&w, p)
+	}
+	if p.Position.Y != 64 || p.Position.Z > 10.3 || p.Position.Z < 10 {
+		t.Errorf("sneaking at the edge of the water: at %v", p.Position)
+	}
+}
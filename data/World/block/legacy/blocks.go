@@ -0,0 +1,3172 @@
+// Code generated by generator/main.go; DO NOT EDIT.
+package legacy
+
+type (
+	Air   struct{}
+	Stone struct {
+		Variant StoneVariant `nbt:"variant"`
+	}
+	Grass struct{}
+	Dirt  struct {
+		Variant DirtVariant `nbt:"variant"`
+	}
+	Cobblestone struct{}
+	Planks      struct {
+		Variant WoodType `nbt:"variant"`
+	}
+	Sapling struct {
+		Type  WoodType `nbt:"type"`
+		Stage int      `nbt:"stage"`
+	}
+	Bedrock      struct{}
+	FlowingWater struct {
+		Level int `nbt:"level"`
+	}
+	Water struct {
+		Level int `nbt:"level"`
+	}
+	FlowingLava struct {
+		Level int `nbt:"level"`
+	}
+	Lava struct {
+		Level int `nbt:"level"`
+	}
+	Sand struct {
+		Variant SandVariant `nbt:"variant"`
+	}
+	Gravel  struct{}
+	GoldOre struct{}
+	IronOre struct{}
+	CoalOre struct{}
+	Log     struct {
+		Variant WoodType `nbt:"variant"`
+		Axis    LogAxis  `nbt:"axis"`
+	}
+	Leaves struct {
+		Variant    WoodType `nbt:"variant"`
+		Decayable  bool     `nbt:"decayable"`
+		CheckDecay bool     `nbt:"check_decay"`
+	}
+	Sponge struct {
+		Wet bool `nbt:"wet"`
+	}
+	Glass      struct{}
+	LapisOre   struct{}
+	LapisBlock struct{}
+	Dispenser  struct {
+		Facing    Facing `nbt:"facing"`
+		Triggered bool   `nbt:"triggered"`
+	}
+	Sandstone struct {
+		Type SandstoneType `nbt:"type"`
+	}
+	Noteblock struct{}
+	Bed       struct {
+		Facing   Facing  `nbt:"facing"`
+		Part     BedPart `nbt:"part"`
+		Occupied bool    `nbt:"occupied"`
+	}
+	GoldenRail struct {
+		Shape   RailShape `nbt:"shape"`
+		Powered bool      `nbt:"powered"`
+	}
+	DetectorRail struct {
+		Shape   RailShape `nbt:"shape"`
+		Powered bool      `nbt:"powered"`
+	}
+	StickyPiston struct {
+		Facing   Facing `nbt:"facing"`
+		Extended bool   `nbt:"extended"`
+	}
+	Web       struct{}
+	Tallgrass struct {
+		Type TallGrassType `nbt:"type"`
+	}
+	Deadbush struct{}
+	Piston   struct {
+		Facing   Facing `nbt:"facing"`
+		Extended bool   `nbt:"extended"`
+	}
+	PistonHead struct {
+		Facing Facing     `nbt:"facing"`
+		Type   PistonType `nbt:"type"`
+	}
+	Wool struct {
+		Color Color `nbt:"color"`
+	}
+	PistonExtension struct {
+		Facing Facing     `nbt:"facing"`
+		Type   PistonType `nbt:"type"`
+	}
+	YellowFlower struct{}
+	RedFlower    struct {
+		Type FlowerType `nbt:"type"`
+	}
+	BrownMushroom   struct{}
+	RedMushroom     struct{}
+	GoldBlock       struct{}
+	IronBlock       struct{}
+	DoubleStoneSlab struct {
+		Variant  StoneSlabVariant `nbt:"variant"`
+		Seamless bool             `nbt:"seamless"`
+	}
+	StoneSlab struct {
+		Variant StoneSlabVariant `nbt:"variant"`
+		Half    Half             `nbt:"half"`
+	}
+	BrickBlock struct{}
+	Tnt        struct {
+		Explode bool `nbt:"explode"`
+	}
+	Bookshelf        struct{}
+	MossyCobblestone struct{}
+	Obsidian         struct{}
+	Torch            struct {
+		Facing Facing `nbt:"facing"`
+	}
+	Fire struct {
+		Age int `nbt:"age"`
+	}
+	MobSpawner struct{}
+	OakStairs  struct {
+		Facing Facing `nbt:"facing"`
+		Half   Half   `nbt:"half"`
+	}
+	Chest struct {
+		Facing Facing `nbt:"facing"`
+	}
+	RedstoneWire struct {
+		Power int `nbt:"power"`
+	}
+	DiamondOre    struct{}
+	DiamondBlock  struct{}
+	CraftingTable struct{}
+	Wheat         struct {
+		Age int `nbt:"age"`
+	}
+	Farmland struct {
+		Moisture int `nbt:"moisture"`
+	}
+	Furnace struct {
+		Facing Facing `nbt:"facing"`
+	}
+	LitFurnace struct {
+		Facing Facing `nbt:"facing"`
+	}
+	StandingSign struct {
+		Rotation int `nbt:"rotation"`
+	}
+	WoodenDoor struct {
+		Half    DoubleBlockHalf `nbt:"half"`
+		Facing  Facing          `nbt:"facing"`
+		Open    bool            `nbt:"open"`
+		Hinge   Hinge           `nbt:"hinge"`
+		Powered bool            `nbt:"powered"`
+	}
+	Ladder struct {
+		Facing Facing `nbt:"facing"`
+	}
+	Rail struct {
+		Shape RailShape `nbt:"shape"`
+	}
+	StoneStairs struct {
+		Facing Facing `nbt:"facing"`
+		Half   Half   `nbt:"half"`
+	}
+	WallSign struct {
+		Facing Facing `nbt:"facing"`
+	}
+	Lever struct {
+		Facing  LeverOrientation `nbt:"facing"`
+		Powered bool             `nbt:"powered"`
+	}
+	StonePressurePlate struct {
+		Powered bool `nbt:"powered"`
+	}
+	IronDoor struct {
+		Half    DoubleBlockHalf `nbt:"half"`
+		Facing  Facing          `nbt:"facing"`
+		Open    bool            `nbt:"open"`
+		Hinge   Hinge           `nbt:"hinge"`
+		Powered bool            `nbt:"powered"`
+	}
+	WoodenPressurePlate struct {
+		Powered bool `nbt:"powered"`
+	}
+	RedstoneOre        struct{}
+	LitRedstoneOre     struct{}
+	UnlitRedstoneTorch struct {
+		Facing Facing `nbt:"facing"`
+	}
+	RedstoneTorch struct {
+		Facing Facing `nbt:"facing"`
+	}
+	StoneButton struct {
+		Facing  Facing `nbt:"facing"`
+		Powered bool   `nbt:"powered"`
+	}
+	SnowLayer struct {
+		Layers int `nbt:"layers"`
+	}
+	Ice    struct{}
+	Snow   struct{}
+	Cactus struct {
+		Age int `nbt:"age"`
+	}
+	Clay  struct{}
+	Reeds struct {
+		Age int `nbt:"age"`
+	}
+	Jukebox struct {
+		HasRecord bool `nbt:"has_record"`
+	}
+	Fence   struct{}
+	Pumpkin struct {
+		Facing Facing `nbt:"facing"`
+	}
+	Netherrack struct{}
+	SoulSand   struct{}
+	Glowstone  struct{}
+	Portal     struct {
+		Axis Axis `nbt:"axis"`
+	}
+	LitPumpkin struct {
+		Facing Facing `nbt:"facing"`
+	}
+	Cake struct {
+		Bites int `nbt:"bites"`
+	}
+	UnpoweredRepeater struct {
+		Facing Facing `nbt:"facing"`
+		Delay  int    `nbt:"delay"`
+	}
+	PoweredRepeater struct {
+		Facing Facing `nbt:"facing"`
+		Delay  int    `nbt:"delay"`
+	}
+	StainedGlass struct {
+		Color Color `nbt:"color"`
+	}
+	Trapdoor struct {
+		Facing Facing `nbt:"facing"`
+		Open   bool   `nbt:"open"`
+		Half   Half   `nbt:"half"`
+	}
+	MonsterEgg struct {
+		Variant SilverfishVariant `nbt:"variant"`
+	}
+	Stonebrick struct {
+		Variant StoneBrickVariant `nbt:"variant"`
+	}
+	BrownMushroomBlock struct {
+		Variant HugeMushroomVariant `nbt:"variant"`
+	}
+	RedMushroomBlock struct {
+		Variant HugeMushroomVariant `nbt:"variant"`
+	}
+	IronBars    struct{}
+	GlassPane   struct{}
+	MelonBlock  struct{}
+	PumpkinStem struct {
+		Age int `nbt:"age"`
+	}
+	MelonStem struct {
+		Age int `nbt:"age"`
+	}
+	Vine struct {
+		South bool `nbt:"south"`
+		West  bool `nbt:"west"`
+		North bool `nbt:"north"`
+		East  bool `nbt:"east"`
+	}
+	FenceGate struct {
+		Facing  Facing `nbt:"facing"`
+		Open    bool   `nbt:"open"`
+		Powered bool   `nbt:"powered"`
+	}
+	BrickStairs struct {
+		Facing Facing `nbt:"facing"`
+		Half   Half   `nbt:"half"`
+	}
+	StoneBrickStairs struct {
+		Facing Facing `nbt:"facing"`
+		Half   Half   `nbt:"half"`
+	}
+	Mycelium          struct{}
+	Waterlily         struct{}
+	NetherBrick       struct{}
+	NetherBrickFence  struct{}
+	NetherBrickStairs struct {
+		Facing Facing `nbt:"facing"`
+		Half   Half   `nbt:"half"`
+	}
+	NetherWart struct {
+		Age int `nbt:"age"`
+	}
+	EnchantingTable struct{}
+	BrewingStand    struct {
+		HasBottle0 bool `nbt:"has_bottle_0"`
+		HasBottle1 bool `nbt:"has_bottle_1"`
+		HasBottle2 bool `nbt:"has_bottle_2"`
+	}
+	Cauldron struct {
+		Level int `nbt:"level"`
+	}
+	EndPortal      struct{}
+	EndPortalFrame struct {
+		Facing Facing `nbt:"facing"`
+		Eye    bool   `nbt:"eye"`
+	}
+	EndStone         struct{}
+	DragonEgg        struct{}
+	RedstoneLamp     struct{}
+	LitRedstoneLamp  struct{}
+	DoubleWoodenSlab struct {
+		Variant WoodType `nbt:"variant"`
+	}
+	WoodenSlab struct {
+		Variant WoodType `nbt:"variant"`
+		Half    Half     `nbt:"half"`
+	}
+	Cocoa struct {
+		Facing Facing `nbt:"facing"`
+		Age    int    `nbt:"age"`
+	}
+	SandstoneStairs struct {
+		Facing Facing `nbt:"facing"`
+		Half   Half   `nbt:"half"`
+	}
+	EmeraldOre struct{}
+	EnderChest struct {
+		Facing Facing `nbt:"facing"`
+	}
+	TripwireHook struct {
+		Facing   Facing `nbt:"facing"`
+		Attached bool   `nbt:"attached"`
+		Powered  bool   `nbt:"powered"`
+	}
+	Tripwire struct {
+		Powered  bool `nbt:"powered"`
+		Attached bool `nbt:"attached"`
+		Disarmed bool `nbt:"disarmed"`
+	}
+	EmeraldBlock struct{}
+	SpruceStairs struct {
+		Facing Facing `nbt:"facing"`
+		Half   Half   `nbt:"half"`
+	}
+	BirchStairs struct {
+		Facing Facing `nbt:"facing"`
+		Half   Half   `nbt:"half"`
+	}
+	JungleStairs struct {
+		Facing Facing `nbt:"facing"`
+		Half   Half   `nbt:"half"`
+	}
+	CommandBlock struct {
+		Facing      Facing `nbt:"facing"`
+		Conditional bool   `nbt:"conditional"`
+	}
+	Beacon          struct{}
+	CobblestoneWall struct {
+		Variant WallVariant `nbt:"variant"`
+	}
+	FlowerPot struct {
+		LegacyData int `nbt:"legacy_data"`
+	}
+	Carrots struct {
+		Age int `nbt:"age"`
+	}
+	Potatoes struct {
+		Age int `nbt:"age"`
+	}
+	WoodenButton struct {
+		Facing  Facing `nbt:"facing"`
+		Powered bool   `nbt:"powered"`
+	}
+	Skull struct {
+		Facing Facing `nbt:"facing"`
+		Nodrop bool   `nbt:"nodrop"`
+	}
+	Anvil struct {
+		Facing Facing `nbt:"facing"`
+		Damage int    `nbt:"damage"`
+	}
+	TrappedChest struct {
+		Facing Facing `nbt:"facing"`
+	}
+	LightWeightedPressurePlate struct {
+		Power int `nbt:"power"`
+	}
+	HeavyWeightedPressurePlate struct {
+		Power int `nbt:"power"`
+	}
+	UnpoweredComparator struct {
+		Facing  Facing         `nbt:"facing"`
+		Mode    ComparatorMode `nbt:"mode"`
+		Powered bool           `nbt:"powered"`
+	}
+	PoweredComparator struct {
+		Facing  Facing         `nbt:"facing"`
+		Mode    ComparatorMode `nbt:"mode"`
+		Powered bool           `nbt:"powered"`
+	}
+	DaylightDetector struct {
+		Power int `nbt:"power"`
+	}
+	RedstoneBlock struct{}
+	QuartzOre     struct{}
+	Hopper        struct {
+		Facing  Facing `nbt:"facing"`
+		Enabled bool   `nbt:"enabled"`
+	}
+	QuartzBlock struct {
+		Variant QuartzVariant `nbt:"variant"`
+	}
+	QuartzStairs struct {
+		Facing Facing `nbt:"facing"`
+		Half   Half   `nbt:"half"`
+	}
+	ActivatorRail struct {
+		Shape   RailShape `nbt:"shape"`
+		Powered bool      `nbt:"powered"`
+	}
+	Dropper struct {
+		Facing    Facing `nbt:"facing"`
+		Triggered bool   `nbt:"triggered"`
+	}
+	StainedHardenedClay struct {
+		Color Color `nbt:"color"`
+	}
+	StainedGlassPane struct {
+		Color Color `nbt:"color"`
+	}
+	Leaves2 struct {
+		Variant    WoodType `nbt:"variant"`
+		Decayable  bool     `nbt:"decayable"`
+		CheckDecay bool     `nbt:"check_decay"`
+	}
+	Log2 struct {
+		Variant WoodType `nbt:"variant"`
+		Axis    LogAxis  `nbt:"axis"`
+	}
+	AcaciaStairs struct {
+		Facing Facing `nbt:"facing"`
+		Half   Half   `nbt:"half"`
+	}
+	DarkOakStairs struct {
+		Facing Facing `nbt:"facing"`
+		Half   Half   `nbt:"half"`
+	}
+	Slime        struct{}
+	Barrier      struct{}
+	IronTrapdoor struct {
+		Facing Facing `nbt:"facing"`
+		Open   bool   `nbt:"open"`
+		Half   Half   `nbt:"half"`
+	}
+	Prismarine struct {
+		Variant PrismarineVariant `nbt:"variant"`
+	}
+	SeaLantern struct{}
+	HayBlock   struct {
+		Axis Axis `nbt:"axis"`
+	}
+	Carpet struct {
+		Color Color `nbt:"color"`
+	}
+	HardenedClay struct{}
+	CoalBlock    struct{}
+	PackedIce    struct{}
+	DoublePlant  struct {
+		Half    DoubleBlockHalf    `nbt:"half"`
+		Variant DoublePlantVariant `nbt:"variant"`
+		Facing  Facing             `nbt:"facing"`
+	}
+	StandingBanner struct {
+		Rotation int `nbt:"rotation"`
+	}
+	WallBanner struct {
+		Facing Facing `nbt:"facing"`
+	}
+	DaylightDetectorInverted struct {
+		Power int `nbt:"power"`
+	}
+	RedSandstone struct {
+		Type RedSandstoneType `nbt:"type"`
+	}
+	RedSandstoneStairs struct {
+		Facing Facing `nbt:"facing"`
+		Half   Half   `nbt:"half"`
+	}
+	DoubleStoneSlab2 struct {
+		Seamless bool `nbt:"seamless"`
+	}
+	StoneSlab2 struct {
+		Half Half `nbt:"half"`
+	}
+	SpruceFenceGate struct {
+		Facing  Facing `nbt:"facing"`
+		Open    bool   `nbt:"open"`
+		Powered bool   `nbt:"powered"`
+	}
+	BirchFenceGate struct {
+		Facing  Facing `nbt:"facing"`
+		Open    bool   `nbt:"open"`
+		Powered bool   `nbt:"powered"`
+	}
+	JungleFenceGate struct {
+		Facing  Facing `nbt:"facing"`
+		Open    bool   `nbt:"open"`
+		Powered bool   `nbt:"powered"`
+	}
+	DarkOakFenceGate struct {
+		Facing  Facing `nbt:"facing"`
+		Open    bool   `nbt:"open"`
+		Powered bool   `nbt:"powered"`
+	}
+	AcaciaFenceGate struct {
+		Facing  Facing `nbt:"facing"`
+		Open    bool   `nbt:"open"`
+		Powered bool   `nbt:"powered"`
+	}
+	SpruceFence  struct{}
+	BirchFence   struct{}
+	JungleFence  struct{}
+	DarkOakFence struct{}
+	AcaciaFence  struct{}
+	SpruceDoor   struct {
+		Half    DoubleBlockHalf `nbt:"half"`
+		Facing  Facing          `nbt:"facing"`
+		Open    bool            `nbt:"open"`
+		Hinge   Hinge           `nbt:"hinge"`
+		Powered bool            `nbt:"powered"`
+	}
+	BirchDoor struct {
+		Half    DoubleBlockHalf `nbt:"half"`
+		Facing  Facing          `nbt:"facing"`
+		Open    bool            `nbt:"open"`
+		Hinge   Hinge           `nbt:"hinge"`
+		Powered bool            `nbt:"powered"`
+	}
+	JungleDoor struct {
+		Half    DoubleBlockHalf `nbt:"half"`
+		Facing  Facing          `nbt:"facing"`
+		Open    bool            `nbt:"open"`
+		Hinge   Hinge           `nbt:"hinge"`
+		Powered bool            `nbt:"powered"`
+	}
+	AcaciaDoor struct {
+		Half    DoubleBlockHalf `nbt:"half"`
+		Facing  Facing          `nbt:"facing"`
+		Open    bool            `nbt:"open"`
+		Hinge   Hinge           `nbt:"hinge"`
+		Powered bool            `nbt:"powered"`
+	}
+	DarkOakDoor struct {
+		Half    DoubleBlockHalf `nbt:"half"`
+		Facing  Facing          `nbt:"facing"`
+		Open    bool            `nbt:"open"`
+		Hinge   Hinge           `nbt:"hinge"`
+		Powered bool            `nbt:"powered"`
+	}
+	EndRod struct {
+		Facing Facing `nbt:"facing"`
+	}
+	ChorusPlant  struct{}
+	ChorusFlower struct {
+		Age int `nbt:"age"`
+	}
+	PurpurBlock  struct{}
+	PurpurPillar struct {
+		Axis Axis `nbt:"axis"`
+	}
+	PurpurStairs struct {
+		Facing Facing `nbt:"facing"`
+		Half   Half   `nbt:"half"`
+	}
+	PurpurDoubleSlab struct{}
+	PurpurSlab       struct {
+		Half Half `nbt:"half"`
+	}
+	EndBricks struct{}
+	Beetroots struct {
+		Age int `nbt:"age"`
+	}
+	GrassPath             struct{}
+	EndGateway            struct{}
+	RepeatingCommandBlock struct {
+		Facing      Facing `nbt:"facing"`
+		Conditional bool   `nbt:"conditional"`
+	}
+	ChainCommandBlock struct {
+		Facing      Facing `nbt:"facing"`
+		Conditional bool   `nbt:"conditional"`
+	}
+	FrostedIce struct {
+		Age int `nbt:"age"`
+	}
+	Magma           struct{}
+	NetherWartBlock struct{}
+	RedNetherBrick  struct{}
+	BoneBlock       struct {
+		Axis Axis `nbt:"axis"`
+	}
+	StructureVoid struct{}
+	Observer      struct {
+		Facing  Facing `nbt:"facing"`
+		Powered bool   `nbt:"powered"`
+	}
+	WhiteShulkerBox struct {
+		Facing Facing `nbt:"facing"`
+	}
+	OrangeShulkerBox struct {
+		Facing Facing `nbt:"facing"`
+	}
+	MagentaShulkerBox struct {
+		Facing Facing `nbt:"facing"`
+	}
+	LightBlueShulkerBox struct {
+		Facing Facing `nbt:"facing"`
+	}
+	YellowShulkerBox struct {
+		Facing Facing `nbt:"facing"`
+	}
+	LimeShulkerBox struct {
+		Facing Facing `nbt:"facing"`
+	}
+	PinkShulkerBox struct {
+		Facing Facing `nbt:"facing"`
+	}
+	GrayShulkerBox struct {
+		Facing Facing `nbt:"facing"`
+	}
+	SilverShulkerBox struct {
+		Facing Facing `nbt:"facing"`
+	}
+	CyanShulkerBox struct {
+		Facing Facing `nbt:"facing"`
+	}
+	PurpleShulkerBox struct {
+		Facing Facing `nbt:"facing"`
+	}
+	BlueShulkerBox struct {
+		Facing Facing `nbt:"facing"`
+	}
+	BrownShulkerBox struct {
+		Facing Facing `nbt:"facing"`
+	}
+	GreenShulkerBox struct {
+		Facing Facing `nbt:"facing"`
+	}
+	RedShulkerBox struct {
+		Facing Facing `nbt:"facing"`
+	}
+	BlackShulkerBox struct {
+		Facing Facing `nbt:"facing"`
+	}
+	WhiteGlazedTerracotta struct {
+		Facing Facing `nbt:"facing"`
+	}
+	OrangeGlazedTerracotta struct {
+		Facing Facing `nbt:"facing"`
+	}
+	MagentaGlazedTerracotta struct {
+		Facing Facing `nbt:"facing"`
+	}
+	LightBlueGlazedTerracotta struct {
+		Facing Facing `nbt:"facing"`
+	}
+	YellowGlazedTerracotta struct {
+		Facing Facing `nbt:"facing"`
+	}
+	LimeGlazedTerracotta struct {
+		Facing Facing `nbt:"facing"`
+	}
+	PinkGlazedTerracotta struct {
+		Facing Facing `nbt:"facing"`
+	}
+	GrayGlazedTerracotta struct {
+		Facing Facing `nbt:"facing"`
+	}
+	SilverGlazedTerracotta struct {
+		Facing Facing `nbt:"facing"`
+	}
+	CyanGlazedTerracotta struct {
+		Facing Facing `nbt:"facing"`
+	}
+	PurpleGlazedTerracotta struct {
+		Facing Facing `nbt:"facing"`
+	}
+	BlueGlazedTerracotta struct {
+		Facing Facing `nbt:"facing"`
+	}
+	BrownGlazedTerracotta struct {
+		Facing Facing `nbt:"facing"`
+	}
+	GreenGlazedTerracotta struct {
+		Facing Facing `nbt:"facing"`
+	}
+	RedGlazedTerracotta struct {
+		Facing Facing `nbt:"facing"`
+	}
+	BlackGlazedTerracotta struct {
+		Facing Facing `nbt:"facing"`
+	}
+	Concrete struct {
+		Color Color `nbt:"color"`
+	}
+	ConcretePowder struct {
+		Color Color `nbt:"color"`
+	}
+	StructureBlock struct {
+		Mode StructureMode `nbt:"mode"`
+	}
+)
+
+func (Air) ID() string                        { return "minecraft:air" }
+func (Stone) ID() string                      { return "minecraft:stone" }
+func (Grass) ID() string                      { return "minecraft:grass" }
+func (Dirt) ID() string                       { return "minecraft:dirt" }
+func (Cobblestone) ID() string                { return "minecraft:cobblestone" }
+func (Planks) ID() string                     { return "minecraft:planks" }
+func (Sapling) ID() string                    { return "minecraft:sapling" }
+func (Bedrock) ID() string                    { return "minecraft:bedrock" }
+func (FlowingWater) ID() string               { return "minecraft:flowing_water" }
+func (Water) ID() string                      { return "minecraft:water" }
+func (FlowingLava) ID() string                { return "minecraft:flowing_lava" }
+func (Lava) ID() string                       { return "minecraft:lava" }
+func (Sand) ID() string                       { return "minecraft:sand" }
+func (Gravel) ID() string                     { return "minecraft:gravel" }
+func (GoldOre) ID() string                    { return "minecraft:gold_ore" }
+func (IronOre) ID() string                    { return "minecraft:iron_ore" }
+func (CoalOre) ID() string                    { return "minecraft:coal_ore" }
+func (Log) ID() string                        { return "minecraft:log" }
+func (Leaves) ID() string                     { return "minecraft:leaves" }
+func (Sponge) ID() string                     { return "minecraft:sponge" }
+func (Glass) ID() string                      { return "minecraft:glass" }
+func (LapisOre) ID() string                   { return "minecraft:lapis_ore" }
+func (LapisBlock) ID() string                 { return "minecraft:lapis_block" }
+func (Dispenser) ID() string                  { return "minecraft:dispenser" }
+func (Sandstone) ID() string                  { return "minecraft:sandstone" }
+func (Noteblock) ID() string                  { return "minecraft:noteblock" }
+func (Bed) ID() string                        { return "minecraft:bed" }
+func (GoldenRail) ID() string                 { return "minecraft:golden_rail" }
+func (DetectorRail) ID() string               { return "minecraft:detector_rail" }
+func (StickyPiston) ID() string               { return "minecraft:sticky_piston" }
+func (Web) ID() string                        { return "minecraft:web" }
+func (Tallgrass) ID() string                  { return "minecraft:tallgrass" }
+func (Deadbush) ID() string                   { return "minecraft:deadbush" }
+func (Piston) ID() string                     { return "minecraft:piston" }
+func (PistonHead) ID() string                 { return "minecraft:piston_head" }
+func (Wool) ID() string                       { return "minecraft:wool" }
+func (PistonExtension) ID() string            { return "minecraft:piston_extension" }
+func (YellowFlower) ID() string               { return "minecraft:yellow_flower" }
+func (RedFlower) ID() string                  { return "minecraft:red_flower" }
+func (BrownMushroom) ID() string              { return "minecraft:brown_mushroom" }
+func (RedMushroom) ID() string                { return "minecraft:red_mushroom" }
+func (GoldBlock) ID() string                  { return "minecraft:gold_block" }
+func (IronBlock) ID() string                  { return "minecraft:iron_block" }
+func (DoubleStoneSlab) ID() string            { return "minecraft:double_stone_slab" }
+func (StoneSlab) ID() string                  { return "minecraft:stone_slab" }
+func (BrickBlock) ID() string                 { return "minecraft:brick_block" }
+func (Tnt) ID() string                        { return "minecraft:tnt" }
+func (Bookshelf) ID() string                  { return "minecraft:bookshelf" }
+func (MossyCobblestone) ID() string           { return "minecraft:mossy_cobblestone" }
+func (Obsidian) ID() string                   { return "minecraft:obsidian" }
+func (Torch) ID() string                      { return "minecraft:torch" }
+func (Fire) ID() string                       { return "minecraft:fire" }
+func (MobSpawner) ID() string                 { return "minecraft:mob_spawner" }
+func (OakStairs) ID() string                  { return "minecraft:oak_stairs" }
+func (Chest) ID() string                      { return "minecraft:chest" }
+func (RedstoneWire) ID() string               { return "minecraft:redstone_wire" }
+func (DiamondOre) ID() string                 { return "minecraft:diamond_ore" }
+func (DiamondBlock) ID() string               { return "minecraft:diamond_block" }
+func (CraftingTable) ID() string              { return "minecraft:crafting_table" }
+func (Wheat) ID() string                      { return "minecraft:wheat" }
+func (Farmland) ID() string                   { return "minecraft:farmland" }
+func (Furnace) ID() string                    { return "minecraft:furnace" }
+func (LitFurnace) ID() string                 { return "minecraft:lit_furnace" }
+func (StandingSign) ID() string               { return "minecraft:standing_sign" }
+func (WoodenDoor) ID() string                 { return "minecraft:wooden_door" }
+func (Ladder) ID() string                     { return "minecraft:ladder" }
+func (Rail) ID() string                       { return "minecraft:rail" }
+func (StoneStairs) ID() string                { return "minecraft:stone_stairs" }
+func (WallSign) ID() string                   { return "minecraft:wall_sign" }
+func (Lever) ID() string                      { return "minecraft:lever" }
+func (StonePressurePlate) ID() string         { return "minecraft:stone_pressure_plate" }
+func (IronDoor) ID() string                   { return "minecraft:iron_door" }
+func (WoodenPressurePlate) ID() string        { return "minecraft:wooden_pressure_plate" }
+func (RedstoneOre) ID() string                { return "minecraft:redstone_ore" }
+func (LitRedstoneOre) ID() string             { return "minecraft:lit_redstone_ore" }
+func (UnlitRedstoneTorch) ID() string         { return "minecraft:unlit_redstone_torch" }
+func (RedstoneTorch) ID() string              { return "minecraft:redstone_torch" }
+func (StoneButton) ID() string                { return "minecraft:stone_button" }
+func (SnowLayer) ID() string                  { return "minecraft:snow_layer" }
+func (Ice) ID() string                        { return "minecraft:ice" }
+func (Snow) ID() string                       { return "minecraft:snow" }
+func (Cactus) ID() string                     { return "minecraft:cactus" }
+func (Clay) ID() string                       { return "minecraft:clay" }
+func (Reeds) ID() string                      { return "minecraft:reeds" }
+func (Jukebox) ID() string                    { return "minecraft:jukebox" }
+func (Fence) ID() string                      { return "minecraft:fence" }
+func (Pumpkin) ID() string                    { return "minecraft:pumpkin" }
+func (Netherrack) ID() string                 { return "minecraft:netherrack" }
+func (SoulSand) ID() string                   { return "minecraft:soul_sand" }
+func (Glowstone) ID() string                  { return "minecraft:glowstone" }
+func (Portal) ID() string                     { return "minecraft:portal" }
+func (LitPumpkin) ID() string                 { return "minecraft:lit_pumpkin" }
+func (Cake) ID() string                       { return "minecraft:cake" }
+func (UnpoweredRepeater) ID() string          { return "minecraft:unpowered_repeater" }
+func (PoweredRepeater) ID() string            { return "minecraft:powered_repeater" }
+func (StainedGlass) ID() string               { return "minecraft:stained_glass" }
+func (Trapdoor) ID() string                   { return "minecraft:trapdoor" }
+func (MonsterEgg) ID() string                 { return "minecraft:monster_egg" }
+func (Stonebrick) ID() string                 { return "minecraft:stonebrick" }
+func (BrownMushroomBlock) ID() string         { return "minecraft:brown_mushroom_block" }
+func (RedMushroomBlock) ID() string           { return "minecraft:red_mushroom_block" }
+func (IronBars) ID() string                   { return "minecraft:iron_bars" }
+func (GlassPane) ID() string                  { return "minecraft:glass_pane" }
+func (MelonBlock) ID() string                 { return "minecraft:melon_block" }
+func (PumpkinStem) ID() string                { return "minecraft:pumpkin_stem" }
+func (MelonStem) ID() string                  { return "minecraft:melon_stem" }
+func (Vine) ID() string                       { return "minecraft:vine" }
+func (FenceGate) ID() string                  { return "minecraft:fence_gate" }
+func (BrickStairs) ID() string                { return "minecraft:brick_stairs" }
+func (StoneBrickStairs) ID() string           { return "minecraft:stone_brick_stairs" }
+func (Mycelium) ID() string                   { return "minecraft:mycelium" }
+func (Waterlily) ID() string                  { return "minecraft:waterlily" }
+func (NetherBrick) ID() string                { return "minecraft:nether_brick" }
+func (NetherBrickFence) ID() string           { return "minecraft:nether_brick_fence" }
+func (NetherBrickStairs) ID() string          { return "minecraft:nether_brick_stairs" }
+func (NetherWart) ID() string                 { return "minecraft:nether_wart" }
+func (EnchantingTable) ID() string            { return "minecraft:enchanting_table" }
+func (BrewingStand) ID() string               { return "minecraft:brewing_stand" }
+func (Cauldron) ID() string                   { return "minecraft:cauldron" }
+func (EndPortal) ID() string                  { return "minecraft:end_portal" }
+func (EndPortalFrame) ID() string             { return "minecraft:end_portal_frame" }
+func (EndStone) ID() string                   { return "minecraft:end_stone" }
+func (DragonEgg) ID() string                  { return "minecraft:dragon_egg" }
+func (RedstoneLamp) ID() string               { return "minecraft:redstone_lamp" }
+func (LitRedstoneLamp) ID() string            { return "minecraft:lit_redstone_lamp" }
+func (DoubleWoodenSlab) ID() string           { return "minecraft:double_wooden_slab" }
+func (WoodenSlab) ID() string                 { return "minecraft:wooden_slab" }
+func (Cocoa) ID() string                      { return "minecraft:cocoa" }
+func (SandstoneStairs) ID() string            { return "minecraft:sandstone_stairs" }
+func (EmeraldOre) ID() string                 { return "minecraft:emerald_ore" }
+func (EnderChest) ID() string                 { return "minecraft:ender_chest" }
+func (TripwireHook) ID() string               { return "minecraft:tripwire_hook" }
+func (Tripwire) ID() string                   { return "minecraft:tripwire" }
+func (EmeraldBlock) ID() string               { return "minecraft:emerald_block" }
+func (SpruceStairs) ID() string               { return "minecraft:spruce_stairs" }
+func (BirchStairs) ID() string                { return "minecraft:birch_stairs" }
+func (JungleStairs) ID() string               { return "minecraft:jungle_stairs" }
+func (CommandBlock) ID() string               { return "minecraft:command_block" }
+func (Beacon) ID() string                     { return "minecraft:beacon" }
+func (CobblestoneWall) ID() string            { return "minecraft:cobblestone_wall" }
+func (FlowerPot) ID() string                  { return "minecraft:flower_pot" }
+func (Carrots) ID() string                    { return "minecraft:carrots" }
+func (Potatoes) ID() string                   { return "minecraft:potatoes" }
+func (WoodenButton) ID() string               { return "minecraft:wooden_button" }
+func (Skull) ID() string                      { return "minecraft:skull" }
+func (Anvil) ID() string                      { return "minecraft:anvil" }
+func (TrappedChest) ID() string               { return "minecraft:trapped_chest" }
+func (LightWeightedPressurePlate) ID() string { return "minecraft:light_weighted_pressure_plate" }
+func (HeavyWeightedPressurePlate) ID() string { return "minecraft:heavy_weighted_pressure_plate" }
+func (UnpoweredComparator) ID() string        { return "minecraft:unpowered_comparator" }
+func (PoweredComparator) ID() string          { return "minecraft:powered_comparator" }
+func (DaylightDetector) ID() string           { return "minecraft:daylight_detector" }
+func (RedstoneBlock) ID() string              { return "minecraft:redstone_block" }
+func (QuartzOre) ID() string                  { return "minecraft:quartz_ore" }
+func (Hopper) ID() string                     { return "minecraft:hopper" }
+func (QuartzBlock) ID() string                { return "minecraft:quartz_block" }
+func (QuartzStairs) ID() string               { return "minecraft:quartz_stairs" }
+func (ActivatorRail) ID() string              { return "minecraft:activator_rail" }
+func (Dropper) ID() string                    { return "minecraft:dropper" }
+func (StainedHardenedClay) ID() string        { return "minecraft:stained_hardened_clay" }
+func (StainedGlassPane) ID() string           { return "minecraft:stained_glass_pane" }
+func (Leaves2) ID() string                    { return "minecraft:leaves2" }
+func (Log2) ID() string                       { return "minecraft:log2" }
+func (AcaciaStairs) ID() string               { return "minecraft:acacia_stairs" }
+func (DarkOakStairs) ID() string              { return "minecraft:dark_oak_stairs" }
+func (Slime) ID() string                      { return "minecraft:slime" }
+func (Barrier) ID() string                    { return "minecraft:barrier" }
+func (IronTrapdoor) ID() string               { return "minecraft:iron_trapdoor" }
+func (Prismarine) ID() string                 { return "minecraft:prismarine" }
+func (SeaLantern) ID() string                 { return "minecraft:sea_lantern" }
+func (HayBlock) ID() string                   { return "minecraft:hay_block" }
+func (Carpet) ID() string                     { return "minecraft:carpet" }
+func (HardenedClay) ID() string               { return "minecraft:hardened_clay" }
+func (CoalBlock) ID() string                  { return "minecraft:coal_block" }
+func (PackedIce) ID() string                  { return "minecraft:packed_ice" }
+func (DoublePlant) ID() string                { return "minecraft:double_plant" }
+func (StandingBanner) ID() string             { return "minecraft:standing_banner" }
+func (WallBanner) ID() string                 { return "minecraft:wall_banner" }
+func (DaylightDetectorInverted) ID() string   { return "minecraft:daylight_detector_inverted" }
+func (RedSandstone) ID() string               { return "minecraft:red_sandstone" }
+func (RedSandstoneStairs) ID() string         { return "minecraft:red_sandstone_stairs" }
+func (DoubleStoneSlab2) ID() string           { return "minecraft:double_stone_slab2" }
+func (StoneSlab2) ID() string                 { return "minecraft:stone_slab2" }
+func (SpruceFenceGate) ID() string            { return "minecraft:spruce_fence_gate" }
+func (BirchFenceGate) ID() string             { return "minecraft:birch_fence_gate" }
+func (JungleFenceGate) ID() string            { return "minecraft:jungle_fence_gate" }
+func (DarkOakFenceGate) ID() string           { return "minecraft:dark_oak_fence_gate" }
+func (AcaciaFenceGate) ID() string            { return "minecraft:acacia_fence_gate" }
+func (SpruceFence) ID() string                { return "minecraft:spruce_fence" }
+func (BirchFence) ID() string                 { return "minecraft:birch_fence" }
+func (JungleFence) ID() string                { return "minecraft:jungle_fence" }
+func (DarkOakFence) ID() string               { return "minecraft:dark_oak_fence" }
+func (AcaciaFence) ID() string                { return "minecraft:acacia_fence" }
+func (SpruceDoor) ID() string                 { return "minecraft:spruce_door" }
+func (BirchDoor) ID() string                  { return "minecraft:birch_door" }
+func (JungleDoor) ID() string                 { return "minecraft:jungle_door" }
+func (AcaciaDoor) ID() string                 { return "minecraft:acacia_door" }
+func (DarkOakDoor) ID() string                { return "minecraft:dark_oak_door" }
+func (EndRod) ID() string                     { return "minecraft:end_rod" }
+func (ChorusPlant) ID() string                { return "minecraft:chorus_plant" }
+func (ChorusFlower) ID() string               { return "minecraft:chorus_flower" }
+func (PurpurBlock) ID() string                { return "minecraft:purpur_block" }
+func (PurpurPillar) ID() string               { return "minecraft:purpur_pillar" }
+func (PurpurStairs) ID() string               { return "minecraft:purpur_stairs" }
+func (PurpurDoubleSlab) ID() string           { return "minecraft:purpur_double_slab" }
+func (PurpurSlab) ID() string                 { return "minecraft:purpur_slab" }
+func (EndBricks) ID() string                  { return "minecraft:end_bricks" }
+func (Beetroots) ID() string                  { return "minecraft:beetroots" }
+func (GrassPath) ID() string                  { return "minecraft:grass_path" }
+func (EndGateway) ID() string                 { return "minecraft:end_gateway" }
+func (RepeatingCommandBlock) ID() string      { return "minecraft:repeating_command_block" }
+func (ChainCommandBlock) ID() string          { return "minecraft:chain_command_block" }
+func (FrostedIce) ID() string                 { return "minecraft:frosted_ice" }
+func (Magma) ID() string                      { return "minecraft:magma" }
+func (NetherWartBlock) ID() string            { return "minecraft:nether_wart_block" }
+func (RedNetherBrick) ID() string             { return "minecraft:red_nether_brick" }
+func (BoneBlock) ID() string                  { return "minecraft:bone_block" }
+func (StructureVoid) ID() string              { return "minecraft:structure_void" }
+func (Observer) ID() string                   { return "minecraft:observer" }
+func (WhiteShulkerBox) ID() string            { return "minecraft:white_shulker_box" }
+func (OrangeShulkerBox) ID() string           { return "minecraft:orange_shulker_box" }
+func (MagentaShulkerBox) ID() string          { return "minecraft:magenta_shulker_box" }
+func (LightBlueShulkerBox) ID() string        { return "minecraft:light_blue_shulker_box" }
+func (YellowShulkerBox) ID() string           { return "minecraft:yellow_shulker_box" }
+func (LimeShulkerBox) ID() string             { return "minecraft:lime_shulker_box" }
+func (PinkShulkerBox) ID() string             { return "minecraft:pink_shulker_box" }
+func (GrayShulkerBox) ID() string             { return "minecraft:gray_shulker_box" }
+func (SilverShulkerBox) ID() string           { return "minecraft:silver_shulker_box" }
+func (CyanShulkerBox) ID() string             { return "minecraft:cyan_shulker_box" }
+func (PurpleShulkerBox) ID() string           { return "minecraft:purple_shulker_box" }
+func (BlueShulkerBox) ID() string             { return "minecraft:blue_shulker_box" }
+func (BrownShulkerBox) ID() string            { return "minecraft:brown_shulker_box" }
+func (GreenShulkerBox) ID() string            { return "minecraft:green_shulker_box" }
+func (RedShulkerBox) ID() string              { return "minecraft:red_shulker_box" }
+func (BlackShulkerBox) ID() string            { return "minecraft:black_shulker_box" }
+func (WhiteGlazedTerracotta) ID() string      { return "minecraft:white_glazed_terracotta" }
+func (OrangeGlazedTerracotta) ID() string     { return "minecraft:orange_glazed_terracotta" }
+func (MagentaGlazedTerracotta) ID() string    { return "minecraft:magenta_glazed_terracotta" }
+func (LightBlueGlazedTerracotta) ID() string  { return "minecraft:light_blue_glazed_terracotta" }
+func (YellowGlazedTerracotta) ID() string     { return "minecraft:yellow_glazed_terracotta" }
+func (LimeGlazedTerracotta) ID() string       { return "minecraft:lime_glazed_terracotta" }
+func (PinkGlazedTerracotta) ID() string       { return "minecraft:pink_glazed_terracotta" }
+func (GrayGlazedTerracotta) ID() string       { return "minecraft:gray_glazed_terracotta" }
+func (SilverGlazedTerracotta) ID() string     { return "minecraft:silver_glazed_terracotta" }
+func (CyanGlazedTerracotta) ID() string       { return "minecraft:cyan_glazed_terracotta" }
+func (PurpleGlazedTerracotta) ID() string     { return "minecraft:purple_glazed_terracotta" }
+func (BlueGlazedTerracotta) ID() string       { return "minecraft:blue_glazed_terracotta" }
+func (BrownGlazedTerracotta) ID() string      { return "minecraft:brown_glazed_terracotta" }
+func (GreenGlazedTerracotta) ID() string      { return "minecraft:green_glazed_terracotta" }
+func (RedGlazedTerracotta) ID() string        { return "minecraft:red_glazed_terracotta" }
+func (BlackGlazedTerracotta) ID() string      { return "minecraft:black_glazed_terracotta" }
+func (Concrete) ID() string                   { return "minecraft:concrete" }
+func (ConcretePowder) ID() string             { return "minecraft:concrete_powder" }
+func (StructureBlock) ID() string             { return "minecraft:structure_block" }
+
+// Names of the blocks by numeric ID
+var Names = [256]string{
+	0:   "minecraft:air",
+	1:   "minecraft:stone",
+	2:   "minecraft:grass",
+	3:   "minecraft:dirt",
+	4:   "minecraft:cobblestone",
+	5:   "minecraft:planks",
+	6:   "minecraft:sapling",
+	7:   "minecraft:bedrock",
+	8:   "minecraft:flowing_water",
+	9:   "minecraft:water",
+	10:  "minecraft:flowing_lava",
+	11:  "minecraft:lava",
+	12:  "minecraft:sand",
+	13:  "minecraft:gravel",
+	14:  "minecraft:gold_ore",
+	15:  "minecraft:iron_ore",
+	16:  "minecraft:coal_ore",
+	17:  "minecraft:log",
+	18:  "minecraft:leaves",
+	19:  "minecraft:sponge",
+	20:  "minecraft:glass",
+	21:  "minecraft:lapis_ore",
+	22:  "minecraft:lapis_block",
+	23:  "minecraft:dispenser",
+	24:  "minecraft:sandstone",
+	25:  "minecraft:noteblock",
+	26:  "minecraft:bed",
+	27:  "minecraft:golden_rail",
+	28:  "minecraft:detector_rail",
+	29:  "minecraft:sticky_piston",
+	30:  "minecraft:web",
+	31:  "minecraft:tallgrass",
+	32:  "minecraft:deadbush",
+	33:  "minecraft:piston",
+	34:  "minecraft:piston_head",
+	35:  "minecraft:wool",
+	36:  "minecraft:piston_extension",
+	37:  "minecraft:yellow_flower",
+	38:  "minecraft:red_flower",
+	39:  "minecraft:brown_mushroom",
+	40:  "minecraft:red_mushroom",
+	41:  "minecraft:gold_block",
+	42:  "minecraft:iron_block",
+	43:  "minecraft:double_stone_slab",
+	44:  "minecraft:stone_slab",
+	45:  "minecraft:brick_block",
+	46:  "minecraft:tnt",
+	47:  "minecraft:bookshelf",
+	48:  "minecraft:mossy_cobblestone",
+	49:  "minecraft:obsidian",
+	50:  "minecraft:torch",
+	51:  "minecraft:fire",
+	52:  "minecraft:mob_spawner",
+	53:  "minecraft:oak_stairs",
+	54:  "minecraft:chest",
+	55:  "minecraft:redstone_wire",
+	56:  "minecraft:diamond_ore",
+	57:  "minecraft:diamond_block",
+	58:  "minecraft:crafting_table",
+	59:  "minecraft:wheat",
+	60:  "minecraft:farmland",
+	61:  "minecraft:furnace",
+	62:  "minecraft:lit_furnace",
+	63:  "minecraft:standing_sign",
+	64:  "minecraft:wooden_door",
+	65:  "minecraft:ladder",
+	66:  "minecraft:rail",
+	67:  "minecraft:stone_stairs",
+	68:  "minecraft:wall_sign",
+	69:  "minecraft:lever",
+	70:  "minecraft:stone_pressure_plate",
+	71:  "minecraft:iron_door",
+	72:  "minecraft:wooden_pressure_plate",
+	73:  "minecraft:redstone_ore",
+	74:  "minecraft:lit_redstone_ore",
+	75:  "minecraft:unlit_redstone_torch",
+	76:  "minecraft:redstone_torch",
+	77:  "minecraft:stone_button",
+	78:  "minecraft:snow_layer",
+	79:  "minecraft:ice",
+	80:  "minecraft:snow",
+	81:  "minecraft:cactus",
+	82:  "minecraft:clay",
+	83:  "minecraft:reeds",
+	84:  "minecraft:jukebox",
+	85:  "minecraft:fence",
+	86:  "minecraft:pumpkin",
+	87:  "minecraft:netherrack",
+	88:  "minecraft:soul_sand",
+	89:  "minecraft:glowstone",
+	90:  "minecraft:portal",
+	91:  "minecraft:lit_pumpkin",
+	92:  "minecraft:cake",
+	93:  "minecraft:unpowered_repeater",
+	94:  "minecraft:powered_repeater",
+	95:  "minecraft:stained_glass",
+	96:  "minecraft:trapdoor",
+	97:  "minecraft:monster_egg",
+	98:  "minecraft:stonebrick",
+	99:  "minecraft:brown_mushroom_block",
+	100: "minecraft:red_mushroom_block",
+	101: "minecraft:iron_bars",
+	102: "minecraft:glass_pane",
+	103: "minecraft:melon_block",
+	104: "minecraft:pumpkin_stem",
+	105: "minecraft:melon_stem",
+	106: "minecraft:vine",
+	107: "minecraft:fence_gate",
+	108: "minecraft:brick_stairs",
+	109: "minecraft:stone_brick_stairs",
+	110: "minecraft:mycelium",
+	111: "minecraft:waterlily",
+	112: "minecraft:nether_brick",
+	113: "minecraft:nether_brick_fence",
+	114: "minecraft:nether_brick_stairs",
+	115: "minecraft:nether_wart",
+	116: "minecraft:enchanting_table",
+	117: "minecraft:brewing_stand",
+	118: "minecraft:cauldron",
+	119: "minecraft:end_portal",
+	120: "minecraft:end_portal_frame",
+	121: "minecraft:end_stone",
+	122: "minecraft:dragon_egg",
+	123: "minecraft:redstone_lamp",
+	124: "minecraft:lit_redstone_lamp",
+	125: "minecraft:double_wooden_slab",
+	126: "minecraft:wooden_slab",
+	127: "minecraft:cocoa",
+	128: "minecraft:sandstone_stairs",
+	129: "minecraft:emerald_ore",
+	130: "minecraft:ender_chest",
+	131: "minecraft:tripwire_hook",
+	132: "minecraft:tripwire",
+	133: "minecraft:emerald_block",
+	134: "minecraft:spruce_stairs",
+	135: "minecraft:birch_stairs",
+	136: "minecraft:jungle_stairs",
+	137: "minecraft:command_block",
+	138: "minecraft:beacon",
+	139: "minecraft:cobblestone_wall",
+	140: "minecraft:flower_pot",
+	141: "minecraft:carrots",
+	142: "minecraft:potatoes",
+	143: "minecraft:wooden_button",
+	144: "minecraft:skull",
+	145: "minecraft:anvil",
+	146: "minecraft:trapped_chest",
+	147: "minecraft:light_weighted_pressure_plate",
+	148: "minecraft:heavy_weighted_pressure_plate",
+	149: "minecraft:unpowered_comparator",
+	150: "minecraft:powered_comparator",
+	151: "minecraft:daylight_detector",
+	152: "minecraft:redstone_block",
+	153: "minecraft:quartz_ore",
+	154: "minecraft:hopper",
+	155: "minecraft:quartz_block",
+	156: "minecraft:quartz_stairs",
+	157: "minecraft:activator_rail",
+	158: "minecraft:dropper",
+	159: "minecraft:stained_hardened_clay",
+	160: "minecraft:stained_glass_pane",
+	161: "minecraft:leaves2",
+	162: "minecraft:log2",
+	163: "minecraft:acacia_stairs",
+	164: "minecraft:dark_oak_stairs",
+	165: "minecraft:slime",
+	166: "minecraft:barrier",
+	167: "minecraft:iron_trapdoor",
+	168: "minecraft:prismarine",
+	169: "minecraft:sea_lantern",
+	170: "minecraft:hay_block",
+	171: "minecraft:carpet",
+	172: "minecraft:hardened_clay",
+	173: "minecraft:coal_block",
+	174: "minecraft:packed_ice",
+	175: "minecraft:double_plant",
+	176: "minecraft:standing_banner",
+	177: "minecraft:wall_banner",
+	178: "minecraft:daylight_detector_inverted",
+	179: "minecraft:red_sandstone",
+	180: "minecraft:red_sandstone_stairs",
+	181: "minecraft:double_stone_slab2",
+	182: "minecraft:stone_slab2",
+	183: "minecraft:spruce_fence_gate",
+	184: "minecraft:birch_fence_gate",
+	185: "minecraft:jungle_fence_gate",
+	186: "minecraft:dark_oak_fence_gate",
+	187: "minecraft:acacia_fence_gate",
+	188: "minecraft:spruce_fence",
+	189: "minecraft:birch_fence",
+	190: "minecraft:jungle_fence",
+	191: "minecraft:dark_oak_fence",
+	192: "minecraft:acacia_fence",
+	193: "minecraft:spruce_door",
+	194: "minecraft:birch_door",
+	195: "minecraft:jungle_door",
+	196: "minecraft:acacia_door",
+	197: "minecraft:dark_oak_door",
+	198: "minecraft:end_rod",
+	199: "minecraft:chorus_plant",
+	200: "minecraft:chorus_flower",
+	201: "minecraft:purpur_block",
+	202: "minecraft:purpur_pillar",
+	203: "minecraft:purpur_stairs",
+	204: "minecraft:purpur_double_slab",
+	205: "minecraft:purpur_slab",
+	206: "minecraft:end_bricks",
+	207: "minecraft:beetroots",
+	208: "minecraft:grass_path",
+	209: "minecraft:end_gateway",
+	210: "minecraft:repeating_command_block",
+	211: "minecraft:chain_command_block",
+	212: "minecraft:frosted_ice",
+	213: "minecraft:magma",
+	214: "minecraft:nether_wart_block",
+	215: "minecraft:red_nether_brick",
+	216: "minecraft:bone_block",
+	217: "minecraft:structure_void",
+	218: "minecraft:observer",
+	219: "minecraft:white_shulker_box",
+	220: "minecraft:orange_shulker_box",
+	221: "minecraft:magenta_shulker_box",
+	222: "minecraft:light_blue_shulker_box",
+	223: "minecraft:yellow_shulker_box",
+	224: "minecraft:lime_shulker_box",
+	225: "minecraft:pink_shulker_box",
+	226: "minecraft:gray_shulker_box",
+	227: "minecraft:silver_shulker_box",
+	228: "minecraft:cyan_shulker_box",
+	229: "minecraft:purple_shulker_box",
+	230: "minecraft:blue_shulker_box",
+	231: "minecraft:brown_shulker_box",
+	232: "minecraft:green_shulker_box",
+	233: "minecraft:red_shulker_box",
+	234: "minecraft:black_shulker_box",
+	235: "minecraft:white_glazed_terracotta",
+	236: "minecraft:orange_glazed_terracotta",
+	237: "minecraft:magenta_glazed_terracotta",
+	238: "minecraft:light_blue_glazed_terracotta",
+	239: "minecraft:yellow_glazed_terracotta",
+	240: "minecraft:lime_glazed_terracotta",
+	241: "minecraft:pink_glazed_terracotta",
+	242: "minecraft:gray_glazed_terracotta",
+	243: "minecraft:silver_glazed_terracotta",
+	244: "minecraft:cyan_glazed_terracotta",
+	245: "minecraft:purple_glazed_terracotta",
+	246: "minecraft:blue_glazed_terracotta",
+	247: "minecraft:brown_glazed_terracotta",
+	248: "minecraft:green_glazed_terracotta",
+	249: "minecraft:red_glazed_terracotta",
+	250: "minecraft:black_glazed_terracotta",
+	251: "minecraft:concrete",
+	252: "minecraft:concrete_powder",
+	255: "minecraft:structure_block",
+}
+
+// StateList contains every valid state by its global state ID (id<<4 | meta)
+var StateList = [256 << 4]Block{
+	0x000: Air{},
+	0x010: Stone{Variant: StoneVariantStone},
+	0x011: Stone{Variant: StoneVariantGranite},
+	0x012: Stone{Variant: StoneVariantSmoothGranite},
+	0x013: Stone{Variant: StoneVariantDiorite},
+	0x014: Stone{Variant: StoneVariantSmoothDiorite},
+	0x015: Stone{Variant: StoneVariantAndesite},
+	0x016: Stone{Variant: StoneVariantSmoothAndesite},
+	0x020: Grass{},
+	0x030: Dirt{Variant: DirtVariantDirt},
+	0x031: Dirt{Variant: DirtVariantCoarseDirt},
+	0x032: Dirt{Variant: DirtVariantPodzol},
+	0x040: Cobblestone{},
+	0x050: Planks{Variant: WoodTypeOak},
+	0x051: Planks{Variant: WoodTypeSpruce},
+	0x052: Planks{Variant: WoodTypeBirch},
+	0x053: Planks{Variant: WoodTypeJungle},
+	0x054: Planks{Variant: WoodTypeAcacia},
+	0x055: Planks{Variant: WoodTypeDarkOak},
+	0x060: Sapling{Type: WoodTypeOak, Stage: 0},
+	0x061: Sapling{Type: WoodTypeSpruce, Stage: 0},
+	0x062: Sapling{Type: WoodTypeBirch, Stage: 0},
+	0x063: Sapling{Type: WoodTypeJungle, Stage: 0},
+	0x064: Sapling{Type: WoodTypeAcacia, Stage: 0},
+	0x065: Sapling{Type: WoodTypeDarkOak, Stage: 0},
+	0x068: Sapling{Type: WoodTypeOak, Stage: 1},
+	0x069: Sapling{Type: WoodTypeSpruce, Stage: 1},
+	0x06a: Sapling{Type: WoodTypeBirch, Stage: 1},
+	0x06b: Sapling{Type: WoodTypeJungle, Stage: 1},
+	0x06c: Sapling{Type: WoodTypeAcacia, Stage: 1},
+	0x06d: Sapling{Type: WoodTypeDarkOak, Stage: 1},
+	0x070: Bedrock{},
+	0x080: FlowingWater{Level: 0},
+	0x081: FlowingWater{Level: 1},
+	0x082: FlowingWater{Level: 2},
+	0x083: FlowingWater{Level: 3},
+	0x084: FlowingWater{Level: 4},
+	0x085: FlowingWater{Level: 5},
+	0x086: FlowingWater{Level: 6},
+	0x087: FlowingWater{Level: 7},
+	0x088: FlowingWater{Level: 8},
+	0x089: FlowingWater{Level: 9},
+	0x08a: FlowingWater{Level: 10},
+	0x08b: FlowingWater{Level: 11},
+	0x08c: FlowingWater{Level: 12},
+	0x08d: FlowingWater{Level: 13},
+	0x08e: FlowingWater{Level: 14},
+	0x08f: FlowingWater{Level: 15},
+	0x090: Water{Level: 0},
+	0x091: Water{Level: 1},
+	0x092: Water{Level: 2},
+	0x093: Water{Level: 3},
+	0x094: Water{Level: 4},
+	0x095: Water{Level: 5},
+	0x096: Water{Level: 6},
+	0x097: Water{Level: 7},
+	0x098: Water{Level: 8},
+	0x099: Water{Level: 9},
+	0x09a: Water{Level: 10},
+	0x09b: Water{Level: 11},
+	0x09c: Water{Level: 12},
+	0x09d: Water{Level: 13},
+	0x09e: Water{Level: 14},
+	0x09f: Water{Level: 15},
+	0x0a0: FlowingLava{Level: 0},
+	0x0a1: FlowingLava{Level: 1},
+	0x0a2: FlowingLava{Level: 2},
+	0x0a3: FlowingLava{Level: 3},
+	0x0a4: FlowingLava{Level: 4},
+	0x0a5: FlowingLava{Level: 5},
+	0x0a6: FlowingLava{Level: 6},
+	0x0a7: FlowingLava{Level: 7},
+	0x0a8: FlowingLava{Level: 8},
+	0x0a9: FlowingLava{Level: 9},
+	0x0aa: FlowingLava{Level: 10},
+	0x0ab: FlowingLava{Level: 11},
+	0x0ac: FlowingLava{Level: 12},
+	0x0ad: FlowingLava{Level: 13},
+	0x0ae: FlowingLava{Level: 14},
+	0x0af: FlowingLava{Level: 15},
+	0x0b0: Lava{Level: 0},
+	0x0b1: Lava{Level: 1},
+	0x0b2: Lava{Level: 2},
+	0x0b3: Lava{Level: 3},
+	0x0b4: Lava{Level: 4},
+	0x0b5: Lava{Level: 5},
+	0x0b6: Lava{Level: 6},
+	0x0b7: Lava{Level: 7},
+	0x0b8: Lava{Level: 8},
+	0x0b9: Lava{Level: 9},
+	0x0ba: Lava{Level: 10},
+	0x0bb: Lava{Level: 11},
+	0x0bc: Lava{Level: 12},
+	0x0bd: Lava{Level: 13},
+	0x0be: Lava{Level: 14},
+	0x0bf: Lava{Level: 15},
+	0x0c0: Sand{Variant: SandVariantSand},
+	0x0c1: Sand{Variant: SandVariantRedSand},
+	0x0d0: Gravel{},
+	0x0e0: GoldOre{},
+	0x0f0: IronOre{},
+	0x100: CoalOre{},
+	0x110: Log{Variant: WoodTypeOak, Axis: LogAxisY},
+	0x111: Log{Variant: WoodTypeSpruce, Axis: LogAxisY},
+	0x112: Log{Variant: WoodTypeBirch, Axis: LogAxisY},
+	0x113: Log{Variant: WoodTypeJungle, Axis: LogAxisY},
+	0x114: Log{Variant: WoodTypeOak, Axis: LogAxisX},
+	0x115: Log{Variant: WoodTypeSpruce, Axis: LogAxisX},
+	0x116: Log{Variant: WoodTypeBirch, Axis: LogAxisX},
+	0x117: Log{Variant: WoodTypeJungle, Axis: LogAxisX},
+	0x118: Log{Variant: WoodTypeOak, Axis: LogAxisZ},
+	0x119: Log{Variant: WoodTypeSpruce, Axis: LogAxisZ},
+	0x11a: Log{Variant: WoodTypeBirch, Axis: LogAxisZ},
+	0x11b: Log{Variant: WoodTypeJungle, Axis: LogAxisZ},
+	0x11c: Log{Variant: WoodTypeOak, Axis: LogAxisNone},
+	0x11d: Log{Variant: WoodTypeSpruce, Axis: LogAxisNone},
+	0x11e: Log{Variant: WoodTypeBirch, Axis: LogAxisNone},
+	0x11f: Log{Variant: WoodTypeJungle, Axis: LogAxisNone},
+	0x120: Leaves{Variant: WoodTypeOak, Decayable: true, CheckDecay: false},
+	0x121: Leaves{Variant: WoodTypeSpruce, Decayable: true, CheckDecay: false},
+	0x122: Leaves{Variant: WoodTypeBirch, Decayable: true, CheckDecay: false},
+	0x123: Leaves{Variant: WoodTypeJungle, Decayable: true, CheckDecay: false},
+	0x124: Leaves{Variant: WoodTypeOak, Decayable: false, CheckDecay: false},
+	0x125: Leaves{Variant: WoodTypeSpruce, Decayable: false, CheckDecay: false},
+	0x126: Leaves{Variant: WoodTypeBirch, Decayable: false, CheckDecay: false},
+	0x127: Leaves{Variant: WoodTypeJungle, Decayable: false, CheckDecay: false},
+	0x128: Leaves{Variant: WoodTypeOak, Decayable: true, CheckDecay: true},
+	0x129: Leaves{Variant: WoodTypeSpruce, Decayable: true, CheckDecay: true},
+	0x12a: Leaves{Variant: WoodTypeBirch, Decayable: true, CheckDecay: true},
+	0x12b: Leaves{Variant: WoodTypeJungle, Decayable: true, CheckDecay: true},
+	0x12c: Leaves{Variant: WoodTypeOak, Decayable: false, CheckDecay: true},
+	0x12d: Leaves{Variant: WoodTypeSpruce, Decayable: false, CheckDecay: true},
+	0x12e: Leaves{Variant: WoodTypeBirch, Decayable: false, CheckDecay: true},
+	0x12f: Leaves{Variant: WoodTypeJungle, Decayable: false, CheckDecay: true},
+	0x130: Sponge{Wet: false},
+	0x131: Sponge{Wet: true},
+	0x140: Glass{},
+	0x150: LapisOre{},
+	0x160: LapisBlock{},
+	0x170: Dispenser{Facing: FacingDown, Triggered: false},
+	0x171: Dispenser{Facing: FacingUp, Triggered: false},
+	0x172: Dispenser{Facing: FacingNorth, Triggered: false},
+	0x173: Dispenser{Facing: FacingSouth, Triggered: false},
+	0x174: Dispenser{Facing: FacingWest, Triggered: false},
+	0x175: Dispenser{Facing: FacingEast, Triggered: false},
+	0x178: Dispenser{Facing: FacingDown, Triggered: true},
+	0x179: Dispenser{Facing: FacingUp, Triggered: true},
+	0x17a: Dispenser{Facing: FacingNorth, Triggered: true},
+	0x17b: Dispenser{Facing: FacingSouth, Triggered: true},
+	0x17c: Dispenser{Facing: FacingWest, Triggered: true},
+	0x17d: Dispenser{Facing: FacingEast, Triggered: true},
+	0x180: Sandstone{Type: SandstoneTypeSandstone},
+	0x181: Sandstone{Type: SandstoneTypeChiseledSandstone},
+	0x182: Sandstone{Type: SandstoneTypeSmoothSandstone},
+	0x190: Noteblock{},
+	0x1a0: Bed{Facing: FacingSouth, Part: BedPartFoot},
+	0x1a1: Bed{Facing: FacingWest, Part: BedPartFoot},
+	0x1a2: Bed{Facing: FacingNorth, Part: BedPartFoot},
+	0x1a3: Bed{Facing: FacingEast, Part: BedPartFoot},
+	0x1a8: Bed{Facing: FacingSouth, Part: BedPartHead, Occupied: false},
+	0x1a9: Bed{Facing: FacingWest, Part: BedPartHead, Occupied: false},
+	0x1aa: Bed{Facing: FacingNorth, Part: BedPartHead, Occupied: false},
+	0x1ab: Bed{Facing: FacingEast, Part: BedPartHead, Occupied: false},
+	0x1ac: Bed{Facing: FacingSouth, Part: BedPartHead, Occupied: true},
+	0x1ad: Bed{Facing: FacingWest, Part: BedPartHead, Occupied: true},
+	0x1ae: Bed{Facing: FacingNorth, Part: BedPartHead, Occupied: true},
+	0x1af: Bed{Facing: FacingEast, Part: BedPartHead, Occupied: true},
+	0x1b0: GoldenRail{Shape: RailShapeNorthSouth, Powered: false},
+	0x1b1: GoldenRail{Shape: RailShapeEastWest, Powered: false},
+	0x1b2: GoldenRail{Shape: RailShapeAscendingEast, Powered: false},
+	0x1b3: GoldenRail{Shape: RailShapeAscendingWest, Powered: false},
+	0x1b4: GoldenRail{Shape: RailShapeAscendingNorth, Powered: false},
+	0x1b5: GoldenRail{Shape: RailShapeAscendingSouth, Powered: false},
+	0x1b8: GoldenRail{Shape: RailShapeNorthSouth, Powered: true},
+	0x1b9: GoldenRail{Shape: RailShapeEastWest, Powered: true},
+	0x1ba: GoldenRail{Shape: RailShapeAscendingEast, Powered: true},
+	0x1bb: GoldenRail{Shape: RailShapeAscendingWest, Powered: true},
+	0x1bc: GoldenRail{Shape: RailShapeAscendingNorth, Powered: true},
+	0x1bd: GoldenRail{Shape: RailShapeAscendingSouth, Powered: true},
+	0x1c0: DetectorRail{Shape: RailShapeNorthSouth, Powered: false},
+	0x1c1: DetectorRail{Shape: RailShapeEastWest, Powered: false},
+	0x1c2: DetectorRail{Shape: RailShapeAscendingEast, Powered: false},
+	0x1c3: DetectorRail{Shape: RailShapeAscendingWest, Powered: false},
+	0x1c4: DetectorRail{Shape: RailShapeAscendingNorth, Powered: false},
+	0x1c5: DetectorRail{Shape: RailShapeAscendingSouth, Powered: false},
+	0x1c8: DetectorRail{Shape: RailShapeNorthSouth, Powered: true},
+	0x1c9: DetectorRail{Shape: RailShapeEastWest, Powered: true},
+	0x1ca: DetectorRail{Shape: RailShapeAscendingEast, Powered: true},
+	0x1cb: DetectorRail{Shape: RailShapeAscendingWest, Powered: true},
+	0x1cc: DetectorRail{Shape: RailShapeAscendingNorth, Powered: true},
+	0x1cd: DetectorRail{Shape: RailShapeAscendingSouth, Powered: true},
+	0x1d0: StickyPiston{Facing: FacingDown, Extended: false},
+	0x1d1: StickyPiston{Facing: FacingUp, Extended: false},
+	0x1d2: StickyPiston{Facing: FacingNorth, Extended: false},
+	0x1d3: StickyPiston{Facing: FacingSouth, Extended: false},
+	0x1d4: StickyPiston{Facing: FacingWest, Extended: false},
+	0x1d5: StickyPiston{Facing: FacingEast, Extended: false},
+	0x1d8: StickyPiston{Facing: FacingDown, Extended: true},
+	0x1d9: StickyPiston{Facing: FacingUp, Extended: true},
+	0x1da: StickyPiston{Facing: FacingNorth, Extended: true},
+	0x1db: StickyPiston{Facing: FacingSouth, Extended: true},
+	0x1dc: StickyPiston{Facing: FacingWest, Extended: true},
+	0x1dd: StickyPiston{Facing: FacingEast, Extended: true},
+	0x1e0: Web{},
+	0x1f0: Tallgrass{Type: TallGrassTypeDeadBush},
+	0x1f1: Tallgrass{Type: TallGrassTypeTallGrass},
+	0x1f2: Tallgrass{Type: TallGrassTypeFern},
+	0x200: Deadbush{},
+	0x210: Piston{Facing: FacingDown, Extended: false},
+	0x211: Piston{Facing: FacingUp, Extended: false},
+	0x212: Piston{Facing: FacingNorth, Extended: false},
+	0x213: Piston{Facing: FacingSouth, Extended: false},
+	0x214: Piston{Facing: FacingWest, Extended: false},
+	0x215: Piston{Facing: FacingEast, Extended: false},
+	0x218: Piston{Facing: FacingDown, Extended: true},
+	0x219: Piston{Facing: FacingUp, Extended: true},
+	0x21a: Piston{Facing: FacingNorth, Extended: true},
+	0x21b: Piston{Facing: FacingSouth, Extended: true},
+	0x21c: Piston{Facing: FacingWest, Extended: true},
+	0x21d: Piston{Facing: FacingEast, Extended: true},
+	0x220: PistonHead{Facing: FacingDown, Type: PistonTypeNormal},
+	0x221: PistonHead{Facing: FacingUp, Type: PistonTypeNormal},
+	0x222: PistonHead{Facing: FacingNorth, Type: PistonTypeNormal},
+	0x223: PistonHead{Facing: FacingSouth, Type: PistonTypeNormal},
+	0x224: PistonHead{Facing: FacingWest, Type: PistonTypeNormal},
+	0x225: PistonHead{Facing: FacingEast, Type: PistonTypeNormal},
+	0x228: PistonHead{Facing: FacingDown, Type: PistonTypeSticky},
+	0x229: PistonHead{Facing: FacingUp, Type: PistonTypeSticky},
+	0x22a: PistonHead{Facing: FacingNorth, Type: PistonTypeSticky},
+	0x22b: PistonHead{Facing: FacingSouth, Type: PistonTypeSticky},
+	0x22c: PistonHead{Facing: FacingWest, Type: PistonTypeSticky},
+	0x22d: PistonHead{Facing: FacingEast, Type: PistonTypeSticky},
+	0x230: Wool{Color: ColorWhite},
+	0x231: Wool{Color: ColorOrange},
+	0x232: Wool{Color: ColorMagenta},
+	0x233: Wool{Color: ColorLightBlue},
+	0x234: Wool{Color: ColorYellow},
+	0x235: Wool{Color: ColorLime},
+	0x236: Wool{Color: ColorPink},
+	0x237: Wool{Color: ColorGray},
+	0x238: Wool{Color: ColorSilver},
+	0x239: Wool{Color: ColorCyan},
+	0x23a: Wool{Color: ColorPurple},
+	0x23b: Wool{Color: ColorBlue},
+	0x23c: Wool{Color: ColorBrown},
+	0x23d: Wool{Color: ColorGreen},
+	0x23e: Wool{Color: ColorRed},
+	0x23f: Wool{Color: ColorBlack},
+	0x240: PistonExtension{Facing: FacingDown, Type: PistonTypeNormal},
+	0x241: PistonExtension{Facing: FacingUp, Type: PistonTypeNormal},
+	0x242: PistonExtension{Facing: FacingNorth, Type: PistonTypeNormal},
+	0x243: PistonExtension{Facing: FacingSouth, Type: PistonTypeNormal},
+	0x244: PistonExtension{Facing: FacingWest, Type: PistonTypeNormal},
+	0x245: PistonExtension{Facing: FacingEast, Type: PistonTypeNormal},
+	0x248: PistonExtension{Facing: FacingDown, Type: PistonTypeSticky},
+	0x249: PistonExtension{Facing: FacingUp, Type: PistonTypeSticky},
+	0x24a: PistonExtension{Facing: FacingNorth, Type: PistonTypeSticky},
+	0x24b: PistonExtension{Facing: FacingSouth, Type: PistonTypeSticky},
+	0x24c: PistonExtension{Facing: FacingWest, Type: PistonTypeSticky},
+	0x24d: PistonExtension{Facing: FacingEast, Type: PistonTypeSticky},
+	0x250: YellowFlower{},
+	0x260: RedFlower{Type: FlowerTypePoppy},
+	0x261: RedFlower{Type: FlowerTypeBlueOrchid},
+	0x262: RedFlower{Type: FlowerTypeAllium},
+	0x263: RedFlower{Type: FlowerTypeHoustonia},
+	0x264: RedFlower{Type: FlowerTypeRedTulip},
+	0x265: RedFlower{Type: FlowerTypeOrangeTulip},
+	0x266: RedFlower{Type: FlowerTypeWhiteTulip},
+	0x267: RedFlower{Type: FlowerTypePinkTulip},
+	0x268: RedFlower{Type: FlowerTypeOxeyeDaisy},
+	0x270: BrownMushroom{},
+	0x280: RedMushroom{},
+	0x290: GoldBlock{},
+	0x2a0: IronBlock{},
+	0x2b0: DoubleStoneSlab{Variant: StoneSlabVariantStone, Seamless: false},
+	0x2b1: DoubleStoneSlab{Variant: StoneSlabVariantSand, Seamless: false},
+	0x2b2: DoubleStoneSlab{Variant: StoneSlabVariantWoodOld, Seamless: false},
+	0x2b3: DoubleStoneSlab{Variant: StoneSlabVariantCobblestone, Seamless: false},
+	0x2b4: DoubleStoneSlab{Variant: StoneSlabVariantBrick, Seamless: false},
+	0x2b5: DoubleStoneSlab{Variant: StoneSlabVariantStoneBrick, Seamless: false},
+	0x2b6: DoubleStoneSlab{Variant: StoneSlabVariantNetherBrick, Seamless: false},
+	0x2b7: DoubleStoneSlab{Variant: StoneSlabVariantQuartz, Seamless: false},
+	0x2b8: DoubleStoneSlab{Variant: StoneSlabVariantStone, Seamless: true},
+	0x2b9: DoubleStoneSlab{Variant: StoneSlabVariantSand, Seamless: true},
+	0x2ba: DoubleStoneSlab{Variant: StoneSlabVariantWoodOld, Seamless: true},
+	0x2bb: DoubleStoneSlab{Variant: StoneSlabVariantCobblestone, Seamless: true},
+	0x2bc: DoubleStoneSlab{Variant: StoneSlabVariantBrick, Seamless: true},
+	0x2bd: DoubleStoneSlab{Variant: StoneSlabVariantStoneBrick, Seamless: true},
+	0x2be: DoubleStoneSlab{Variant: StoneSlabVariantNetherBrick, Seamless: true},
+	0x2bf: DoubleStoneSlab{Variant: StoneSlabVariantQuartz, Seamless: true},
+	0x2c0: StoneSlab{Variant: StoneSlabVariantStone, Half: HalfBottom},
+	0x2c1: StoneSlab{Variant: StoneSlabVariantSand, Half: HalfBottom},
+	0x2c2: StoneSlab{Variant: StoneSlabVariantWoodOld, Half: HalfBottom},
+	0x2c3: StoneSlab{Variant: StoneSlabVariantCobblestone, Half: HalfBottom},
+	0x2c4: StoneSlab{Variant: StoneSlabVariantBrick, Half: HalfBottom},
+	0x2c5: StoneSlab{Variant: StoneSlabVariantStoneBrick, Half: HalfBottom},
+	0x2c6: StoneSlab{Variant: StoneSlabVariantNetherBrick, Half: HalfBottom},
+	0x2c7: StoneSlab{Variant: StoneSlabVariantQuartz, Half: HalfBottom},
+	0x2c8: StoneSlab{Variant: StoneSlabVariantStone, Half: HalfTop},
+	0x2c9: StoneSlab{Variant: StoneSlabVariantSand, Half: HalfTop},
+	0x2ca: StoneSlab{Variant: StoneSlabVariantWoodOld, Half: HalfTop},
+	0x2cb: StoneSlab{Variant: StoneSlabVariantCobblestone, Half: HalfTop},
+	0x2cc: StoneSlab{Variant: StoneSlabVariantBrick, Half: HalfTop},
+	0x2cd: StoneSlab{Variant: StoneSlabVariantStoneBrick, Half: HalfTop},
+	0x2ce: StoneSlab{Variant: StoneSlabVariantNetherBrick, Half: HalfTop},
+	0x2cf: StoneSlab{Variant: StoneSlabVariantQuartz, Half: HalfTop},
+	0x2d0: BrickBlock{},
+	0x2e0: Tnt{Explode: false},
+	0x2e1: Tnt{Explode: true},
+	0x2f0: Bookshelf{},
+	0x300: MossyCobblestone{},
+	0x310: Obsidian{},
+	0x321: Torch{Facing: FacingEast},
+	0x322: Torch{Facing: FacingWest},
+	0x323: Torch{Facing: FacingSouth},
+	0x324: Torch{Facing: FacingNorth},
+	0x325: Torch{Facing: FacingUp},
+	0x330: Fire{Age: 0},
+	0x331: Fire{Age: 1},
+	0x332: Fire{Age: 2},
+	0x333: Fire{Age: 3},
+	0x334: Fire{Age: 4},
+	0x335: Fire{Age: 5},
+	0x336: Fire{Age: 6},
+	0x337: Fire{Age: 7},
+	0x338: Fire{Age: 8},
+	0x339: Fire{Age: 9},
+	0x33a: Fire{Age: 10},
+	0x33b: Fire{Age: 11},
+	0x33c: Fire{Age: 12},
+	0x33d: Fire{Age: 13},
+	0x33e: Fire{Age: 14},
+	0x33f: Fire{Age: 15},
+	0x340: MobSpawner{},
+	0x350: OakStairs{Facing: FacingEast, Half: HalfBottom},
+	0x351: OakStairs{Facing: FacingWest, Half: HalfBottom},
+	0x352: OakStairs{Facing: FacingSouth, Half: HalfBottom},
+	0x353: OakStairs{Facing: FacingNorth, Half: HalfBottom},
+	0x354: OakStairs{Facing: FacingEast, Half: HalfTop},
+	0x355: OakStairs{Facing: FacingWest, Half: HalfTop},
+	0x356: OakStairs{Facing: FacingSouth, Half: HalfTop},
+	0x357: OakStairs{Facing: FacingNorth, Half: HalfTop},
+	0x362: Chest{Facing: FacingNorth},
+	0x363: Chest{Facing: FacingSouth},
+	0x364: Chest{Facing: FacingWest},
+	0x365: Chest{Facing: FacingEast},
+	0x370: RedstoneWire{Power: 0},
+	0x371: RedstoneWire{Power: 1},
+	0x372: RedstoneWire{Power: 2},
+	0x373: RedstoneWire{Power: 3},
+	0x374: RedstoneWire{Power: 4},
+	0x375: RedstoneWire{Power: 5},
+	0x376: RedstoneWire{Power: 6},
+	0x377: RedstoneWire{Power: 7},
+	0x378: RedstoneWire{Power: 8},
+	0x379: RedstoneWire{Power: 9},
+	0x37a: RedstoneWire{Power: 10},
+	0x37b: RedstoneWire{Power: 11},
+	0x37c: RedstoneWire{Power: 12},
+	0x37d: RedstoneWire{Power: 13},
+	0x37e: RedstoneWire{Power: 14},
+	0x37f: RedstoneWire{Power: 15},
+	0x380: DiamondOre{},
+	0x390: DiamondBlock{},
+	0x3a0: CraftingTable{},
+	0x3b0: Wheat{Age: 0},
+	0x3b1: Wheat{Age: 1},
+	0x3b2: Wheat{Age: 2},
+	0x3b3: Wheat{Age: 3},
+	0x3b4: Wheat{Age: 4},
+	0x3b5: Wheat{Age: 5},
+	0x3b6: Wheat{Age: 6},
+	0x3b7: Wheat{Age: 7},
+	0x3c0: Farmland{Moisture: 0},
+	0x3c1: Farmland{Moisture: 1},
+	0x3c2: Farmland{Moisture: 2},
+	0x3c3: Farmland{Moisture: 3},
+	0x3c4: Farmland{Moisture: 4},
+	0x3c5: Farmland{Moisture: 5},
+	0x3c6: Farmland{Moisture: 6},
+	0x3c7: Farmland{Moisture: 7},
+	0x3d2: Furnace{Facing: FacingNorth},
+	0x3d3: Furnace{Facing: FacingSouth},
+	0x3d4: Furnace{Facing: FacingWest},
+	0x3d5: Furnace{Facing: FacingEast},
+	0x3e2: LitFurnace{Facing: FacingNorth},
+	0x3e3: LitFurnace{Facing: FacingSouth},
+	0x3e4: LitFurnace{Facing: FacingWest},
+	0x3e5: LitFurnace{Facing: FacingEast},
+	0x3f0: StandingSign{Rotation: 0},
+	0x3f1: StandingSign{Rotation: 1},
+	0x3f2: StandingSign{Rotation: 2},
+	0x3f3: StandingSign{Rotation: 3},
+	0x3f4: StandingSign{Rotation: 4},
+	0x3f5: StandingSign{Rotation: 5},
+	0x3f6: StandingSign{Rotation: 6},
+	0x3f7: StandingSign{Rotation: 7},
+	0x3f8: StandingSign{Rotation: 8},
+	0x3f9: StandingSign{Rotation: 9},
+	0x3fa: StandingSign{Rotation: 10},
+	0x3fb: StandingSign{Rotation: 11},
+	0x3fc: StandingSign{Rotation: 12},
+	0x3fd: StandingSign{Rotation: 13},
+	0x3fe: StandingSign{Rotation: 14},
+	0x3ff: StandingSign{Rotation: 15},
+	0x400: WoodenDoor{Half: DoubleBlockHalfLower, Facing: FacingEast, Open: false},
+	0x401: WoodenDoor{Half: DoubleBlockHalfLower, Facing: FacingSouth, Open: false},
+	0x402: WoodenDoor{Half: DoubleBlockHalfLower, Facing: FacingWest, Open: false},
+	0x403: WoodenDoor{Half: DoubleBlockHalfLower, Facing: FacingNorth, Open: false},
+	0x404: WoodenDoor{Half: DoubleBlockHalfLower, Facing: FacingEast, Open: true},
+	0x405: WoodenDoor{Half: DoubleBlockHalfLower, Facing: FacingSouth, Open: true},
+	0x406: WoodenDoor{Half: DoubleBlockHalfLower, Facing: FacingWest, Open: true},
+	0x407: WoodenDoor{Half: DoubleBlockHalfLower, Facing: FacingNorth, Open: true},
+	0x408: WoodenDoor{Half: DoubleBlockHalfUpper, Hinge: HingeLeft, Powered: false},
+	0x409: WoodenDoor{Half: DoubleBlockHalfUpper, Hinge: HingeRight, Powered: false},
+	0x40a: WoodenDoor{Half: DoubleBlockHalfUpper, Hinge: HingeLeft, Powered: true},
+	0x40b: WoodenDoor{Half: DoubleBlockHalfUpper, Hinge: HingeRight, Powered: true},
+	0x412: Ladder{Facing: FacingNorth},
+	0x413: Ladder{Facing: FacingSouth},
+	0x414: Ladder{Facing: FacingWest},
+	0x415: Ladder{Facing: FacingEast},
+	0x420: Rail{Shape: RailShapeNorthSouth},
+	0x421: Rail{Shape: RailShapeEastWest},
+	0x422: Rail{Shape: RailShapeAscendingEast},
+	0x423: Rail{Shape: RailShapeAscendingWest},
+	0x424: Rail{Shape: RailShapeAscendingNorth},
+	0x425: Rail{Shape: RailShapeAscendingSouth},
+	0x426: Rail{Shape: RailShapeSouthEast},
+	0x427: Rail{Shape: RailShapeSouthWest},
+	0x428: Rail{Shape: RailShapeNorthWest},
+	0x429: Rail{Shape: RailShapeNorthEast},
+	0x430: StoneStairs{Facing: FacingEast, Half: HalfBottom},
+	0x431: StoneStairs{Facing: FacingWest, Half: HalfBottom},
+	0x432: StoneStairs{Facing: FacingSouth, Half: HalfBottom},
+	0x433: StoneStairs{Facing: FacingNorth, Half: HalfBottom},
+	0x434: StoneStairs{Facing: FacingEast, Half: HalfTop},
+	0x435: StoneStairs{Facing: FacingWest, Half: HalfTop},
+	0x436: StoneStairs{Facing: FacingSouth, Half: HalfTop},
+	0x437: StoneStairs{Facing: FacingNorth, Half: HalfTop},
+	0x442: WallSign{Facing: FacingNorth},
+	0x443: WallSign{Facing: FacingSouth},
+	0x444: WallSign{Facing: FacingWest},
+	0x445: WallSign{Facing: FacingEast},
+	0x450: Lever{Facing: LeverOrientationDownX, Powered: false},
+	0x451: Lever{Facing: LeverOrientationEast, Powered: false},
+	0x452: Lever{Facing: LeverOrientationWest, Powered: false},
+	0x453: Lever{Facing: LeverOrientationSouth, Powered: false},
+	0x454: Lever{Facing: LeverOrientationNorth, Powered: false},
+	0x455: Lever{Facing: LeverOrientationUpZ, Powered: false},
+	0x456: Lever{Facing: LeverOrientationUpX, Powered: false},
+	0x457: Lever{Facing: LeverOrientationDownZ, Powered: false},
+	0x458: Lever{Facing: LeverOrientationDownX, Powered: true},
+	0x459: Lever{Facing: LeverOrientationEast, Powered: true},
+	0x45a: Lever{Facing: LeverOrientationWest, Powered: true},
+	0x45b: Lever{Facing: LeverOrientationSouth, Powered: true},
+	0x45c: Lever{Facing: LeverOrientationNorth, Powered: true},
+	0x45d: Lever{Facing: LeverOrientationUpZ, Powered: true},
+	0x45e: Lever{Facing: LeverOrientationUpX, Powered: true},
+	0x45f: Lever{Facing: LeverOrientationDownZ, Powered: true},
+	0x460: StonePressurePlate{Powered: false},
+	0x461: StonePressurePlate{Powered: true},
+	0x470: IronDoor{Half: DoubleBlockHalfLower, Facing: FacingEast, Open: false},
+	0x471: IronDoor{Half: DoubleBlockHalfLower, Facing: FacingSouth, Open: false},
+	0x472: IronDoor{Half: DoubleBlockHalfLower, Facing: FacingWest, Open: false},
+	0x473: IronDoor{Half: DoubleBlockHalfLower, Facing: FacingNorth, Open: false},
+	0x474: IronDoor{Half: DoubleBlockHalfLower, Facing: FacingEast, Open: true},
+	0x475: IronDoor{Half: DoubleBlockHalfLower, Facing: FacingSouth, Open: true},
+	0x476: IronDoor{Half: DoubleBlockHalfLower, Facing: FacingWest, Open: true},
+	0x477: IronDoor{Half: DoubleBlockHalfLower, Facing: FacingNorth, Open: true},
+	0x478: IronDoor{Half: DoubleBlockHalfUpper, Hinge: HingeLeft, Powered: false},
+	0x479: IronDoor{Half: DoubleBlockHalfUpper, Hinge: HingeRight, Powered: false},
+	0x47a: IronDoor{Half: DoubleBlockHalfUpper, Hinge: HingeLeft, Powered: true},
+	0x47b: IronDoor{Half: DoubleBlockHalfUpper, Hinge: HingeRight, Powered: true},
+	0x480: WoodenPressurePlate{Powered: false},
+	0x481: WoodenPressurePlate{Powered: true},
+	0x490: RedstoneOre{},
+	0x4a0: LitRedstoneOre{},
+	0x4b1: UnlitRedstoneTorch{Facing: FacingEast},
+	0x4b2: UnlitRedstoneTorch{Facing: FacingWest},
+	0x4b3: UnlitRedstoneTorch{Facing: FacingSouth},
+	0x4b4: UnlitRedstoneTorch{Facing: FacingNorth},
+	0x4b5: UnlitRedstoneTorch{Facing: FacingUp},
+	0x4c1: RedstoneTorch{Facing: FacingEast},
+	0x4c2: RedstoneTorch{Facing: FacingWest},
+	0x4c3: RedstoneTorch{Facing: FacingSouth},
+	0x4c4: RedstoneTorch{Facing: FacingNorth},
+	0x4c5: RedstoneTorch{Facing: FacingUp},
+	0x4d0: StoneButton{Facing: FacingDown, Powered: false},
+	0x4d1: StoneButton{Facing: FacingEast, Powered: false},
+	0x4d2: StoneButton{Facing: FacingWest, Powered: false},
+	0x4d3: StoneButton{Facing: FacingSouth, Powered: false},
+	0x4d4: StoneButton{Facing: FacingNorth, Powered: false},
+	0x4d5: StoneButton{Facing: FacingUp, Powered: false},
+	0x4d8: StoneButton{Facing: FacingDown, Powered: true},
+	0x4d9: StoneButton{Facing: FacingEast, Powered: true},
+	0x4da: StoneButton{Facing: FacingWest, Powered: true},
+	0x4db: StoneButton{Facing: FacingSouth, Powered: true},
+	0x4dc: StoneButton{Facing: FacingNorth, Powered: true},
+	0x4dd: StoneButton{Facing: FacingUp, Powered: true},
+	0x4e0: SnowLayer{Layers: 1},
+	0x4e1: SnowLayer{Layers: 2},
+	0x4e2: SnowLayer{Layers: 3},
+	0x4e3: SnowLayer{Layers: 4},
+	0x4e4: SnowLayer{Layers: 5},
+	0x4e5: SnowLayer{Layers: 6},
+	0x4e6: SnowLayer{Layers: 7},
+	0x4e7: SnowLayer{Layers: 8},
+	0x4f0: Ice{},
+	0x500: Snow{},
+	0x510: Cactus{Age: 0},
+	0x511: Cactus{Age: 1},
+	0x512: Cactus{Age: 2},
+	0x513: Cactus{Age: 3},
+	0x514: Cactus{Age: 4},
+	0x515: Cactus{Age: 5},
+	0x516: Cactus{Age: 6},
+	0x517: Cactus{Age: 7},
+	0x518: Cactus{Age: 8},
+	0x519: Cactus{Age: 9},
+	0x51a: Cactus{Age: 10},
+	0x51b: Cactus{Age: 11},
+	0x51c: Cactus{Age: 12},
+	0x51d: Cactus{Age: 13},
+	0x51e: Cactus{Age: 14},
+	0x51f: Cactus{Age: 15},
+	0x520: Clay{},
+	0x530: Reeds{Age: 0},
+	0x531: Reeds{Age: 1},
+	0x532: Reeds{Age: 2},
+	0x533: Reeds{Age: 3},
+	0x534: Reeds{Age: 4},
+	0x535: Reeds{Age: 5},
+	0x536: Reeds{Age: 6},
+	0x537: Reeds{Age: 7},
+	0x538: Reeds{Age: 8},
+	0x539: Reeds{Age: 9},
+	0x53a: Reeds{Age: 10},
+	0x53b: Reeds{Age: 11},
+	0x53c: Reeds{Age: 12},
+	0x53d: Reeds{Age: 13},
+	0x53e: Reeds{Age: 14},
+	0x53f: Reeds{Age: 15},
+	0x540: Jukebox{HasRecord: false},
+	0x541: Jukebox{HasRecord: true},
+	0x550: Fence{},
+	0x560: Pumpkin{Facing: FacingSouth},
+	0x561: Pumpkin{Facing: FacingWest},
+	0x562: Pumpkin{Facing: FacingNorth},
+	0x563: Pumpkin{Facing: FacingEast},
+	0x570: Netherrack{},
+	0x580: SoulSand{},
+	0x590: Glowstone{},
+	0x5a1: Portal{Axis: AxisX},
+	0x5a2: Portal{Axis: AxisZ},
+	0x5b0: LitPumpkin{Facing: FacingSouth},
+	0x5b1: LitPumpkin{Facing: FacingWest},
+	0x5b2: LitPumpkin{Facing: FacingNorth},
+	0x5b3: LitPumpkin{Facing: FacingEast},
+	0x5c0: Cake{Bites: 0},
+	0x5c1: Cake{Bites: 1},
+	0x5c2: Cake{Bites: 2},
+	0x5c3: Cake{Bites: 3},
+	0x5c4: Cake{Bites: 4},
+	0x5c5: Cake{Bites: 5},
+	0x5c6: Cake{Bites: 6},
+	0x5d0: UnpoweredRepeater{Facing: FacingSouth, Delay: 1},
+	0x5d1: UnpoweredRepeater{Facing: FacingWest, Delay: 1},
+	0x5d2: UnpoweredRepeater{Facing: FacingNorth, Delay: 1},
+	0x5d3: UnpoweredRepeater{Facing: FacingEast, Delay: 1},
+	0x5d4: UnpoweredRepeater{Facing: FacingSouth, Delay: 2},
+	0x5d5: UnpoweredRepeater{Facing: FacingWest, Delay: 2},
+	0x5d6: UnpoweredRepeater{Facing: FacingNorth, Delay: 2},
+	0x5d7: UnpoweredRepeater{Facing: FacingEast, Delay: 2},
+	0x5d8: UnpoweredRepeater{Facing: FacingSouth, Delay: 3},
+	0x5d9: UnpoweredRepeater{Facing: FacingWest, Delay: 3},
+	0x5da: UnpoweredRepeater{Facing: FacingNorth, Delay: 3},
+	0x5db: UnpoweredRepeater{Facing: FacingEast, Delay: 3},
+	0x5dc: UnpoweredRepeater{Facing: FacingSouth, Delay: 4},
+	0x5dd: UnpoweredRepeater{Facing: FacingWest, Delay: 4},
+	0x5de: UnpoweredRepeater{Facing: FacingNorth, Delay: 4},
+	0x5df: UnpoweredRepeater{Facing: FacingEast, Delay: 4},
+	0x5e0: PoweredRepeater{Facing: FacingSouth, Delay: 1},
+	0x5e1: PoweredRepeater{Facing: FacingWest, Delay: 1},
+	0x5e2: PoweredRepeater{Facing: FacingNorth, Delay: 1},
+	0x5e3: PoweredRepeater{Facing: FacingEast, Delay: 1},
+	0x5e4: PoweredRepeater{Facing: FacingSouth, Delay: 2},
+	0x5e5: PoweredRepeater{Facing: FacingWest, Delay: 2},
+	0x5e6: PoweredRepeater{Facing: FacingNorth, Delay: 2},
+	0x5e7: PoweredRepeater{Facing: FacingEast, Delay: 2},
+	0x5e8: PoweredRepeater{Facing: FacingSouth, Delay: 3},
+	0x5e9: PoweredRepeater{Facing: FacingWest, Delay: 3},
+	0x5ea: PoweredRepeater{Facing: FacingNorth, Delay: 3},
+	0x5eb: PoweredRepeater{Facing: FacingEast, Delay: 3},
+	0x5ec: PoweredRepeater{Facing: FacingSouth, Delay: 4},
+	0x5ed: PoweredRepeater{Facing: FacingWest, Delay: 4},
+	0x5ee: PoweredRepeater{Facing: FacingNorth, Delay: 4},
+	0x5ef: PoweredRepeater{Facing: FacingEast, Delay: 4},
+	0x5f0: StainedGlass{Color: ColorWhite},
+	0x5f1: StainedGlass{Color: ColorOrange},
+	0x5f2: StainedGlass{Color: ColorMagenta},
+	0x5f3: StainedGlass{Color: ColorLightBlue},
+	0x5f4: StainedGlass{Color: ColorYellow},
+	0x5f5: StainedGlass{Color: ColorLime},
+	0x5f6: StainedGlass{Color: ColorPink},
+	0x5f7: StainedGlass{Color: ColorGray},
+	0x5f8: StainedGlass{Color: ColorSilver},
+	0x5f9: StainedGlass{Color: ColorCyan},
+	0x5fa: StainedGlass{Color: ColorPurple},
+	0x5fb: StainedGlass{Color: ColorBlue},
+	0x5fc: StainedGlass{Color: ColorBrown},
+	0x5fd: StainedGlass{Color: ColorGreen},
+	0x5fe: StainedGlass{Color: ColorRed},
+	0x5ff: StainedGlass{Color: ColorBlack},
+	0x600: Trapdoor{Facing: FacingNorth, Open: false, Half: HalfBottom},
+	0x601: Trapdoor{Facing: FacingSouth, Open: false, Half: HalfBottom},
+	0x602: Trapdoor{Facing: FacingWest, Open: false, Half: HalfBottom},
+	0x603: Trapdoor{Facing: FacingEast, Open: false, Half: HalfBottom},
+	0x604: Trapdoor{Facing: FacingNorth, Open: true, Half: HalfBottom},
+	0x605: Trapdoor{Facing: FacingSouth, Open: true, Half: HalfBottom},
+	0x606: Trapdoor{Facing: FacingWest, Open: true, Half: HalfBottom},
+	0x607: Trapdoor{Facing: FacingEast, Open: true, Half: HalfBottom},
+	0x608: Trapdoor{Facing: FacingNorth, Open: false, Half: HalfTop},
+	0x609: Trapdoor{Facing: FacingSouth, Open: false, Half: HalfTop},
+	0x60a: Trapdoor{Facing: FacingWest, Open: false, Half: HalfTop},
+	0x60b: Trapdoor{Facing: FacingEast, Open: false, Half: HalfTop},
+	0x60c: Trapdoor{Facing: FacingNorth, Open: true, Half: HalfTop},
+	0x60d: Trapdoor{Facing: FacingSouth, Open: true, Half: HalfTop},
+	0x60e: Trapdoor{Facing: FacingWest, Open: true, Half: HalfTop},
+	0x60f: Trapdoor{Facing: FacingEast, Open: true, Half: HalfTop},
+	0x610: MonsterEgg{Variant: SilverfishVariantStone},
+	0x611: MonsterEgg{Variant: SilverfishVariantCobblestone},
+	0x612: MonsterEgg{Variant: SilverfishVariantStoneBrick},
+	0x613: MonsterEgg{Variant: SilverfishVariantMossyBrick},
+	0x614: MonsterEgg{Variant: SilverfishVariantCrackedBrick},
+	0x615: MonsterEgg{Variant: SilverfishVariantChiseledBrick},
+	0x620: Stonebrick{Variant: StoneBrickVariantStonebrick},
+	0x621: Stonebrick{Variant: StoneBrickVariantMossyStonebrick},
+	0x622: Stonebrick{Variant: StoneBrickVariantCrackedStonebrick},
+	0x623: Stonebrick{Variant: StoneBrickVariantChiseledStonebrick},
+	0x630: BrownMushroomBlock{Variant: HugeMushroomVariantAllInside},
+	0x631: BrownMushroomBlock{Variant: HugeMushroomVariantNorthWest},
+	0x632: BrownMushroomBlock{Variant: HugeMushroomVariantNorth},
+	0x633: BrownMushroomBlock{Variant: HugeMushroomVariantNorthEast},
+	0x634: BrownMushroomBlock{Variant: HugeMushroomVariantWest},
+	0x635: BrownMushroomBlock{Variant: HugeMushroomVariantCenter},
+	0x636: BrownMushroomBlock{Variant: HugeMushroomVariantEast},
+	0x637: BrownMushroomBlock{Variant: HugeMushroomVariantSouthWest},
+	0x638: BrownMushroomBlock{Variant: HugeMushroomVariantSouth},
+	0x639: BrownMushroomBlock{Variant: HugeMushroomVariantSouthEast},
+	0x63a: BrownMushroomBlock{Variant: HugeMushroomVariantStem},
+	0x63e: BrownMushroomBlock{Variant: HugeMushroomVariantAllOutside},
+	0x63f: BrownMushroomBlock{Variant: HugeMushroomVariantAllStem},
+	0x640: RedMushroomBlock{Variant: HugeMushroomVariantAllInside},
+	0x641: RedMushroomBlock{Variant: HugeMushroomVariantNorthWest},
+	0x642: RedMushroomBlock{Variant: HugeMushroomVariantNorth},
+	0x643: RedMushroomBlock{Variant: HugeMushroomVariantNorthEast},
+	0x644: RedMushroomBlock{Variant: HugeMushroomVariantWest},
+	0x645: RedMushroomBlock{Variant: HugeMushroomVariantCenter},
+	0x646: RedMushroomBlock{Variant: HugeMushroomVariantEast},
+	0x647: RedMushroomBlock{Variant: HugeMushroomVariantSouthWest},
+	0x648: RedMushroomBlock{Variant: HugeMushroomVariantSouth},
+	0x649: RedMushroomBlock{Variant: HugeMushroomVariantSouthEast},
+	0x64a: RedMushroomBlock{Variant: HugeMushroomVariantStem},
+	0x64e: RedMushroomBlock{Variant: HugeMushroomVariantAllOutside},
+	0x64f: RedMushroomBlock{Variant: HugeMushroomVariantAllStem},
+	0x650: IronBars{},
+	0x660: GlassPane{},
+	0x670: MelonBlock{},
+	0x680: PumpkinStem{Age: 0},
+	0x681: PumpkinStem{Age: 1},
+	0x682: PumpkinStem{Age: 2},
+	0x683: PumpkinStem{Age: 3},
+	0x684: PumpkinStem{Age: 4},
+	0x685: PumpkinStem{Age: 5},
+	0x686: PumpkinStem{Age: 6},
+	0x687: PumpkinStem{Age: 7},
+	0x690: MelonStem{Age: 0},
+	0x691: MelonStem{Age: 1},
+	0x692: MelonStem{Age: 2},
+	0x693: MelonStem{Age: 3},
+	0x694: MelonStem{Age: 4},
+	0x695: MelonStem{Age: 5},
+	0x696: MelonStem{Age: 6},
+	0x697: MelonStem{Age: 7},
+	0x6a0: Vine{South: false, West: false, North: false, East: false},
+	0x6a1: Vine{South: true, West: false, North: false, East: false},
+	0x6a2: Vine{South: false, West: true, North: false, East: false},
+	0x6a3: Vine{South: true, West: true, North: false, East: false},
+	0x6a4: Vine{South: false, West: false, North: true, East: false},
+	0x6a5: Vine{South: true, West: false, North: true, East: false},
+	0x6a6: Vine{South: false, West: true, North: true, East: false},
+	0x6a7: Vine{South: true, West: true, North: true, East: false},
+	0x6a8: Vine{South: false, West: false, North: false, East: true},
+	0x6a9: Vine{South: true, West: false, North: false, East: true},
+	0x6aa: Vine{South: false, West: true, North: false, East: true},
+	0x6ab: Vine{South: true, West: true, North: false, East: true},
+	0x6ac: Vine{South: false, West: false, North: true, East: true},
+	0x6ad: Vine{South: true, West: false, North: true, East: true},
+	0x6ae: Vine{South: false, West: true, North: true, East: true},
+	0x6af: Vine{South: true, West: true, North: true, East: true},
+	0x6b0: FenceGate{Facing: FacingSouth, Open: false, Powered: false},
+	0x6b1: FenceGate{Facing: FacingWest, Open: false, Powered: false},
+	0x6b2: FenceGate{Facing: FacingNorth, Open: false, Powered: false},
+	0x6b3: FenceGate{Facing: FacingEast, Open: false, Powered: false},
+	0x6b4: FenceGate{Facing: FacingSouth, Open: true, Powered: false},
+	0x6b5: FenceGate{Facing: FacingWest, Open: true, Powered: false},
+	0x6b6: FenceGate{Facing: FacingNorth, Open: true, Powered: false},
+	0x6b7: FenceGate{Facing: FacingEast, Open: true, Powered: false},
+	0x6b8: FenceGate{Facing: FacingSouth, Open: false, Powered: true},
+	0x6b9: FenceGate{Facing: FacingWest, Open: false, Powered: true},
+	0x6ba: FenceGate{Facing: FacingNorth, Open: false, Powered: true},
+	0x6bb: FenceGate{Facing: FacingEast, Open: false, Powered: true},
+	0x6bc: FenceGate{Facing: FacingSouth, Open: true, Powered: true},
+	0x6bd: FenceGate{Facing: FacingWest, Open: true, Powered: true},
+	0x6be: FenceGate{Facing: FacingNorth, Open: true, Powered: true},
+	0x6bf: FenceGate{Facing: FacingEast, Open: true, Powered: true},
+	0x6c0: BrickStairs{Facing: FacingEast, Half: HalfBottom},
+	0x6c1: BrickStairs{Facing: FacingWest, Half: HalfBottom},
+	0x6c2: BrickStairs{Facing: FacingSouth, Half: HalfBottom},
+	0x6c3: BrickStairs{Facing: FacingNorth, Half: HalfBottom},
+	0x6c4: BrickStairs{Facing: FacingEast, Half: HalfTop},
+	0x6c5: BrickStairs{Facing: FacingWest, Half: HalfTop},
+	0x6c6: BrickStairs{Facing: FacingSouth, Half: HalfTop},
+	0x6c7: BrickStairs{Facing: FacingNorth, Half: HalfTop},
+	0x6d0: StoneBrickStairs{Facing: FacingEast, Half: HalfBottom},
+	0x6d1: StoneBrickStairs{Facing: FacingWest, Half: HalfBottom},
+	0x6d2: StoneBrickStairs{Facing: FacingSouth, Half: HalfBottom},
+	0x6d3: StoneBrickStairs{Facing: FacingNorth, Half: HalfBottom},
+	0x6d4: StoneBrickStairs{Facing: FacingEast, Half: HalfTop},
+	0x6d5: StoneBrickStairs{Facing: FacingWest, Half: HalfTop},
+	0x6d6: StoneBrickStairs{Facing: FacingSouth, Half: HalfTop},
+	0x6d7: StoneBrickStairs{Facing: FacingNorth, Half: HalfTop},
+	0x6e0: Mycelium{},
+	0x6f0: Waterlily{},
+	0x700: NetherBrick{},
+	0x710: NetherBrickFence{},
+	0x720: NetherBrickStairs{Facing: FacingEast, Half: HalfBottom},
+	0x721: NetherBrickStairs{Facing: FacingWest, Half: HalfBottom},
+	0x722: NetherBrickStairs{Facing: FacingSouth, Half: HalfBottom},
+	0x723: NetherBrickStairs{Facing: FacingNorth, Half: HalfBottom},
+	0x724: NetherBrickStairs{Facing: FacingEast, Half: HalfTop},
+	0x725: NetherBrickStairs{Facing: FacingWest, Half: HalfTop},
+	0x726: NetherBrickStairs{Facing: FacingSouth, Half: HalfTop},
+	0x727: NetherBrickStairs{Facing: FacingNorth, Half: HalfTop},
+	0x730: NetherWart{Age: 0},
+	0x731: NetherWart{Age: 1},
+	0x732: NetherWart{Age: 2},
+	0x733: NetherWart{Age: 3},
+	0x740: EnchantingTable{},
+	0x750: BrewingStand{HasBottle0: false, HasBottle1: false, HasBottle2: false},
+	0x751: BrewingStand{HasBottle0: true, HasBottle1: false, HasBottle2: false},
+	0x752: BrewingStand{HasBottle0: false, HasBottle1: true, HasBottle2: false},
+	0x753: BrewingStand{HasBottle0: true, HasBottle1: true, HasBottle2: false},
+	0x754: BrewingStand{HasBottle0: false, HasBottle1: false, HasBottle2: true},
+	0x755: BrewingStand{HasBottle0: true, HasBottle1: false, HasBottle2: true},
+	0x756: BrewingStand{HasBottle0: false, HasBottle1: true, HasBottle2: true},
+	0x757: BrewingStand{HasBottle0: true, HasBottle1: true, HasBottle2: true},
+	0x760: Cauldron{Level: 0},
+	0x761: Cauldron{Level: 1},
+	0x762: Cauldron{Level: 2},
+	0x763: Cauldron{Level: 3},
+	0x770: EndPortal{},
+	0x780: EndPortalFrame{Facing: FacingSouth, Eye: false},
+	0x781: EndPortalFrame{Facing: FacingWest, Eye: false},
+	0x782: EndPortalFrame{Facing: FacingNorth, Eye: false},
+	0x783: EndPortalFrame{Facing: FacingEast, Eye: false},
+	0x784: EndPortalFrame{Facing: FacingSouth, Eye: true},
+	0x785: EndPortalFrame{Facing: FacingWest, Eye: true},
+	0x786: EndPortalFrame{Facing: FacingNorth, Eye: true},
+	0x787: EndPortalFrame{Facing: FacingEast, Eye: true},
+	0x790: EndStone{},
+	0x7a0: DragonEgg{},
+	0x7b0: RedstoneLamp{},
+	0x7c0: LitRedstoneLamp{},
+	0x7d0: DoubleWoodenSlab{Variant: WoodTypeOak},
+	0x7d1: DoubleWoodenSlab{Variant: WoodTypeSpruce},
+	0x7d2: DoubleWoodenSlab{Variant: WoodTypeBirch},
+	0x7d3: DoubleWoodenSlab{Variant: WoodTypeJungle},
+	0x7d4: DoubleWoodenSlab{Variant: WoodTypeAcacia},
+	0x7d5: DoubleWoodenSlab{Variant: WoodTypeDarkOak},
+	0x7e0: WoodenSlab{Variant: WoodTypeOak, Half: HalfBottom},
+	0x7e1: WoodenSlab{Variant: WoodTypeSpruce, Half: HalfBottom},
+	0x7e2: WoodenSlab{Variant: WoodTypeBirch, Half: HalfBottom},
+	0x7e3: WoodenSlab{Variant: WoodTypeJungle, Half: HalfBottom},
+	0x7e4: WoodenSlab{Variant: WoodTypeAcacia, Half: HalfBottom},
+	0x7e5: WoodenSlab{Variant: WoodTypeDarkOak, Half: HalfBottom},
+	0x7e8: WoodenSlab{Variant: WoodTypeOak, Half: HalfTop},
+	0x7e9: WoodenSlab{Variant: WoodTypeSpruce, Half: HalfTop},
+	0x7ea: WoodenSlab{Variant: WoodTypeBirch, Half: HalfTop},
+	0x7eb: WoodenSlab{Variant: WoodTypeJungle, Half: HalfTop},
+	0x7ec: WoodenSlab{Variant: WoodTypeAcacia, Half: HalfTop},
+	0x7ed: WoodenSlab{Variant: WoodTypeDarkOak, Half: HalfTop},
+	0x7f0: Cocoa{Facing: FacingSouth, Age: 0},
+	0x7f1: Cocoa{Facing: FacingWest, Age: 0},
+	0x7f2: Cocoa{Facing: FacingNorth, Age: 0},
+	0x7f3: Cocoa{Facing: FacingEast, Age: 0},
+	0x7f4: Cocoa{Facing: FacingSouth, Age: 1},
+	0x7f5: Cocoa{Facing: FacingWest, Age: 1},
+	0x7f6: Cocoa{Facing: FacingNorth, Age: 1},
+	0x7f7: Cocoa{Facing: FacingEast, Age: 1},
+	0x7f8: Cocoa{Facing: FacingSouth, Age: 2},
+	0x7f9: Cocoa{Facing: FacingWest, Age: 2},
+	0x7fa: Cocoa{Facing: FacingNorth, Age: 2},
+	0x7fb: Cocoa{Facing: FacingEast, Age: 2},
+	0x800: SandstoneStairs{Facing: FacingEast, Half: HalfBottom},
+	0x801: SandstoneStairs{Facing: FacingWest, Half: HalfBottom},
+	0x802: SandstoneStairs{Facing: FacingSouth, Half: HalfBottom},
+	0x803: SandstoneStairs{Facing: FacingNorth, Half: HalfBottom},
+	0x804: SandstoneStairs{Facing: FacingEast, Half: HalfTop},
+	0x805: SandstoneStairs{Facing: FacingWest, Half: HalfTop},
+	0x806: SandstoneStairs{Facing: FacingSouth, Half: HalfTop},
+	0x807: SandstoneStairs{Facing: FacingNorth, Half: HalfTop},
+	0x810: EmeraldOre{},
+	0x822: EnderChest{Facing: FacingNorth},
+	0x823: EnderChest{Facing: FacingSouth},
+	0x824: EnderChest{Facing: FacingWest},
+	0x825: EnderChest{Facing: FacingEast},
+	0x830: TripwireHook{Facing: FacingSouth, Attached: false, Powered: false},
+	0x831: TripwireHook{Facing: FacingWest, Attached: false, Powered: false},
+	0x832: TripwireHook{Facing: FacingNorth, Attached: false, Powered: false},
+	0x833: TripwireHook{Facing: FacingEast, Attached: false, Powered: false},
+	0x834: TripwireHook{Facing: FacingSouth, Attached: true, Powered: false},
+	0x835: TripwireHook{Facing: FacingWest, Attached: true, Powered: false},
+	0x836: TripwireHook{Facing: FacingNorth, Attached: true, Powered: false},
+	0x837: TripwireHook{Facing: FacingEast, Attached: true, Powered: false},
+	0x838: TripwireHook{Facing: FacingSouth, Attached: false, Powered: true},
+	0x839: TripwireHook{Facing: FacingWest, Attached: false, Powered: true},
+	0x83a: TripwireHook{Facing: FacingNorth, Attached: false, Powered: true},
+	0x83b: TripwireHook{Facing: FacingEast, Attached: false, Powered: true},
+	0x83c: TripwireHook{Facing: FacingSouth, Attached: true, Powered: true},
+	0x83d: TripwireHook{Facing: FacingWest, Attached: true, Powered: true},
+	0x83e: TripwireHook{Facing: FacingNorth, Attached: true, Powered: true},
+	0x83f: TripwireHook{Facing: FacingEast, Attached: true, Powered: true},
+	0x840: Tripwire{Powered: false, Attached: false, Disarmed: false},
+	0x841: Tripwire{Powered: true, Attached: false, Disarmed: false},
+	0x844: Tripwire{Powered: false, Attached: true, Disarmed: false},
+	0x845: Tripwire{Powered: true, Attached: true, Disarmed: false},
+	0x848: Tripwire{Powered: false, Attached: false, Disarmed: true},
+	0x849: Tripwire{Powered: true, Attached: false, Disarmed: true},
+	0x84c: Tripwire{Powered: false, Attached: true, Disarmed: true},
+	0x84d: Tripwire{Powered: true, Attached: true, Disarmed: true},
+	0x850: EmeraldBlock{},
+	0x860: SpruceStairs{Facing: FacingEast, Half: HalfBottom},
+	0x861: SpruceStairs{Facing: FacingWest, Half: HalfBottom},
+	0x862: SpruceStairs{Facing: FacingSouth, Half: HalfBottom},
+	0x863: SpruceStairs{Facing: FacingNorth, Half: HalfBottom},
+	0x864: SpruceStairs{Facing: FacingEast, Half: HalfTop},
+	0x865: SpruceStairs{Facing: FacingWest, Half: HalfTop},
+	0x866: SpruceStairs{Facing: FacingSouth, Half: HalfTop},
+	0x867: SpruceStairs{Facing: FacingNorth, Half: HalfTop},
+	0x870: BirchStairs{Facing: FacingEast, Half: HalfBottom},
+	0x871: BirchStairs{Facing: FacingWest, Half: HalfBottom},
+	0x872: BirchStairs{Facing: FacingSouth, Half: HalfBottom},
+	0x873: BirchStairs{Facing: FacingNorth, Half: HalfBottom},
+	0x874: BirchStairs{Facing: FacingEast, Half: HalfTop},
+	0x875: BirchStairs{Facing: FacingWest, Half: HalfTop},
+	0x876: BirchStairs{Facing: FacingSouth, Half: HalfTop},
+	0x877: BirchStairs{Facing: FacingNorth, Half: HalfTop},
+	0x880: JungleStairs{Facing: FacingEast, Half: HalfBottom},
+	0x881: JungleStairs{Facing: FacingWest, Half: HalfBottom},
+	0x882: JungleStairs{Facing: FacingSouth, Half: HalfBottom},
+	0x883: JungleStairs{Facing: FacingNorth, Half: HalfBottom},
+	0x884: JungleStairs{Facing: FacingEast, Half: HalfTop},
+	0x885: JungleStairs{Facing: FacingWest, Half: HalfTop},
+	0x886: JungleStairs{Facing: FacingSouth, Half: HalfTop},
+	0x887: JungleStairs{Facing: FacingNorth, Half: HalfTop},
+	0x890: CommandBlock{Facing: FacingDown, Conditional: false},
+	0x891: CommandBlock{Facing: FacingUp, Conditional: false},
+	0x892: CommandBlock{Facing: FacingNorth, Conditional: false},
+	0x893: CommandBlock{Facing: FacingSouth, Conditional: false},
+	0x894: CommandBlock{Facing: FacingWest, Conditional: false},
+	0x895: CommandBlock{Facing: FacingEast, Conditional: false},
+	0x898: CommandBlock{Facing: FacingDown, Conditional: true},
+	0x899: CommandBlock{Facing: FacingUp, Conditional: true},
+	0x89a: CommandBlock{Facing: FacingNorth, Conditional: true},
+	0x89b: CommandBlock{Facing: FacingSouth, Conditional: true},
+	0x89c: CommandBlock{Facing: FacingWest, Conditional: true},
+	0x89d: CommandBlock{Facing: FacingEast, Conditional: true},
+	0x8a0: Beacon{},
+	0x8b0: CobblestoneWall{Variant: WallVariantCobblestone},
+	0x8b1: CobblestoneWall{Variant: WallVariantMossyCobblestone},
+	0x8c0: FlowerPot{LegacyData: 0},
+	0x8c1: FlowerPot{LegacyData: 1},
+	0x8c2: FlowerPot{LegacyData: 2},
+	0x8c3: FlowerPot{LegacyData: 3},
+	0x8c4: FlowerPot{LegacyData: 4},
+	0x8c5: FlowerPot{LegacyData: 5},
+	0x8c6: FlowerPot{LegacyData: 6},
+	0x8c7: FlowerPot{LegacyData: 7},
+	0x8c8: FlowerPot{LegacyData: 8},
+	0x8c9: FlowerPot{LegacyData: 9},
+	0x8ca: FlowerPot{LegacyData: 10},
+	0x8cb: FlowerPot{LegacyData: 11},
+	0x8cc: FlowerPot{LegacyData: 12},
+	0x8cd: FlowerPot{LegacyData: 13},
+	0x8ce: FlowerPot{LegacyData: 14},
+	0x8cf: FlowerPot{LegacyData: 15},
+	0x8d0: Carrots{Age: 0},
+	0x8d1: Carrots{Age: 1},
+	0x8d2: Carrots{Age: 2},
+	0x8d3: Carrots{Age: 3},
+	0x8d4: Carrots{Age: 4},
+	0x8d5: Carrots{Age: 5},
+	0x8d6: Carrots{Age: 6},
+	0x8d7: Carrots{Age: 7},
+	0x8e0: Potatoes{Age: 0},
+	0x8e1: Potatoes{Age: 1},
+	0x8e2: Potatoes{Age: 2},
+	0x8e3: Potatoes{Age: 3},
+	0x8e4: Potatoes{Age: 4},
+	0x8e5: Potatoes{Age: 5},
+	0x8e6: Potatoes{Age: 6},
+	0x8e7: Potatoes{Age: 7},
+	0x8f0: WoodenButton{Facing: FacingDown, Powered: false},
+	0x8f1: WoodenButton{Facing: FacingEast, Powered: false},
+	0x8f2: WoodenButton{Facing: FacingWest, Powered: false},
+	0x8f3: WoodenButton{Facing: FacingSouth, Powered: false},
+	0x8f4: WoodenButton{Facing: FacingNorth, Powered: false},
+	0x8f5: WoodenButton{Facing: FacingUp, Powered: false},
+	0x8f8: WoodenButton{Facing: FacingDown, Powered: true},
+	0x8f9: WoodenButton{Facing: FacingEast, Powered: true},
+	0x8fa: WoodenButton{Facing: FacingWest, Powered: true},
+	0x8fb: WoodenButton{Facing: FacingSouth, Powered: true},
+	0x8fc: WoodenButton{Facing: FacingNorth, Powered: true},
+	0x8fd: WoodenButton{Facing: FacingUp, Powered: true},
+	0x901: Skull{Facing: FacingUp, Nodrop: false},
+	0x902: Skull{Facing: FacingNorth, Nodrop: false},
+	0x903: Skull{Facing: FacingSouth, Nodrop: false},
+	0x904: Skull{Facing: FacingWest, Nodrop: false},
+	0x905: Skull{Facing: FacingEast, Nodrop: false},
+	0x909: Skull{Facing: FacingUp, Nodrop: true},
+	0x90a: Skull{Facing: FacingNorth, Nodrop: true},
+	0x90b: Skull{Facing: FacingSouth, Nodrop: true},
+	0x90c: Skull{Facing: FacingWest, Nodrop: true},
+	0x90d: Skull{Facing: FacingEast, Nodrop: true},
+	0x910: Anvil{Facing: FacingSouth, Damage: 0},
+	0x911: Anvil{Facing: FacingWest, Damage: 0},
+	0x912: Anvil{Facing: FacingNorth, Damage: 0},
+	0x913: Anvil{Facing: FacingEast, Damage: 0},
+	0x914: Anvil{Facing: FacingSouth, Damage: 1},
+	0x915: Anvil{Facing: FacingWest, Damage: 1},
+	0x916: Anvil{Facing: FacingNorth, Damage: 1},
+	0x917: Anvil{Facing: FacingEast, Damage: 1},
+	0x918: Anvil{Facing: FacingSouth, Damage: 2},
+	0x919: Anvil{Facing: FacingWest, Damage: 2},
+	0x91a: Anvil{Facing: FacingNorth, Damage: 2},
+	0x91b: Anvil{Facing: FacingEast, Damage: 2},
+	0x922: TrappedChest{Facing: FacingNorth},
+	0x923: TrappedChest{Facing: FacingSouth},
+	0x924: TrappedChest{Facing: FacingWest},
+	0x925: TrappedChest{Facing: FacingEast},
+	0x930: LightWeightedPressurePlate{Power: 0},
+	0x931: LightWeightedPressurePlate{Power: 1},
+	0x932: LightWeightedPressurePlate{Power: 2},
+	0x933: LightWeightedPressurePlate{Power: 3},
+	0x934: LightWeightedPressurePlate{Power: 4},
+	0x935: LightWeightedPressurePlate{Power: 5},
+	0x936: LightWeightedPressurePlate{Power: 6},
+	0x937: LightWeightedPressurePlate{Power: 7},
+	0x938: LightWeightedPressurePlate{Power: 8},
+	0x939: LightWeightedPressurePlate{Power: 9},
+	0x93a: LightWeightedPressurePlate{Power: 10},
+	0x93b: LightWeightedPressurePlate{Power: 11},
+	0x93c: LightWeightedPressurePlate{Power: 12},
+	0x93d: LightWeightedPressurePlate{Power: 13},
+	0x93e: LightWeightedPressurePlate{Power: 14},
+	0x93f: LightWeightedPressurePlate{Power: 15},
+	0x940: HeavyWeightedPressurePlate{Power: 0},
+	0x941: HeavyWeightedPressurePlate{Power: 1},
+	0x942: HeavyWeightedPressurePlate{Power: 2},
+	0x943: HeavyWeightedPressurePlate{Power: 3},
+	0x944: HeavyWeightedPressurePlate{Power: 4},
+	0x945: HeavyWeightedPressurePlate{Power: 5},
+	0x946: HeavyWeightedPressurePlate{Power: 6},
+	0x947: HeavyWeightedPressurePlate{Power: 7},
+	0x948: HeavyWeightedPressurePlate{Power: 8},
+	0x949: HeavyWeightedPressurePlate{Power: 9},
+	0x94a: HeavyWeightedPressurePlate{Power: 10},
+	0x94b: HeavyWeightedPressurePlate{Power: 11},
+	0x94c: HeavyWeightedPressurePlate{Power: 12},
+	0x94d: HeavyWeightedPressurePlate{Power: 13},
+	0x94e: HeavyWeightedPressurePlate{Power: 14},
+	0x94f: HeavyWeightedPressurePlate{Power: 15},
+	0x950: UnpoweredComparator{Facing: FacingSouth, Mode: ComparatorModeCompare, Powered: false},
+	0x951: UnpoweredComparator{Facing: FacingWest, Mode: ComparatorModeCompare, Powered: false},
+	0x952: UnpoweredComparator{Facing: FacingNorth, Mode: ComparatorModeCompare, Powered: false},
+	0x953: UnpoweredComparator{Facing: FacingEast, Mode: ComparatorModeCompare, Powered: false},
+	0x954: UnpoweredComparator{Facing: FacingSouth, Mode: ComparatorModeSubtract, Powered: false},
+	0x955: UnpoweredComparator{Facing: FacingWest, Mode: ComparatorModeSubtract, Powered: false},
+	0x956: UnpoweredComparator{Facing: FacingNorth, Mode: ComparatorModeSubtract, Powered: false},
+	0x957: UnpoweredComparator{Facing: FacingEast, Mode: ComparatorModeSubtract, Powered: false},
+	0x958: UnpoweredComparator{Facing: FacingSouth, Mode: ComparatorModeCompare, Powered: true},
+	0x959: UnpoweredComparator{Facing: FacingWest, Mode: ComparatorModeCompare, Powered: true},
+	0x95a: UnpoweredComparator{Facing: FacingNorth, Mode: ComparatorModeCompare, Powered: true},
+	0x95b: UnpoweredComparator{Facing: FacingEast, Mode: ComparatorModeCompare, Powered: true},
+	0x95c: UnpoweredComparator{Facing: FacingSouth, Mode: ComparatorModeSubtract, Powered: true},
+	0x95d: UnpoweredComparator{Facing: FacingWest, Mode: ComparatorModeSubtract, Powered: true},
+	0x95e: UnpoweredComparator{Facing: FacingNorth, Mode: ComparatorModeSubtract, Powered: true},
+	0x95f: UnpoweredComparator{Facing: FacingEast, Mode: ComparatorModeSubtract, Powered: true},
+	0x960: PoweredComparator{Facing: FacingSouth, Mode: ComparatorModeCompare, Powered: false},
+	0x961: PoweredComparator{Facing: FacingWest, Mode: ComparatorModeCompare, Powered: false},
+	0x962: PoweredComparator{Facing: FacingNorth, Mode: ComparatorModeCompare, Powered: false},
+	0x963: PoweredComparator{Facing: FacingEast, Mode: ComparatorModeCompare, Powered: false},
+	0x964: PoweredComparator{Facing: FacingSouth, Mode: ComparatorModeSubtract, Powered: false},
+	0x965: PoweredComparator{Facing: FacingWest, Mode: ComparatorModeSubtract, Powered: false},
+	0x966: PoweredComparator{Facing: FacingNorth, Mode: ComparatorModeSubtract, Powered: false},
+	0x967: PoweredComparator{Facing: FacingEast, Mode: ComparatorModeSubtract, Powered: false},
+	0x968: PoweredComparator{Facing: FacingSouth, Mode: ComparatorModeCompare, Powered: true},
+	0x969: PoweredComparator{Facing: FacingWest, Mode: ComparatorModeCompare, Powered: true},
+	0x96a: PoweredComparator{Facing: FacingNorth, Mode: ComparatorModeCompare, Powered: true},
+	0x96b: PoweredComparator{Facing: FacingEast, Mode: ComparatorModeCompare, Powered: true},
+	0x96c: PoweredComparator{Facing: FacingSouth, Mode: ComparatorModeSubtract, Powered: true},
+	0x96d: PoweredComparator{Facing: FacingWest, Mode: ComparatorModeSubtract, Powered: true},
+	0x96e: PoweredComparator{Facing: FacingNorth, Mode: ComparatorModeSubtract, Powered: true},
+	0x96f: PoweredComparator{Facing: FacingEast, Mode: ComparatorModeSubtract, Powered: true},
+	0x970: DaylightDetector{Power: 0},
+	0x971: DaylightDetector{Power: 1},
+	0x972: DaylightDetector{Power: 2},
+	0x973: DaylightDetector{Power: 3},
+	0x974: DaylightDetector{Power: 4},
+	0x975: DaylightDetector{Power: 5},
+	0x976: DaylightDetector{Power: 6},
+	0x977: DaylightDetector{Power: 7},
+	0x978: DaylightDetector{Power: 8},
+	0x979: DaylightDetector{Power: 9},
+	0x97a: DaylightDetector{Power: 10},
+	0x97b: DaylightDetector{Power: 11},
+	0x97c: DaylightDetector{Power: 12},
+	0x97d: DaylightDetector{Power: 13},
+	0x97e: DaylightDetector{Power: 14},
+	0x97f: DaylightDetector{Power: 15},
+	0x980: RedstoneBlock{},
+	0x990: QuartzOre{},
+	0x9a0: Hopper{Facing: FacingDown, Enabled: true},
+	0x9a2: Hopper{Facing: FacingNorth, Enabled: true},
+	0x9a3: Hopper{Facing: FacingSouth, Enabled: true},
+	0x9a4: Hopper{Facing: FacingWest, Enabled: true},
+	0x9a5: Hopper{Facing: FacingEast, Enabled: true},
+	0x9a8: Hopper{Facing: FacingDown, Enabled: false},
+	0x9aa: Hopper{Facing: FacingNorth, Enabled: false},
+	0x9ab: Hopper{Facing: FacingSouth, Enabled: false},
+	0x9ac: Hopper{Facing: FacingWest, Enabled: false},
+	0x9ad: Hopper{Facing: FacingEast, Enabled: false},
+	0x9b0: QuartzBlock{Variant: QuartzVariantDefault},
+	0x9b1: QuartzBlock{Variant: QuartzVariantChiseled},
+	0x9b2: QuartzBlock{Variant: QuartzVariantLinesY},
+	0x9b3: QuartzBlock{Variant: QuartzVariantLinesX},
+	0x9b4: QuartzBlock{Variant: QuartzVariantLinesZ},
+	0x9c0: QuartzStairs{Facing: FacingEast, Half: HalfBottom},
+	0x9c1: QuartzStairs{Facing: FacingWest, Half: HalfBottom},
+	0x9c2: QuartzStairs{Facing: FacingSouth, Half: HalfBottom},
+	0x9c3: QuartzStairs{Facing: FacingNorth, Half: HalfBottom},
+	0x9c4: QuartzStairs{Facing: FacingEast, Half: HalfTop},
+	0x9c5: QuartzStairs{Facing: FacingWest, Half: HalfTop},
+	0x9c6: QuartzStairs{Facing: FacingSouth, Half: HalfTop},
+	0x9c7: QuartzStairs{Facing: FacingNorth, Half: HalfTop},
+	0x9d0: ActivatorRail{Shape: RailShapeNorthSouth, Powered: false},
+	0x9d1: ActivatorRail{Shape: RailShapeEastWest, Powered: false},
+	0x9d2: ActivatorRail{Shape: RailShapeAscendingEast, Powered: false},
+	0x9d3: ActivatorRail{Shape: RailShapeAscendingWest, Powered: false},
+	0x9d4: ActivatorRail{Shape: RailShapeAscendingNorth, Powered: false},
+	0x9d5: ActivatorRail{Shape: RailShapeAscendingSouth, Powered: false},
+	0x9d8: ActivatorRail{Shape: RailShapeNorthSouth, Powered: true},
+	0x9d9: ActivatorRail{Shape: RailShapeEastWest, Powered: true},
+	0x9da: ActivatorRail{Shape: RailShapeAscendingEast, Powered: true},
+	0x9db: ActivatorRail{Shape: RailShapeAscendingWest, Powered: true},
+	0x9dc: ActivatorRail{Shape: RailShapeAscendingNorth, Powered: true},
+	0x9dd: ActivatorRail{Shape: RailShapeAscendingSouth, Powered: true},
+	0x9e0: Dropper{Facing: FacingDown, Triggered: false},
+	0x9e1: Dropper{Facing: FacingUp, Triggered: false},
+	0x9e2: Dropper{Facing: FacingNorth, Triggered: false},
+	0x9e3: Dropper{Facing: FacingSouth, Triggered: false},
+	0x9e4: Dropper{Facing: FacingWest, Triggered: false},
+	0x9e5: Dropper{Facing: FacingEast, Triggered: false},
+	0x9e8: Dropper{Facing: FacingDown, Triggered: true},
+	0x9e9: Dropper{Facing: FacingUp, Triggered: true},
+	0x9ea: Dropper{Facing: FacingNorth, Triggered: true},
+	0x9eb: Dropper{Facing: FacingSouth, Triggered: true},
+	0x9ec: Dropper{Facing: FacingWest, Triggered: true},
+	0x9ed: Dropper{Facing: FacingEast, Triggered: true},
+	0x9f0: StainedHardenedClay{Color: ColorWhite},
+	0x9f1: StainedHardenedClay{Color: ColorOrange},
+	0x9f2: StainedHardenedClay{Color: ColorMagenta},
+	0x9f3: StainedHardenedClay{Color: ColorLightBlue},
+	0x9f4: StainedHardenedClay{Color: ColorYellow},
+	0x9f5: StainedHardenedClay{Color: ColorLime},
+	0x9f6: StainedHardenedClay{Color: ColorPink},
+	0x9f7: StainedHardenedClay{Color: ColorGray},
+	0x9f8: StainedHardenedClay{Color: ColorSilver},
+	0x9f9: StainedHardenedClay{Color: ColorCyan},
+	0x9fa: StainedHardenedClay{Color: ColorPurple},
+	0x9fb: StainedHardenedClay{Color: ColorBlue},
+	0x9fc: StainedHardenedClay{Color: ColorBrown},
+	0x9fd: StainedHardenedClay{Color: ColorGreen},
+	0x9fe: StainedHardenedClay{Color: ColorRed},
+	0x9ff: StainedHardenedClay{Color: ColorBlack},
+	0xa00: StainedGlassPane{Color: ColorWhite},
+	0xa01: StainedGlassPane{Color: ColorOrange},
+	0xa02: StainedGlassPane{Color: ColorMagenta},
+	0xa03: StainedGlassPane{Color: ColorLightBlue},
+	0xa04: StainedGlassPane{Color: ColorYellow},
+	0xa05: StainedGlassPane{Color: ColorLime},
+	0xa06: StainedGlassPane{Color: ColorPink},
+	0xa07: StainedGlassPane{Color: ColorGray},
+	0xa08: StainedGlassPane{Color: ColorSilver},
+	0xa09: StainedGlassPane{Color: ColorCyan},
+	0xa0a: StainedGlassPane{Color: ColorPurple},
+	0xa0b: StainedGlassPane{Color: ColorBlue},
+	0xa0c: StainedGlassPane{Color: ColorBrown},
+	0xa0d: StainedGlassPane{Color: ColorGreen},
+	0xa0e: StainedGlassPane{Color: ColorRed},
+	0xa0f: StainedGlassPane{Color: ColorBlack},
+	0xa10: Leaves2{Variant: WoodTypeAcacia, Decayable: true, CheckDecay: false},
+	0xa11: Leaves2{Variant: WoodTypeDarkOak, Decayable: true, CheckDecay: false},
+	0xa14: Leaves2{Variant: WoodTypeAcacia, Decayable: false, CheckDecay: false},
+	0xa15: Leaves2{Variant: WoodTypeDarkOak, Decayable: false, CheckDecay: false},
+	0xa18: Leaves2{Variant: WoodTypeAcacia, Decayable: true, CheckDecay: true},
+	0xa19: Leaves2{Variant: WoodTypeDarkOak, Decayable: true, CheckDecay: true},
+	0xa1c: Leaves2{Variant: WoodTypeAcacia, Decayable: false, CheckDecay: true},
+	0xa1d: Leaves2{Variant: WoodTypeDarkOak, Decayable: false, CheckDecay: true},
+	0xa20: Log2{Variant: WoodTypeAcacia, Axis: LogAxisY},
+	0xa21: Log2{Variant: WoodTypeDarkOak, Axis: LogAxisY},
+	0xa24: Log2{Variant: WoodTypeAcacia, Axis: LogAxisX},
+	0xa25: Log2{Variant: WoodTypeDarkOak, Axis: LogAxisX},
+	0xa28: Log2{Variant: WoodTypeAcacia, Axis: LogAxisZ},
+	0xa29: Log2{Variant: WoodTypeDarkOak, Axis: LogAxisZ},
+	0xa2c: Log2{Variant: WoodTypeAcacia, Axis: LogAxisNone},
+	0xa2d: Log2{Variant: WoodTypeDarkOak, Axis: LogAxisNone},
+	0xa30: AcaciaStairs{Facing: FacingEast, Half: HalfBottom},
+	0xa31: AcaciaStairs{Facing: FacingWest, Half: HalfBottom},
+	0xa32: AcaciaStairs{Facing: FacingSouth, Half: HalfBottom},
+	0xa33: AcaciaStairs{Facing: FacingNorth, Half: HalfBottom},
+	0xa34: AcaciaStairs{Facing: FacingEast, Half: HalfTop},
+	0xa35: AcaciaStairs{Facing: FacingWest, Half: HalfTop},
+	0xa36: AcaciaStairs{Facing: FacingSouth, Half: HalfTop},
+	0xa37: AcaciaStairs{Facing: FacingNorth, Half: HalfTop},
+	0xa40: DarkOakStairs{Facing: FacingEast, Half: HalfBottom},
+	0xa41: DarkOakStairs{Facing: FacingWest, Half: HalfBottom},
+	0xa42: DarkOakStairs{Facing: FacingSouth, Half: HalfBottom},
+	0xa43: DarkOakStairs{Facing: FacingNorth, Half: HalfBottom},
+	0xa44: DarkOakStairs{Facing: FacingEast, Half: HalfTop},
+	0xa45: DarkOakStairs{Facing: FacingWest, Half: HalfTop},
+	0xa46: DarkOakStairs{Facing: FacingSouth, Half: HalfTop},
+	0xa47: DarkOakStairs{Facing: FacingNorth, Half: HalfTop},
+	0xa50: Slime{},
+	0xa60: Barrier{},
+	0xa70: IronTrapdoor{Facing: FacingNorth, Open: false, Half: HalfBottom},
+	0xa71: IronTrapdoor{Facing: FacingSouth, Open: false, Half: HalfBottom},
+	0xa72: IronTrapdoor{Facing: FacingWest, Open: false, Half: HalfBottom},
+	0xa73: IronTrapdoor{Facing: FacingEast, Open: false, Half: HalfBottom},
+	0xa74: IronTrapdoor{Facing: FacingNorth, Open: true, Half: HalfBottom},
+	0xa75: IronTrapdoor{Facing: FacingSouth, Open: true, Half: HalfBottom},
+	0xa76: IronTrapdoor{Facing: FacingWest, Open: true, Half: HalfBottom},
+	0xa77: IronTrapdoor{Facing: FacingEast, Open: true, Half: HalfBottom},
+	0xa78: IronTrapdoor{Facing: FacingNorth, Open: false, Half: HalfTop},
+	0xa79: IronTrapdoor{Facing: FacingSouth, Open: false, Half: HalfTop},
+	0xa7a: IronTrapdoor{Facing: FacingWest, Open: false, Half: HalfTop},
+	0xa7b: IronTrapdoor{Facing: FacingEast, Open: false, Half: HalfTop},
+	0xa7c: IronTrapdoor{Facing: FacingNorth, Open: true, Half: HalfTop},
+	0xa7d: IronTrapdoor{Facing: FacingSouth, Open: true, Half: HalfTop},
+	0xa7e: IronTrapdoor{Facing: FacingWest, Open: true, Half: HalfTop},
+	0xa7f: IronTrapdoor{Facing: FacingEast, Open: true, Half: HalfTop},
+	0xa80: Prismarine{Variant: PrismarineVariantPrismarine},
+	0xa81: Prismarine{Variant: PrismarineVariantPrismarineBricks},
+	0xa82: Prismarine{Variant: PrismarineVariantDarkPrismarine},
+	0xa90: SeaLantern{},
+	0xaa0: HayBlock{Axis: AxisY},
+	0xaa4: HayBlock{Axis: AxisX},
+	0xaa8: HayBlock{Axis: AxisZ},
+	0xab0: Carpet{Color: ColorWhite},
+	0xab1: Carpet{Color: ColorOrange},
+	0xab2: Carpet{Color: ColorMagenta},
+	0xab3: Carpet{Color: ColorLightBlue},
+	0xab4: Carpet{Color: ColorYellow},
+	0xab5: Carpet{Color: ColorLime},
+	0xab6: Carpet{Color: ColorPink},
+	0xab7: Carpet{Color: ColorGray},
+	0xab8: Carpet{Color: ColorSilver},
+	0xab9: Carpet{Color: ColorCyan},
+	0xaba: Carpet{Color: ColorPurple},
+	0xabb: Carpet{Color: ColorBlue},
+	0xabc: Carpet{Color: ColorBrown},
+	0xabd: Carpet{Color: ColorGreen},
+	0xabe: Carpet{Color: ColorRed},
+	0xabf: Carpet{Color: ColorBlack},
+	0xac0: HardenedClay{},
+	0xad0: CoalBlock{},
+	0xae0: PackedIce{},
+	0xaf0: DoublePlant{Half: DoubleBlockHalfLower, Variant: DoublePlantVariantSunflower},
+	0xaf1: DoublePlant{Half: DoubleBlockHalfLower, Variant: DoublePlantVariantSyringa},
+	0xaf2: DoublePlant{Half: DoubleBlockHalfLower, Variant: DoublePlantVariantDoubleGrass},
+	0xaf3: DoublePlant{Half: DoubleBlockHalfLower, Variant: DoublePlantVariantDoubleFern},
+	0xaf4: DoublePlant{Half: DoubleBlockHalfLower, Variant: DoublePlantVariantDoubleRose},
+	0xaf5: DoublePlant{Half: DoubleBlockHalfLower, Variant: DoublePlantVariantPaeonia},
+	0xaf8: DoublePlant{Half: DoubleBlockHalfUpper, Facing: FacingSouth},
+	0xaf9: DoublePlant{Half: DoubleBlockHalfUpper, Facing: FacingWest},
+	0xafa: DoublePlant{Half: DoubleBlockHalfUpper, Facing: FacingNorth},
+	0xafb: DoublePlant{Half: DoubleBlockHalfUpper, Facing: FacingEast},
+	0xb00: StandingBanner{Rotation: 0},
+	0xb01: StandingBanner{Rotation: 1},
+	0xb02: StandingBanner{Rotation: 2},
+	0xb03: StandingBanner{Rotation: 3},
+	0xb04: StandingBanner{Rotation: 4},
+	0xb05: StandingBanner{Rotation: 5},
+	0xb06: StandingBanner{Rotation: 6},
+	0xb07: StandingBanner{Rotation: 7},
+	0xb08: StandingBanner{Rotation: 8},
+	0xb09: StandingBanner{Rotation: 9},
+	0xb0a: StandingBanner{Rotation: 10},
+	0xb0b: StandingBanner{Rotation: 11},
+	0xb0c: StandingBanner{Rotation: 12},
+	0xb0d: StandingBanner{Rotation: 13},
+	0xb0e: StandingBanner{Rotation: 14},
+	0xb0f: StandingBanner{Rotation: 15},
+	0xb12: WallBanner{Facing: FacingNorth},
+	0xb13: WallBanner{Facing: FacingSouth},
+	0xb14: WallBanner{Facing: FacingWest},
+	0xb15: WallBanner{Facing: FacingEast},
+	0xb20: DaylightDetectorInverted{Power: 0},
+	0xb21: DaylightDetectorInverted{Power: 1},
+	0xb22: DaylightDetectorInverted{Power: 2},
+	0xb23: DaylightDetectorInverted{Power: 3},
+	0xb24: DaylightDetectorInverted{Power: 4},
+	0xb25: DaylightDetectorInverted{Power: 5},
+	0xb26: DaylightDetectorInverted{Power: 6},
+	0xb27: DaylightDetectorInverted{Power: 7},
+	0xb28: DaylightDetectorInverted{Power: 8},
+	0xb29: DaylightDetectorInverted{Power: 9},
+	0xb2a: DaylightDetectorInverted{Power: 10},
+	0xb2b: DaylightDetectorInverted{Power: 11},
+	0xb2c: DaylightDetectorInverted{Power: 12},
+	0xb2d: DaylightDetectorInverted{Power: 13},
+	0xb2e: DaylightDetectorInverted{Power: 14},
+	0xb2f: DaylightDetectorInverted{Power: 15},
+	0xb30: RedSandstone{Type: RedSandstoneTypeRedSandstone},
+	0xb31: RedSandstone{Type: RedSandstoneTypeChiseledRedSandstone},
+	0xb32: RedSandstone{Type: RedSandstoneTypeSmoothRedSandstone},
+	0xb40: RedSandstoneStairs{Facing: FacingEast, Half: HalfBottom},
+	0xb41: RedSandstoneStairs{Facing: FacingWest, Half: HalfBottom},
+	0xb42: RedSandstoneStairs{Facing: FacingSouth, Half: HalfBottom},
+	0xb43: RedSandstoneStairs{Facing: FacingNorth, Half: HalfBottom},
+	0xb44: RedSandstoneStairs{Facing: FacingEast, Half: HalfTop},
+	0xb45: RedSandstoneStairs{Facing: FacingWest, Half: HalfTop},
+	0xb46: RedSandstoneStairs{Facing: FacingSouth, Half: HalfTop},
+	0xb47: RedSandstoneStairs{Facing: FacingNorth, Half: HalfTop},
+	0xb50: DoubleStoneSlab2{Seamless: false},
+	0xb58: DoubleStoneSlab2{Seamless: true},
+	0xb60: StoneSlab2{Half: HalfBottom},
+	0xb68: StoneSlab2{Half: HalfTop},
+	0xb70: SpruceFenceGate{Facing: FacingSouth, Open: false, Powered: false},
+	0xb71: SpruceFenceGate{Facing: FacingWest, Open: false, Powered: false},
+	0xb72: SpruceFenceGate{Facing: FacingNorth, Open: false, Powered: false},
+	0xb73: SpruceFenceGate{Facing: FacingEast, Open: false, Powered: false},
+	0xb74: SpruceFenceGate{Facing: FacingSouth, Open: true, Powered: false},
+	0xb75: SpruceFenceGate{Facing: FacingWest, Open: true, Powered: false},
+	0xb76: SpruceFenceGate{Facing: FacingNorth, Open: true, Powered: false},
+	0xb77: SpruceFenceGate{Facing: FacingEast, Open: true, Powered: false},
+	0xb78: SpruceFenceGate{Facing: FacingSouth, Open: false, Powered: true},
+	0xb79: SpruceFenceGate{Facing: FacingWest, Open: false, Powered: true},
+	0xb7a: SpruceFenceGate{Facing: FacingNorth, Open: false, Powered: true},
+	0xb7b: SpruceFenceGate{Facing: FacingEast, Open: false, Powered: true},
+	0xb7c: SpruceFenceGate{Facing: FacingSouth, Open: true, Powered: true},
+	0xb7d: SpruceFenceGate{Facing: FacingWest, Open: true, Powered: true},
+	0xb7e: SpruceFenceGate{Facing: FacingNorth, Open: true, Powered: true},
+	0xb7f: SpruceFenceGate{Facing: FacingEast, Open: true, Powered: true},
+	0xb80: BirchFenceGate{Facing: FacingSouth, Open: false, Powered: false},
+	0xb81: BirchFenceGate{Facing: FacingWest, Open: false, Powered: false},
+	0xb82: BirchFenceGate{Facing: FacingNorth, Open: false, Powered: false},
+	0xb83: BirchFenceGate{Facing: FacingEast, Open: false, Powered: false},
+	0xb84: BirchFenceGate{Facing: FacingSouth, Open: true, Powered: false},
+	0xb85: BirchFenceGate{Facing: FacingWest, Open: true, Powered: false},
+	0xb86: BirchFenceGate{Facing: FacingNorth, Open: true, Powered: false},
+	0xb87: BirchFenceGate{Facing: FacingEast, Open: true, Powered: false},
+	0xb88: BirchFenceGate{Facing: FacingSouth, Open: false, Powered: true},
+	0xb89: BirchFenceGate{Facing: FacingWest, Open: false, Powered: true},
+	0xb8a: BirchFenceGate{Facing: FacingNorth, Open: false, Powered: true},
+	0xb8b: BirchFenceGate{Facing: FacingEast, Open: false, Powered: true},
+	0xb8c: BirchFenceGate{Facing: FacingSouth, Open: true, Powered: true},
+	0xb8d: BirchFenceGate{Facing: FacingWest, Open: true, Powered: true},
+	0xb8e: BirchFenceGate{Facing: FacingNorth, Open: true, Powered: true},
+	0xb8f: BirchFenceGate{Facing: FacingEast, Open: true, Powered: true},
+	0xb90: JungleFenceGate{Facing: FacingSouth, Open: false, Powered: false},
+	0xb91: JungleFenceGate{Facing: FacingWest, Open: false, Powered: false},
+	0xb92: JungleFenceGate{Facing: FacingNorth, Open: false, Powered: false},
+	0xb93: JungleFenceGate{Facing: FacingEast, Open: false, Powered: false},
+	0xb94: JungleFenceGate{Facing: FacingSouth, Open: true, Powered: false},
+	0xb95: JungleFenceGate{Facing: FacingWest, Open: true, Powered: false},
+	0xb96: JungleFenceGate{Facing: FacingNorth, Open: true, Powered: false},
+	0xb97: JungleFenceGate{Facing: FacingEast, Open: true, Powered: false},
+	0xb98: JungleFenceGate{Facing: FacingSouth, Open: false, Powered: true},
+	0xb99: JungleFenceGate{Facing: FacingWest, Open: false, Powered: true},
+	0xb9a: JungleFenceGate{Facing: FacingNorth, Open: false, Powered: true},
+	0xb9b: JungleFenceGate{Facing: FacingEast, Open: false, Powered: true},
+	0xb9c: JungleFenceGate{Facing: FacingSouth, Open: true, Powered: true},
+	0xb9d: JungleFenceGate{Facing: FacingWest, Open: true, Powered: true},
+	0xb9e: JungleFenceGate{Facing: FacingNorth, Open: true, Powered: true},
+	0xb9f: JungleFenceGate{Facing: FacingEast, Open: true, Powered: true},
+	0xba0: DarkOakFenceGate{Facing: FacingSouth, Open: false, Powered: false},
+	0xba1: DarkOakFenceGate{Facing: FacingWest, Open: false, Powered: false},
+	0xba2: DarkOakFenceGate{Facing: FacingNorth, Open: false, Powered: false},
+	0xba3: DarkOakFenceGate{Facing: FacingEast, Open: false, Powered: false},
+	0xba4: DarkOakFenceGate{Facing: FacingSouth, Open: true, Powered: false},
+	0xba5: DarkOakFenceGate{Facing: FacingWest, Open: true, Powered: false},
+	0xba6: DarkOakFenceGate{Facing: FacingNorth, Open: true, Powered: false},
+	0xba7: DarkOakFenceGate{Facing: FacingEast, Open: true, Powered: false},
+	0xba8: DarkOakFenceGate{Facing: FacingSouth, Open: false, Powered: true},
+	0xba9: DarkOakFenceGate{Facing: FacingWest, Open: false, Powered: true},
+	0xbaa: DarkOakFenceGate{Facing: FacingNorth, Open: false, Powered: true},
+	0xbab: DarkOakFenceGate{Facing: FacingEast, Open: false, Powered: true},
+	0xbac: DarkOakFenceGate{Facing: FacingSouth, Open: true, Powered: true},
+	0xbad: DarkOakFenceGate{Facing: FacingWest, Open: true, Powered: true},
+	0xbae: DarkOakFenceGate{Facing: FacingNorth, Open: true, Powered: true},
+	0xbaf: DarkOakFenceGate{Facing: FacingEast, Open: true, Powered: true},
+	0xbb0: AcaciaFenceGate{Facing: FacingSouth, Open: false, Powered: false},
+	0xbb1: AcaciaFenceGate{Facing: FacingWest, Open: false, Powered: false},
+	0xbb2: AcaciaFenceGate{Facing: FacingNorth, Open: false, Powered: false},
+	0xbb3: AcaciaFenceGate{Facing: FacingEast, Open: false, Powered: false},
+	0xbb4: AcaciaFenceGate{Facing: FacingSouth, Open: true, Powered: false},
+	0xbb5: AcaciaFenceGate{Facing: FacingWest, Open: true, Powered: false},
+	0xbb6: AcaciaFenceGate{Facing: FacingNorth, Open: true, Powered: false},
+	0xbb7: AcaciaFenceGate{Facing: FacingEast, Open: true, Powered: false},
+	0xbb8: AcaciaFenceGate{Facing: FacingSouth, Open: false, Powered: true},
+	0xbb9: AcaciaFenceGate{Facing: FacingWest, Open: false, Powered: true},
+	0xbba: AcaciaFenceGate{Facing: FacingNorth, Open: false, Powered: true},
+	0xbbb: AcaciaFenceGate{Facing: FacingEast, Open: false, Powered: true},
+	0xbbc: AcaciaFenceGate{Facing: FacingSouth, Open: true, Powered: true},
+	0xbbd: AcaciaFenceGate{Facing: FacingWest, Open: true, Powered: true},
+	0xbbe: AcaciaFenceGate{Facing: FacingNorth, Open: true, Powered: true},
+	0xbbf: AcaciaFenceGate{Facing: FacingEast, Open: true, Powered: true},
+	0xbc0: SpruceFence{},
+	0xbd0: BirchFence{},
+	0xbe0: JungleFence{},
+	0xbf0: DarkOakFence{},
+	0xc00: AcaciaFence{},
+	0xc10: SpruceDoor{Half: DoubleBlockHalfLower, Facing: FacingEast, Open: false},
+	0xc11: SpruceDoor{Half: DoubleBlockHalfLower, Facing: FacingSouth, Open: false},
+	0xc12: SpruceDoor{Half: DoubleBlockHalfLower, Facing: FacingWest, Open: false},
+	0xc13: SpruceDoor{Half: DoubleBlockHalfLower, Facing: FacingNorth, Open: false},
+	0xc14: SpruceDoor{Half: DoubleBlockHalfLower, Facing: FacingEast, Open: true},
+	0xc15: SpruceDoor{Half: DoubleBlockHalfLower, Facing: FacingSouth, Open: true},
+	0xc16: SpruceDoor{Half: DoubleBlockHalfLower, Facing: FacingWest, Open: true},
+	0xc17: SpruceDoor{Half: DoubleBlockHalfLower, Facing: FacingNorth, Open: true},
+	0xc18: SpruceDoor{Half: DoubleBlockHalfUpper, Hinge: HingeLeft, Powered: false},
+	0xc19: SpruceDoor{Half: DoubleBlockHalfUpper, Hinge: HingeRight, Powered: false},
+	0xc1a: SpruceDoor{Half: DoubleBlockHalfUpper, Hinge: HingeLeft, Powered: true},
+	0xc1b: SpruceDoor{Half: DoubleBlockHalfUpper, Hinge: HingeRight, Powered: true},
+	0xc20: BirchDoor{Half: DoubleBlockHalfLower, Facing: FacingEast, Open: false},
+	0xc21: BirchDoor{Half: DoubleBlockHalfLower, Facing: FacingSouth, Open: false},
+	0xc22: BirchDoor{Half: DoubleBlockHalfLower, Facing: FacingWest, Open: false},
+	0xc23: BirchDoor{Half: DoubleBlockHalfLower, Facing: FacingNorth, Open: false},
+	0xc24: BirchDoor{Half: DoubleBlockHalfLower, Facing: FacingEast, Open: true},
+	0xc25: BirchDoor{Half: DoubleBlockHalfLower, Facing: FacingSouth, Open: true},
+	0xc26: BirchDoor{Half: DoubleBlockHalfLower, Facing: FacingWest, Open: true},
+	0xc27: BirchDoor{Half: DoubleBlockHalfLower, Facing: FacingNorth, Open: true},
+	0xc28: BirchDoor{Half: DoubleBlockHalfUpper, Hinge: HingeLeft, Powered: false},
+	0xc29: BirchDoor{Half: DoubleBlockHalfUpper, Hinge: HingeRight, Powered: false},
+	0xc2a: BirchDoor{Half: DoubleBlockHalfUpper, Hinge: HingeLeft, Powered: true},
+	0xc2b: BirchDoor{Half: DoubleBlockHalfUpper, Hinge: HingeRight, Powered: true},
+	0xc30: JungleDoor{Half: DoubleBlockHalfLower, Facing: FacingEast, Open: false},
+	0xc31: JungleDoor{Half: DoubleBlockHalfLower, Facing: FacingSouth, Open: false},
+	0xc32: JungleDoor{Half: DoubleBlockHalfLower, Facing: FacingWest, Open: false},
+	0xc33: JungleDoor{Half: DoubleBlockHalfLower, Facing: FacingNorth, Open: false},
+	0xc34: JungleDoor{Half: DoubleBlockHalfLower, Facing: FacingEast, Open: true},
+	0xc35: JungleDoor{Half: DoubleBlockHalfLower, Facing: FacingSouth, Open: true},
+	0xc36: JungleDoor{Half: DoubleBlockHalfLower, Facing: FacingWest, Open: true},
+	0xc37: JungleDoor{Half: DoubleBlockHalfLower, Facing: FacingNorth, Open: true},
+	0xc38: JungleDoor{Half: DoubleBlockHalfUpper, Hinge: HingeLeft, Powered: false},
+	0xc39: JungleDoor{Half: DoubleBlockHalfUpper, Hinge: HingeRight, Powered: false},
+	0xc3a: JungleDoor{Half: DoubleBlockHalfUpper, Hinge: HingeLeft, Powered: true},
+	0xc3b: JungleDoor{Half: DoubleBlockHalfUpper, Hinge: HingeRight, Powered: true},
+	0xc40: AcaciaDoor{Half: DoubleBlockHalfLower, Facing: FacingEast, Open: false},
+	0xc41: AcaciaDoor{Half: DoubleBlockHalfLower, Facing: FacingSouth, Open: false},
+	0xc42: AcaciaDoor{Half: DoubleBlockHalfLower, Facing: FacingWest, Open: false},
+	0xc43: AcaciaDoor{Half: DoubleBlockHalfLower, Facing: FacingNorth, Open: false},
+	0xc44: AcaciaDoor{Half: DoubleBlockHalfLower, Facing: FacingEast, Open: true},
+	0xc45: AcaciaDoor{Half: DoubleBlockHalfLower, Facing: FacingSouth, Open: true},
+	0xc46: AcaciaDoor{Half: DoubleBlockHalfLower, Facing: FacingWest, Open: true},
+	0xc47: AcaciaDoor{Half: DoubleBlockHalfLower, Facing: FacingNorth, Open: true},
+	0xc48: AcaciaDoor{Half: DoubleBlockHalfUpper, Hinge: HingeLeft, Powered: false},
+	0xc49: AcaciaDoor{Half: DoubleBlockHalfUpper, Hinge: HingeRight, Powered: false},
+	0xc4a: AcaciaDoor{Half: DoubleBlockHalfUpper, Hinge: HingeLeft, Powered: true},
+	0xc4b: AcaciaDoor{Half: DoubleBlockHalfUpper, Hinge: HingeRight, Powered: true},
+	0xc50: DarkOakDoor{Half: DoubleBlockHalfLower, Facing: FacingEast, Open: false},
+	0xc51: DarkOakDoor{Half: DoubleBlockHalfLower, Facing: FacingSouth, Open: false},
+	0xc52: DarkOakDoor{Half: DoubleBlockHalfLower, Facing: FacingWest, Open: false},
+	0xc53: DarkOakDoor{Half: DoubleBlockHalfLower, Facing: FacingNorth, Open: false},
+	0xc54: DarkOakDoor{Half: DoubleBlockHalfLower, Facing: FacingEast, Open: true},
+	0xc55: DarkOakDoor{Half: DoubleBlockHalfLower, Facing: FacingSouth, Open: true},
+	0xc56: DarkOakDoor{Half: DoubleBlockHalfLower, Facing: FacingWest, Open: true},
+	0xc57: DarkOakDoor{Half: DoubleBlockHalfLower, Facing: FacingNorth, Open: true},
+	0xc58: DarkOakDoor{Half: DoubleBlockHalfUpper, Hinge: HingeLeft, Powered: false},
+	0xc59: DarkOakDoor{Half: DoubleBlockHalfUpper, Hinge: HingeRight, Powered: false},
+	0xc5a: DarkOakDoor{Half: DoubleBlockHalfUpper, Hinge: HingeLeft, Powered: true},
+	0xc5b: DarkOakDoor{Half: DoubleBlockHalfUpper, Hinge: HingeRight, Powered: true},
+	0xc60: EndRod{Facing: FacingDown},
+	0xc61: EndRod{Facing: FacingUp},
+	0xc62: EndRod{Facing: FacingNorth},
+	0xc63: EndRod{Facing: FacingSouth},
+	0xc64: EndRod{Facing: FacingWest},
+	0xc65: EndRod{Facing: FacingEast},
+	0xc70: ChorusPlant{},
+	0xc80: ChorusFlower{Age: 0},
+	0xc81: ChorusFlower{Age: 1},
+	0xc82: ChorusFlower{Age: 2},
+	0xc83: ChorusFlower{Age: 3},
+	0xc84: ChorusFlower{Age: 4},
+	0xc85: ChorusFlower{Age: 5},
+	0xc90: PurpurBlock{},
+	0xca0: PurpurPillar{Axis: AxisY},
+	0xca4: PurpurPillar{Axis: AxisX},
+	0xca8: PurpurPillar{Axis: AxisZ},
+	0xcb0: PurpurStairs{Facing: FacingEast, Half: HalfBottom},
+	0xcb1: PurpurStairs{Facing: FacingWest, Half: HalfBottom},
+	0xcb2: PurpurStairs{Facing: FacingSouth, Half: HalfBottom},
+	0xcb3: PurpurStairs{Facing: FacingNorth, Half: HalfBottom},
+	0xcb4: PurpurStairs{Facing: FacingEast, Half: HalfTop},
+	0xcb5: PurpurStairs{Facing: FacingWest, Half: HalfTop},
+	0xcb6: PurpurStairs{Facing: FacingSouth, Half: HalfTop},
+	0xcb7: PurpurStairs{Facing: FacingNorth, Half: HalfTop},
+	0xcc0: PurpurDoubleSlab{},
+	0xcd0: PurpurSlab{Half: HalfBottom},
+	0xcd8: PurpurSlab{Half: HalfTop},
+	0xce0: EndBricks{},
+	0xcf0: Beetroots{Age: 0},
+	0xcf1: Beetroots{Age: 1},
+	0xcf2: Beetroots{Age: 2},
+	0xcf3: Beetroots{Age: 3},
+	0xd00: GrassPath{},
+	0xd10: EndGateway{},
+	0xd20: RepeatingCommandBlock{Facing: FacingDown, Conditional: false},
+	0xd21: RepeatingCommandBlock{Facing: FacingUp, Conditional: false},
+	0xd22: RepeatingCommandBlock{Facing: FacingNorth, Conditional: false},
+	0xd23: RepeatingCommandBlock{Facing: FacingSouth, Conditional: false},
+	0xd24: RepeatingCommandBlock{Facing: FacingWest, Conditional: false},
+	0xd25: RepeatingCommandBlock{Facing: FacingEast, Conditional: false},
+	0xd28: RepeatingCommandBlock{Facing: FacingDown, Conditional: true},
+	0xd29: RepeatingCommandBlock{Facing: FacingUp, Conditional: true},
+	0xd2a: RepeatingCommandBlock{Facing: FacingNorth, Conditional: true},
+	0xd2b: RepeatingCommandBlock{Facing: FacingSouth, Conditional: true},
+	0xd2c: RepeatingCommandBlock{Facing: FacingWest, Conditional: true},
+	0xd2d: RepeatingCommandBlock{Facing: FacingEast, Conditional: true},
+	0xd30: ChainCommandBlock{Facing: FacingDown, Conditional: false},
+	0xd31: ChainCommandBlock{Facing: FacingUp, Conditional: false},
+	0xd32: ChainCommandBlock{Facing: FacingNorth, Conditional: false},
+	0xd33: ChainCommandBlock{Facing: FacingSouth, Conditional: false},
+	0xd34: ChainCommandBlock{Facing: FacingWest, Conditional: false},
+	0xd35: ChainCommandBlock{Facing: FacingEast, Conditional: false},
+	0xd38: ChainCommandBlock{Facing: FacingDown, Conditional: true},
+	0xd39: ChainCommandBlock{Facing: FacingUp, Conditional: true},
+	0xd3a: ChainCommandBlock{Facing: FacingNorth, Conditional: true},
+	0xd3b: ChainCommandBlock{Facing: FacingSouth, Conditional: true},
+	0xd3c: ChainCommandBlock{Facing: FacingWest, Conditional: true},
+	0xd3d: ChainCommandBlock{Facing: FacingEast, Conditional: true},
+	0xd40: FrostedIce{Age: 0},
+	0xd41: FrostedIce{Age: 1},
+	0xd42: FrostedIce{Age: 2},
+	0xd43: FrostedIce{Age: 3},
+	0xd50: Magma{},
+	0xd60: NetherWartBlock{},
+	0xd70: RedNetherBrick{},
+	0xd80: BoneBlock{Axis: AxisY},
+	0xd84: BoneBlock{Axis: AxisX},
+	0xd88: BoneBlock{Axis: AxisZ},
+	0xd90: StructureVoid{},
+	0xda0: Observer{Facing: FacingDown, Powered: false},
+	0xda1: Observer{Facing: FacingUp, Powered: false},
+	0xda2: Observer{Facing: FacingNorth, Powered: false},
+	0xda3: Observer{Facing: FacingSouth, Powered: false},
+	0xda4: Observer{Facing: FacingWest, Powered: false},
+	0xda5: Observer{Facing: FacingEast, Powered: false},
+	0xda8: Observer{Facing: FacingDown, Powered: true},
+	0xda9: Observer{Facing: FacingUp, Powered: true},
+	0xdaa: Observer{Facing: FacingNorth, Powered: true},
+	0xdab: Observer{Facing: FacingSouth, Powered: true},
+	0xdac: Observer{Facing: FacingWest, Powered: true},
+	0xdad: Observer{Facing: FacingEast, Powered: true},
+	0xdb0: WhiteShulkerBox{Facing: FacingDown},
+	0xdb1: WhiteShulkerBox{Facing: FacingUp},
+	0xdb2: WhiteShulkerBox{Facing: FacingNorth},
+	0xdb3: WhiteShulkerBox{Facing: FacingSouth},
+	0xdb4: WhiteShulkerBox{Facing: FacingWest},
+	0xdb5: WhiteShulkerBox{Facing: FacingEast},
+	0xdc0: OrangeShulkerBox{Facing: FacingDown},
+	0xdc1: OrangeShulkerBox{Facing: FacingUp},
+	0xdc2: OrangeShulkerBox{Facing: FacingNorth},
+	0xdc3: OrangeShulkerBox{Facing: FacingSouth},
+	0xdc4: OrangeShulkerBox{Facing: FacingWest},
+	0xdc5: OrangeShulkerBox{Facing: FacingEast},
+	0xdd0: MagentaShulkerBox{Facing: FacingDown},
+	0xdd1: MagentaShulkerBox{Facing: FacingUp},
+	0xdd2: MagentaShulkerBox{Facing: FacingNorth},
+	0xdd3: MagentaShulkerBox{Facing: FacingSouth},
+	0xdd4: MagentaShulkerBox{Facing: FacingWest},
+	0xdd5: MagentaShulkerBox{Facing: FacingEast},
+	0xde0: LightBlueShulkerBox{Facing: FacingDown},
+	0xde1: LightBlueShulkerBox{Facing: FacingUp},
+	0xde2: LightBlueShulkerBox{Facing: FacingNorth},
+	0xde3: LightBlueShulkerBox{Facing: FacingSouth},
+	0xde4: LightBlueShulkerBox{Facing: FacingWest},
+	0xde5: LightBlueShulkerBox{Facing: FacingEast},
+	0xdf0: YellowShulkerBox{Facing: FacingDown},
+	0xdf1: YellowShulkerBox{Facing: FacingUp},
+	0xdf2: YellowShulkerBox{Facing: FacingNorth},
+	0xdf3: YellowShulkerBox{Facing: FacingSouth},
+	0xdf4: YellowShulkerBox{Facing: FacingWest},
+	0xdf5: YellowShulkerBox{Facing: FacingEast},
+	0xe00: LimeShulkerBox{Facing: FacingDown},
+	0xe01: LimeShulkerBox{Facing: FacingUp},
+	0xe02: LimeShulkerBox{Facing: FacingNorth},
+	0xe03: LimeShulkerBox{Facing: FacingSouth},
+	0xe04: LimeShulkerBox{Facing: FacingWest},
+	0xe05: LimeShulkerBox{Facing: FacingEast},
+	0xe10: PinkShulkerBox{Facing: FacingDown},
+	0xe11: PinkShulkerBox{Facing: FacingUp},
+	0xe12: PinkShulkerBox{Facing: FacingNorth},
+	0xe13: PinkShulkerBox{Facing: FacingSouth},
+	0xe14: PinkShulkerBox{Facing: FacingWest},
+	0xe15: PinkShulkerBox{Facing: FacingEast},
+	0xe20: GrayShulkerBox{Facing: FacingDown},
+	0xe21: GrayShulkerBox{Facing: FacingUp},
+	0xe22: GrayShulkerBox{Facing: FacingNorth},
+	0xe23: GrayShulkerBox{Facing: FacingSouth},
+	0xe24: GrayShulkerBox{Facing: FacingWest},
+	0xe25: GrayShulkerBox{Facing: FacingEast},
+	0xe30: SilverShulkerBox{Facing: FacingDown},
+	0xe31: SilverShulkerBox{Facing: FacingUp},
+	0xe32: SilverShulkerBox{Facing: FacingNorth},
+	0xe33: SilverShulkerBox{Facing: FacingSouth},
+	0xe34: SilverShulkerBox{Facing: FacingWest},
+	0xe35: SilverShulkerBox{Facing: FacingEast},
+	0xe40: CyanShulkerBox{Facing: FacingDown},
+	0xe41: CyanShulkerBox{Facing: FacingUp},
+	0xe42: CyanShulkerBox{Facing: FacingNorth},
+	0xe43: CyanShulkerBox{Facing: FacingSouth},
+	0xe44: CyanShulkerBox{Facing: FacingWest},
+	0xe45: CyanShulkerBox{Facing: FacingEast},
+	0xe50: PurpleShulkerBox{Facing: FacingDown},
+	0xe51: PurpleShulkerBox{Facing: FacingUp},
+	0xe52: PurpleShulkerBox{Facing: FacingNorth},
+	0xe53: PurpleShulkerBox{Facing: FacingSouth},
+	0xe54: PurpleShulkerBox{Facing: FacingWest},
+	0xe55: PurpleShulkerBox{Facing: FacingEast},
+	0xe60: BlueShulkerBox{Facing: FacingDown},
+	0xe61: BlueShulkerBox{Facing: FacingUp},
+	0xe62: BlueShulkerBox{Facing: FacingNorth},
+	0xe63: BlueShulkerBox{Facing: FacingSouth},
+	0xe64: BlueShulkerBox{Facing: FacingWest},
+	0xe65: BlueShulkerBox{Facing: FacingEast},
+	0xe70: BrownShulkerBox{Facing: FacingDown},
+	0xe71: BrownShulkerBox{Facing: FacingUp},
+	0xe72: BrownShulkerBox{Facing: FacingNorth},
+	0xe73: BrownShulkerBox{Facing: FacingSouth},
+	0xe74: BrownShulkerBox{Facing: FacingWest},
+	0xe75: BrownShulkerBox{Facing: FacingEast},
+	0xe80: GreenShulkerBox{Facing: FacingDown},
+	0xe81: GreenShulkerBox{Facing: FacingUp},
+	0xe82: GreenShulkerBox{Facing: FacingNorth},
+	0xe83: GreenShulkerBox{Facing: FacingSouth},
+	0xe84: GreenShulkerBox{Facing: FacingWest},
+	0xe85: GreenShulkerBox{Facing: FacingEast},
+	0xe90: RedShulkerBox{Facing: FacingDown},
+	0xe91: RedShulkerBox{Facing: FacingUp},
+	0xe92: RedShulkerBox{Facing: FacingNorth},
+	0xe93: RedShulkerBox{Facing: FacingSouth},
+	0xe94: RedShulkerBox{Facing: FacingWest},
+	0xe95: RedShulkerBox{Facing: FacingEast},
+	0xea0: BlackShulkerBox{Facing: FacingDown},
+	0xea1: BlackShulkerBox{Facing: FacingUp},
+	0xea2: BlackShulkerBox{Facing: FacingNorth},
+	0xea3: BlackShulkerBox{Facing: FacingSouth},
+	0xea4: BlackShulkerBox{Facing: FacingWest},
+	0xea5: BlackShulkerBox{Facing: FacingEast},
+	0xeb0: WhiteGlazedTerracotta{Facing: FacingSouth},
+	0xeb1: WhiteGlazedTerracotta{Facing: FacingWest},
+	0xeb2: WhiteGlazedTerracotta{Facing: FacingNorth},
+	0xeb3: WhiteGlazedTerracotta{Facing: FacingEast},
+	0xec0: OrangeGlazedTerracotta{Facing: FacingSouth},
+	0xec1: OrangeGlazedTerracotta{Facing: FacingWest},
+	0xec2: OrangeGlazedTerracotta{Facing: FacingNorth},
+	0xec3: OrangeGlazedTerracotta{Facing: FacingEast},
+	0xed0: MagentaGlazedTerracotta{Facing: FacingSouth},
+	0xed1: MagentaGlazedTerracotta{Facing: FacingWest},
+	0xed2: MagentaGlazedTerracotta{Facing: FacingNorth},
+	0xed3: MagentaGlazedTerracotta{Facing: FacingEast},
+	0xee0: LightBlueGlazedTerracotta{Facing: FacingSouth},
+	0xee1: LightBlueGlazedTerracotta{Facing: FacingWest},
+	0xee2: LightBlueGlazedTerracotta{Facing: FacingNorth},
+	0xee3: LightBlueGlazedTerracotta{Facing: FacingEast},
+	0xef0: YellowGlazedTerracotta{Facing: FacingSouth},
+	0xef1: YellowGlazedTerracotta{Facing: FacingWest},
+	0xef2: YellowGlazedTerracotta{Facing: FacingNorth},
+	0xef3: YellowGlazedTerracotta{Facing: FacingEast},
+	0xf00: LimeGlazedTerracotta{Facing: FacingSouth},
+	0xf01: LimeGlazedTerracotta{Facing: FacingWest},
+	0xf02: LimeGlazedTerracotta{Facing: FacingNorth},
+	0xf03: LimeGlazedTerracotta{Facing: FacingEast},
+	0xf10: PinkGlazedTerracotta{Facing: FacingSouth},
+	0xf11: PinkGlazedTerracotta{Facing: FacingWest},
+	0xf12: PinkGlazedTerracotta{Facing: FacingNorth},
+	0xf13: PinkGlazedTerracotta{Facing: FacingEast},
+	0xf20: GrayGlazedTerracotta{Facing: FacingSouth},
+	0xf21: GrayGlazedTerracotta{Facing: FacingWest},
+	0xf22: GrayGlazedTerracotta{Facing: FacingNorth},
+	0xf23: GrayGlazedTerracotta{Facing: FacingEast},
+	0xf30: SilverGlazedTerracotta{Facing: FacingSouth},
+	0xf31: SilverGlazedTerracotta{Facing: FacingWest},
+	0xf32: SilverGlazedTerracotta{Facing: FacingNorth},
+	0xf33: SilverGlazedTerracotta{Facing: FacingEast},
+	0xf40: CyanGlazedTerracotta{Facing: FacingSouth},
+	0xf41: CyanGlazedTerracotta{Facing: FacingWest},
+	0xf42: CyanGlazedTerracotta{Facing: FacingNorth},
+	0xf43: CyanGlazedTerracotta{Facing: FacingEast},
+	0xf50: PurpleGlazedTerracotta{Facing: FacingSouth},
+	0xf51: PurpleGlazedTerracotta{Facing: FacingWest},
+	0xf52: PurpleGlazedTerracotta{Facing: FacingNorth},
+	0xf53: PurpleGlazedTerracotta{Facing: FacingEast},
+	0xf60: BlueGlazedTerracotta{Facing: FacingSouth},
+	0xf61: BlueGlazedTerracotta{Facing: FacingWest},
+	0xf62: BlueGlazedTerracotta{Facing: FacingNorth},
+	0xf63: BlueGlazedTerracotta{Facing: FacingEast},
+	0xf70: BrownGlazedTerracotta{Facing: FacingSouth},
+	0xf71: BrownGlazedTerracotta{Facing: FacingWest},
+	0xf72: BrownGlazedTerracotta{Facing: FacingNorth},
+	0xf73: BrownGlazedTerracotta{Facing: FacingEast},
+	0xf80: GreenGlazedTerracotta{Facing: FacingSouth},
+	0xf81: GreenGlazedTerracotta{Facing: FacingWest},
+	0xf82: GreenGlazedTerracotta{Facing: FacingNorth},
+	0xf83: GreenGlazedTerracotta{Facing: FacingEast},
+	0xf90: RedGlazedTerracotta{Facing: FacingSouth},
+	0xf91: RedGlazedTerracotta{Facing: FacingWest},
+	0xf92: RedGlazedTerracotta{Facing: FacingNorth},
+	0xf93: RedGlazedTerracotta{Facing: FacingEast},
+	0xfa0: BlackGlazedTerracotta{Facing: FacingSouth},
+	0xfa1: BlackGlazedTerracotta{Facing: FacingWest},
+	0xfa2: BlackGlazedTerracotta{Facing: FacingNorth},
+	0xfa3: BlackGlazedTerracotta{Facing: FacingEast},
+	0xfb0: Concrete{Color: ColorWhite},
+	0xfb1: Concrete{Color: ColorOrange},
+	0xfb2: Concrete{Color: ColorMagenta},
+	0xfb3: Concrete{Color: ColorLightBlue},
+	0xfb4: Concrete{Color: ColorYellow},
+	0xfb5: Concrete{Color: ColorLime},
+	0xfb6: Concrete{Color: ColorPink},
+	0xfb7: Concrete{Color: ColorGray},
+	0xfb8: Concrete{Color: ColorSilver},
+	0xfb9: Concrete{Color: ColorCyan},
+	0xfba: Concrete{Color: ColorPurple},
+	0xfbb: Concrete{Color: ColorBlue},
+	0xfbc: Concrete{Color: ColorBrown},
+	0xfbd: Concrete{Color: ColorGreen},
+	0xfbe: Concrete{Color: ColorRed},
+	0xfbf: Concrete{Color: ColorBlack},
+	0xfc0: ConcretePowder{Color: ColorWhite},
+	0xfc1: ConcretePowder{Color: ColorOrange},
+	0xfc2: ConcretePowder{Color: ColorMagenta},
+	0xfc3: ConcretePowder{Color: ColorLightBlue},
+	0xfc4: ConcretePowder{Color: ColorYellow},
+	0xfc5: ConcretePowder{Color: ColorLime},
+	0xfc6: ConcretePowder{Color: ColorPink},
+	0xfc7: ConcretePowder{Color: ColorGray},
+	0xfc8: ConcretePowder{Color: ColorSilver},
+	0xfc9: ConcretePowder{Color: ColorCyan},
+	0xfca: ConcretePowder{Color: ColorPurple},
+	0xfcb: ConcretePowder{Color: ColorBlue},
+	0xfcc: ConcretePowder{Color: ColorBrown},
+	0xfcd: ConcretePowder{Color: ColorGreen},
+	0xfce: ConcretePowder{Color: ColorRed},
+	0xfcf: ConcretePowder{Color: ColorBlack},
+	0xff0: StructureBlock{Mode: StructureModeSave},
+	0xff1: StructureBlock{Mode: StructureModeLoad},
+	0xff2: StructureBlock{Mode: StructureModeCorner},
+	0xff3: StructureBlock{Mode: StructureModeData},
+}
+
+// FromID contains the state with the lowest metadata of each block
+var FromID = map[string]Block{
+	"minecraft:air":                           StateList[0x000],
+	"minecraft:stone":                         StateList[0x010],
+	"minecraft:grass":                         StateList[0x020],
+	"minecraft:dirt":                          StateList[0x030],
+	"minecraft:cobblestone":                   StateList[0x040],
+	"minecraft:planks":                        StateList[0x050],
+	"minecraft:sapling":                       StateList[0x060],
+	"minecraft:bedrock":                       StateList[0x070],
+	"minecraft:flowing_water":                 StateList[0x080],
+	"minecraft:water":                         StateList[0x090],
+	"minecraft:flowing_lava":                  StateList[0x0a0],
+	"minecraft:lava":                          StateList[0x0b0],
+	"minecraft:sand":                          StateList[0x0c0],
+	"minecraft:gravel":                        StateList[0x0d0],
+	"minecraft:gold_ore":                      StateList[0x0e0],
+	"minecraft:iron_ore":                      StateList[0x0f0],
+	"minecraft:coal_ore":                      StateList[0x100],
+	"minecraft:log":                           StateList[0x110],
+	"minecraft:leaves":                        StateList[0x120],
+	"minecraft:sponge":                        StateList[0x130],
+	"minecraft:glass":                         StateList[0x140],
+	"minecraft:lapis_ore":                     StateList[0x150],
+	"minecraft:lapis_block":                   StateList[0x160],
+	"minecraft:dispenser":                     StateList[0x170],
+	"minecraft:sandstone":                     StateList[0x180],
+	"minecraft:noteblock":                     StateList[0x190],
+	"minecraft:bed":                           StateList[0x1a0],
+	"minecraft:golden_rail":                   StateList[0x1b0],
+	"minecraft:detector_rail":                 StateList[0x1c0],
+	"minecraft:sticky_piston":                 StateList[0x1d0],
+	"minecraft:web":                           StateList[0x1e0],
+	"minecraft:tallgrass":                     StateList[0x1f0],
+	"minecraft:deadbush":                      StateList[0x200],
+	"minecraft:piston":                        StateList[0x210],
+	"minecraft:piston_head":                   StateList[0x220],
+	"minecraft:wool":                          StateList[0x230],
+	"minecraft:piston_extension":              StateList[0x240],
+	"minecraft:yellow_flower":                 StateList[0x250],
+	"minecraft:red_flower":                    StateList[0x260],
+	"minecraft:brown_mushroom":                StateList[0x270],
+	"minecraft:red_mushroom":                  StateList[0x280],
+	"minecraft:gold_block":                    StateList[0x290],
+	"minecraft:iron_block":                    StateList[0x2a0],
+	"minecraft:double_stone_slab":             StateList[0x2b0],
+	"minecraft:stone_slab":                    StateList[0x2c0],
+	"minecraft:brick_block":                   StateList[0x2d0],
+	"minecraft:tnt":                           StateList[0x2e0],
+	"minecraft:bookshelf":                     StateList[0x2f0],
+	"minecraft:mossy_cobblestone":             StateList[0x300],
+	"minecraft:obsidian":                      StateList[0x310],
+	"minecraft:torch":                         StateList[0x321],
+	"minecraft:fire":                          StateList[0x330],
+	"minecraft:mob_spawner":                   StateList[0x340],
+	"minecraft:oak_stairs":                    StateList[0x350],
+	"minecraft:chest":                         StateList[0x362],
+	"minecraft:redstone_wire":                 StateList[0x370],
+	"minecraft:diamond_ore":                   StateList[0x380],
+	"minecraft:diamond_block":                 StateList[0x390],
+	"minecraft:crafting_table":                StateList[0x3a0],
+	"minecraft:wheat":                         StateList[0x3b0],
+	"minecraft:farmland":                      StateList[0x3c0],
+	"minecraft:furnace":                       StateList[0x3d2],
+	"minecraft:lit_furnace":                   StateList[0x3e2],
+	"minecraft:standing_sign":                 StateList[0x3f0],
+	"minecraft:wooden_door":                   StateList[0x400],
+	"minecraft:ladder":                        StateList[0x412],
+	"minecraft:rail":                          StateList[0x420],
+	"minecraft:stone_stairs":                  StateList[0x430],
+	"minecraft:wall_sign":                     StateList[0x442],
+	"minecraft:lever":                         StateList[0x450],
+	"minecraft:stone_pressure_plate":          StateList[0x460],
+	"minecraft:iron_door":                     StateList[0x470],
+	"minecraft:wooden_pressure_plate":         StateList[0x480],
+	"minecraft:redstone_ore":                  StateList[0x490],
+	"minecraft:lit_redstone_ore":              StateList[0x4a0],
+	"minecraft:unlit_redstone_torch":          StateList[0x4b1],
+	"minecraft:redstone_torch":                StateList[0x4c1],
+	"minecraft:stone_button":                  StateList[0x4d0],
+	"minecraft:snow_layer":                    StateList[0x4e0],
+	"minecraft:ice":                           StateList[0x4f0],
+	"minecraft:snow":                          StateList[0x500],
+	"minecraft:cactus":                        StateList[0x510],
+	"minecraft:clay":                          StateList[0x520],
+	"minecraft:reeds":                         StateList[0x530],
+	"minecraft:jukebox":                       StateList[0x540],
+	"minecraft:fence":                         StateList[0x550],
+	"minecraft:pumpkin":                       StateList[0x560],
+	"minecraft:netherrack":                    StateList[0x570],
+	"minecraft:soul_sand":                     StateList[0x580],
+	"minecraft:glowstone":                     StateList[0x590],
+	"minecraft:portal":                        StateList[0x5a1],
+	"minecraft:lit_pumpkin":                   StateList[0x5b0],
+	"minecraft:cake":                          StateList[0x5c0],
+	"minecraft:unpowered_repeater":            StateList[0x5d0],
+	"minecraft:powered_repeater":              StateList[0x5e0],
+	"minecraft:stained_glass":                 StateList[0x5f0],
+	"minecraft:trapdoor":                      StateList[0x600],
+	"minecraft:monster_egg":                   StateList[0x610],
+	"minecraft:stonebrick":                    StateList[0x620],
+	"minecraft:brown_mushroom_block":          StateList[0x630],
+	"minecraft:red_mushroom_block":            StateList[0x640],
+	"minecraft:iron_bars":                     StateList[0x650],
+	"minecraft:glass_pane":                    StateList[0x660],
+	"minecraft:melon_block":                   StateList[0x670],
+	"minecraft:pumpkin_stem":                  StateList[0x680],
+	"minecraft:melon_stem":                    StateList[0x690],
+	"minecraft:vine":                          StateList[0x6a0],
+	"minecraft:fence_gate":                    StateList[0x6b0],
+	"minecraft:brick_stairs":                  StateList[0x6c0],
+	"minecraft:stone_brick_stairs":            StateList[0x6d0],
+	"minecraft:mycelium":                      StateList[0x6e0],
+	"minecraft:waterlily":                     StateList[0x6f0],
+	"minecraft:nether_brick":                  StateList[0x700],
+	"minecraft:nether_brick_fence":            StateList[0x710],
+	"minecraft:nether_brick_stairs":           StateList[0x720],
+	"minecraft:nether_wart":                   StateList[0x730],
+	"minecraft:enchanting_table":              StateList[0x740],
+	"minecraft:brewing_stand":                 StateList[0x750],
+	"minecraft:cauldron":                      StateList[0x760],
+	"minecraft:end_portal":                    StateList[0x770],
+	"minecraft:end_portal_frame":              StateList[0x780],
+	"minecraft:end_stone":                     StateList[0x790],
+	"minecraft:dragon_egg":                    StateList[0x7a0],
+	"minecraft:redstone_lamp":                 StateList[0x7b0],
+	"minecraft:lit_redstone_lamp":             StateList[0x7c0],
+	"minecraft:double_wooden_slab":            StateList[0x7d0],
+	"minecraft:wooden_slab":                   StateList[0x7e0],
+	"minecraft:cocoa":                         StateList[0x7f0],
+	"minecraft:sandstone_stairs":              StateList[0x800],
+	"minecraft:emerald_ore":                   StateList[0x810],
+	"minecraft:ender_chest":                   StateList[0x822],
+	"minecraft:tripwire_hook":                 StateList[0x830],
+	"minecraft:tripwire":                      StateList[0x840],
+	"minecraft:emerald_block":                 StateList[0x850],
+	"minecraft:spruce_stairs":                 StateList[0x860],
+	"minecraft:birch_stairs":                  StateList[0x870],
+	"minecraft:jungle_stairs":                 StateList[0x880],
+	"minecraft:command_block":                 StateList[0x890],
+	"minecraft:beacon":                        StateList[0x8a0],
+	"minecraft:cobblestone_wall":              StateList[0x8b0],
+	"minecraft:flower_pot":                    StateList[0x8c0],
+	"minecraft:carrots":                       StateList[0x8d0],
+	"minecraft:potatoes":                      StateList[0x8e0],
+	"minecraft:wooden_button":                 StateList[0x8f0],
+	"minecraft:skull":                         StateList[0x901],
+	"minecraft:anvil":                         StateList[0x910],
+	"minecraft:trapped_chest":                 StateList[0x922],
+	"minecraft:light_weighted_pressure_plate": StateList[0x930],
+	"minecraft:heavy_weighted_pressure_plate": StateList[0x940],
+	"minecraft:unpowered_comparator":          StateList[0x950],
+	"minecraft:powered_comparator":            StateList[0x960],
+	"minecraft:daylight_detector":             StateList[0x970],
+	"minecraft:redstone_block":                StateList[0x980],
+	"minecraft:quartz_ore":                    StateList[0x990],
+	"minecraft:hopper":                        StateList[0x9a0],
+	"minecraft:quartz_block":                  StateList[0x9b0],
+	"minecraft:quartz_stairs":                 StateList[0x9c0],
+	"minecraft:activator_rail":                StateList[0x9d0],
+	"minecraft:dropper":                       StateList[0x9e0],
+	"minecraft:stained_hardened_clay":         StateList[0x9f0],
+	"minecraft:stained_glass_pane":            StateList[0xa00],
+	"minecraft:leaves2":                       StateList[0xa10],
+	"minecraft:log2":                          StateList[0xa20],
+	"minecraft:acacia_stairs":                 StateList[0xa30],
+	"minecraft:dark_oak_stairs":               StateList[0xa40],
+	"minecraft:slime":                         StateList[0xa50],
+	"minecraft:barrier":                       StateList[0xa60],
+	"minecraft:iron_trapdoor":                 StateList[0xa70],
+	"minecraft:prismarine":                    StateList[0xa80],
+	"minecraft:sea_lantern":                   StateList[0xa90],
+	"minecraft:hay_block":                     StateList[0xaa0],
+	"minecraft:carpet":                        StateList[0xab0],
+	"minecraft:hardened_clay":                 StateList[0xac0],
+	"minecraft:coal_block":                    StateList[0xad0],
+	"minecraft:packed_ice":                    StateList[0xae0],
+	"minecraft:double_plant":                  StateList[0xaf0],
+	"minecraft:standing_banner":               StateList[0xb00],
+	"minecraft:wall_banner":                   StateList[0xb12],
+	"minecraft:daylight_detector_inverted":    StateList[0xb20],
+	"minecraft:red_sandstone":                 StateList[0xb30],
+	"minecraft:red_sandstone_stairs":          StateList[0xb40],
+	"minecraft:double_stone_slab2":            StateList[0xb50],
+	"minecraft:stone_slab2":                   StateList[0xb60],
+	"minecraft:spruce_fence_gate":             StateList[0xb70],
+	"minecraft:birch_fence_gate":              StateList[0xb80],
+	"minecraft:jungle_fence_gate":             StateList[0xb90],
+	"minecraft:dark_oak_fence_gate":           StateList[0xba0],
+	"minecraft:acacia_fence_gate":             StateList[0xbb0],
+	"minecraft:spruce_fence":                  StateList[0xbc0],
+	"minecraft:birch_fence":                   StateList[0xbd0],
+	"minecraft:jungle_fence":                  StateList[0xbe0],
+	"minecraft:dark_oak_fence":                StateList[0xbf0],
+	"minecraft:acacia_fence":                  StateList[0xc00],
+	"minecraft:spruce_door":                   StateList[0xc10],
+	"minecraft:birch_door":                    StateList[0xc20],
+	"minecraft:jungle_door":                   StateList[0xc30],
+	"minecraft:acacia_door":                   StateList[0xc40],
+	"minecraft:dark_oak_door":                 StateList[0xc50],
+	"minecraft:end_rod":                       StateList[0xc60],
+	"minecraft:chorus_plant":                  StateList[0xc70],
+	"minecraft:chorus_flower":                 StateList[0xc80],
+	"minecraft:purpur_block":                  StateList[0xc90],
+	"minecraft:purpur_pillar":                 StateList[0xca0],
+	"minecraft:purpur_stairs":                 StateList[0xcb0],
+	"minecraft:purpur_double_slab":            StateList[0xcc0],
+	"minecraft:purpur_slab":                   StateList[0xcd0],
+	"minecraft:end_bricks":                    StateList[0xce0],
+	"minecraft:beetroots":                     StateList[0xcf0],
+	"minecraft:grass_path":                    StateList[0xd00],
+	"minecraft:end_gateway":                   StateList[0xd10],
+	"minecraft:repeating_command_block":       StateList[0xd20],
+	"minecraft:chain_command_block":           StateList[0xd30],
+	"minecraft:frosted_ice":                   StateList[0xd40],
+	"minecraft:magma":                         StateList[0xd50],
+	"minecraft:nether_wart_block":             StateList[0xd60],
+	"minecraft:red_nether_brick":              StateList[0xd70],
+	"minecraft:bone_block":                    StateList[0xd80],
+	"minecraft:structure_void":                StateList[0xd90],
+	"minecraft:observer":                      StateList[0xda0],
+	"minecraft:white_shulker_box":             StateList[0xdb0],
+	"minecraft:orange_shulker_box":            StateList[0xdc0],
+	"minecraft:magenta_shulker_box":           StateList[0xdd0],
+	"minecraft:light_blue_shulker_box":        StateList[0xde0],
+	"minecraft:yellow_shulker_box":            StateList[0xdf0],
+	"minecraft:lime_shulker_box":              StateList[0xe00],
+	"minecraft:pink_shulker_box":              StateList[0xe10],
+	"minecraft:gray_shulker_box":              StateList[0xe20],
+	"minecraft:silver_shulker_box":            StateList[0xe30],
+	"minecraft:cyan_shulker_box":              StateList[0xe40],
+	"minecraft:purple_shulker_box":            StateList[0xe50],
+	"minecraft:blue_shulker_box":              StateList[0xe60],
+	"minecraft:brown_shulker_box":             StateList[0xe70],
+	"minecraft:green_shulker_box":             StateList[0xe80],
+	"minecraft:red_shulker_box":               StateList[0xe90],
+	"minecraft:black_shulker_box":             StateList[0xea0],
+	"minecraft:white_glazed_terracotta":       StateList[0xeb0],
+	"minecraft:orange_glazed_terracotta":      StateList[0xec0],
+	"minecraft:magenta_glazed_terracotta":     StateList[0xed0],
+	"minecraft:light_blue_glazed_terracotta":  StateList[0xee0],
+	"minecraft:yellow_glazed_terracotta":      StateList[0xef0],
+	"minecraft:lime_glazed_terracotta":        StateList[0xf00],
+	"minecraft:pink_glazed_terracotta":        StateList[0xf10],
+	"minecraft:gray_glazed_terracotta":        StateList[0xf20],
+	"minecraft:silver_glazed_terracotta":      StateList[0xf30],
+	"minecraft:cyan_glazed_terracotta":        StateList[0xf40],
+	"minecraft:purple_glazed_terracotta":      StateList[0xf50],
+	"minecraft:blue_glazed_terracotta":        StateList[0xf60],
+	"minecraft:brown_glazed_terracotta":       StateList[0xf70],
+	"minecraft:green_glazed_terracotta":       StateList[0xf80],
+	"minecraft:red_glazed_terracotta":         StateList[0xf90],
+	"minecraft:black_glazed_terracotta":       StateList[0xfa0],
+	"minecraft:concrete":                      StateList[0xfb0],
+	"minecraft:concrete_powder":               StateList[0xfc0],
+	"minecraft:structure_block":               StateList[0xff0],
+}
@@ -0,0 +1,267 @@
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"github.com/edouard127/mc-go-1.12.2/generateutils"
+	"go/format"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+//go:embed blocks.go.tmpl
+var blocksSource string
+
+//go:embed properties_enum.go.tmpl
+var enumSource string
+
+//go:embed physics_table.go.tmpl
+var physicsSource string
+
+var funcs = template.FuncMap{
+	"ToGoTypeName": generateutils.ToGoTypeName,
+	"ToLower":      strings.ToLower,
+	"Generator":    func() string { return "generator/main.go" },
+	"Float":        func(f float64) string { return strconv.FormatFloat(f, 'f', -1, 64) },
+	"AABB":         aabb,
+}
+
+var (
+	blocksTemp  = template.Must(template.New("blocks_template").Funcs(funcs).Parse(blocksSource))
+	enumTemp    = template.Must(template.New("enum_template").Funcs(funcs).Parse(enumSource))
+	physicsTemp = template.Must(template.New("physics_template").Funcs(funcs).Parse(physicsSource))
+)
+
+// Spec is the content of blocks.json
+type Spec struct {
+	Enums  []Enum
+	Kinds  map[string][]Property
+	Blocks []BlockSpec
+}
+
+type Enum struct {
+	Name   string
+	Values []string
+}
+
+type BlockSpec struct {
+	ID         int
+	Name       string
+	Kind       string
+	Properties []Property
+
+	Hardness     float64
+	Resistance   float64
+	Tool         string
+	RequiresTool bool `json:"requires_tool"`
+	Level        int
+	Shape        string   // one of Shapes, "full" if empty
+	Flags        []string // liquid, climbable, replaceable or transparent
+}
+
+// Property describe how a property is stored in the metadata.
+// The value is (meta >> Shift) & (1<<Bits - 1).
+type Property struct {
+	Name  string
+	Type  string // an enum, "bool" or "int"
+	Shift uint
+	Bits  uint
+	// For enums, the name of each value, "" if the value is invalid.
+	Values []string
+	// For ints, the range of the property. The value stored is the property minus Min.
+	Min, Max *int
+	// For bools, true if the bit is set when the property is false.
+	Invert bool
+	// The property only exists if the other properties have these values.
+	When map[string]string
+}
+
+func (p Property) mask() int { return (1<<p.Bits - 1) << p.Shift }
+
+// decode return the string value of the property and its Go literal
+func (p Property) decode(meta int) (value, literal string, ok bool) {
+	raw := meta & p.mask() >> p.Shift
+	switch p.Type {
+	case "bool":
+		v := raw != 0
+		if p.Invert {
+			v = !v
+		}
+		return strconv.FormatBool(v), strconv.FormatBool(v), true
+	case "int":
+		min, max := 0, 1<<p.Bits-1
+		if p.Min != nil {
+			min = *p.Min
+		}
+		if p.Max != nil {
+			max = *p.Max
+		}
+		if v := min + raw; v <= max {
+			return strconv.Itoa(v), strconv.Itoa(v), true
+		}
+		return "", "", false
+	default:
+		if raw >= len(p.Values) || p.Values[raw] == "" {
+			return "", "", false
+		}
+		return p.Values[raw], p.Type + generateutils.ToGoTypeName(p.Values[raw]), true
+	}
+}
+
+type Block struct {
+	ID       int
+	Name     string
+	TypeName string
+	Fields   []Field
+	Default  int // the state with the lowest metadata
+	BlockSpec
+}
+
+type Field struct {
+	Name, Type, Key string
+}
+
+type State struct {
+	ID       int
+	TypeName string
+	Values   []Value
+	Shape    int // index in shapes
+}
+
+type Value struct {
+	Field, Literal string
+}
+
+func main() {
+	f, err := os.ReadFile("blocks.json")
+	if err != nil {
+		log.Panic(err)
+	}
+	var spec Spec
+	if err := json.Unmarshal(f, &spec); err != nil {
+		log.Panic(err)
+	}
+
+	blocks, states, shapes := buildStates(spec)
+	data := struct {
+		Blocks []Block
+		States []State
+		Shapes [][]Box
+	}{blocks, states, shapes}
+	genSourceFile("blocks.go", blocksTemp, data)
+	genSourceFile("properties_enum.go", enumTemp, spec.Enums)
+	genSourceFile("physics_table.go", physicsTemp, data)
+}
+
+// buildStates list every valid metadata of each block and their collision boxes
+func buildStates(spec Spec) (blocks []Block, states []State, shapes [][]Box) {
+	shapeIndex := map[string]int{"[]": 0}
+	shapes = append(shapes, nil)
+	enums := make(map[string]bool)
+	for _, e := range spec.Enums {
+		enums[e.Name] = true
+	}
+	for _, b := range spec.Blocks {
+		props := b.Properties
+		if b.Kind != "" {
+			var ok bool
+			if props, ok = spec.Kinds[b.Kind]; !ok {
+				log.Panicf("%s: unknown kind %q", b.Name, b.Kind)
+			}
+		}
+		block := Block{ID: b.ID, Name: "minecraft:" + b.Name, TypeName: generateutils.ToGoTypeName(b.Name), BlockSpec: b}
+		if block.Shape == "" {
+			block.Shape = "full"
+		}
+		shape, ok := Shapes[block.Shape]
+		if !ok {
+			log.Panicf("%s: unknown shape %q", b.Name, block.Shape)
+		}
+		if block.Shape != "full" {
+			block.Flags = append(block.Flags, "transparent")
+		}
+		for _, p := range props {
+			if p.Type != "bool" && p.Type != "int" && !enums[p.Type] {
+				log.Panicf("%s: unknown type %q of property %s", b.Name, p.Type, p.Name)
+			}
+			block.Fields = append(block.Fields, Field{Name: generateutils.ToGoTypeName(p.Name), Type: p.Type, Key: p.Name})
+		}
+
+	metas:
+		for meta := 0; meta < 16; meta++ {
+			state := State{ID: b.ID<<4 | meta, TypeName: block.TypeName}
+			values := make(map[string]string)
+			used := 0
+		properties:
+			for _, p := range props {
+				for k, v := range p.When {
+					if values[k] != v {
+						continue properties
+					}
+				}
+				value, literal, ok := p.decode(meta)
+				if !ok {
+					continue metas
+				}
+				values[p.Name] = value
+				used |= p.mask()
+				state.Values = append(state.Values, Value{Field: generateutils.ToGoTypeName(p.Name), Literal: literal})
+			}
+			// bits not used by any property are always 0
+			if meta&^used != 0 {
+				continue
+			}
+			boxes := shape(values)
+			if key := fmt.Sprint(boxes); len(boxes) > 0 && &boxes[0] == &fromBelow[0] {
+				state.Shape = shapeFromBelow
+			} else if i, ok := shapeIndex[key]; ok {
+				state.Shape = i
+			} else {
+				state.Shape = len(shapes)
+				shapeIndex[key] = state.Shape
+				shapes = append(shapes, boxes)
+			}
+			if len(states) == 0 || states[len(states)-1].ID>>4 != b.ID {
+				block.Default = state.ID
+			}
+			states = append(states, state)
+		}
+		blocks = append(blocks, block)
+	}
+	if len(shapes) >= shapeFromBelow {
+		log.Panicf("too many shapes: %d", len(shapes))
+	}
+	return
+}
+
+// shapeFromBelow is the index of the shapes which are the one of the block under them
+const shapeFromBelow = 0xFF
+
+// aabb return the Go source of a box
+func aabb(b Box) string {
+	f := func(v float64) string { return strconv.FormatFloat(v/16, 'f', -1, 64) }
+	return fmt.Sprintf("{Min: maths.Vector3{X: %s, Y: %s, Z: %s}, Max: maths.Vector3{X: %s, Y: %s, Z: %s}}",
+		f(b[0]), f(b[1]), f(b[2]), f(b[3]), f(b[4]), f(b[5]))
+}
+
+func genSourceFile(name string, temp *template.Template, data any) {
+	var source bytes.Buffer
+	if err := temp.Execute(&source, data); err != nil {
+		log.Panic(err)
+	}
+
+	formattedSource, err := format.Source(source.Bytes())
+	if err != nil {
+		panic(fmt.Errorf("%s: %v", name, err))
+	}
+
+	err = os.WriteFile(name, formattedSource, 0666)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,157 @@
+package main
+
+// Box is a collision box in 1/16 of block
+type Box [6]float64
+
+func box(minX, minY, minZ, maxX, maxY, maxZ float64) Box {
+	return Box{minX, minY, minZ, maxX, maxY, maxZ}
+}
+
+// fromBelow is returned by the shapes of the blocks which take the
+// collision boxes of the block under them, like the upper half of doors.
+var fromBelow = []Box{{-1}}
+
+var full = []Box{box(0, 0, 0, 16, 16, 16)}
+
+// Shapes compute the collision boxes of a state from its properties.
+// It follows the bounding boxes of the 1.12.2 blocks.
+var Shapes = map[string]func(p map[string]string) []Box{
+	"empty":             func(map[string]string) []Box { return nil },
+	"full":              func(map[string]string) []Box { return full },
+	"bed":               height(9),
+	"farmland":          height(15),
+	"soul_sand":         height(14),
+	"repeater":          height(2),
+	"enchanting_table":  height(12),
+	"daylight_detector": height(6),
+	"carpet":            height(1),
+	"chest":             func(map[string]string) []Box { return []Box{box(1, 0, 1, 15, 14, 15)} },
+	"cactus":            func(map[string]string) []Box { return []Box{box(1, 0, 1, 15, 15, 15)} },
+	"dragon_egg":        func(map[string]string) []Box { return []Box{box(1, 0, 1, 15, 16, 15)} },
+	"lily_pad":          func(map[string]string) []Box { return []Box{box(1, 0, 1, 15, 1.5, 15)} },
+	"flower_pot":        func(map[string]string) []Box { return []Box{box(5, 0, 5, 11, 6, 11)} },
+	"skull":             func(map[string]string) []Box { return []Box{box(4, 0, 4, 12, 8, 12)} },
+	"chorus_plant":      func(map[string]string) []Box { return []Box{box(3, 3, 3, 13, 13, 13)} },
+	"fence":             func(map[string]string) []Box { return []Box{box(6, 0, 6, 10, 24, 10)} },
+	"wall":              func(map[string]string) []Box { return []Box{box(4, 0, 4, 12, 24, 12)} },
+	"pane":              func(map[string]string) []Box { return []Box{box(7, 0, 7, 9, 16, 9)} },
+	"brewing_stand": func(map[string]string) []Box {
+		return []Box{box(0, 0, 0, 16, 2, 16), box(7, 0, 7, 9, 14, 9)}
+	},
+	"cauldron": hollow(5),
+	"hopper":   hollow(10),
+	"slab": func(p map[string]string) []Box {
+		if p["half"] == "top" {
+			return []Box{box(0, 8, 0, 16, 16, 16)}
+		}
+		return []Box{box(0, 0, 0, 16, 8, 16)}
+	},
+	"stairs": func(p map[string]string) []Box {
+		slab, step := box(0, 0, 0, 16, 8, 16), box(0, 8, 0, 16, 16, 16)
+		if p["half"] == "top" {
+			slab, step = step, slab
+		}
+		switch p["facing"] {
+		case "north":
+			step[5] = 8
+		case "south":
+			step[2] = 8
+		case "west":
+			step[3] = 8
+		case "east":
+			step[0] = 8
+		}
+		return []Box{slab, step}
+	},
+	"snow_layer": func(p map[string]string) []Box {
+		if p["layers"] == "1" {
+			return nil
+		}
+		return height(2 * (float64(p["layers"][0]-'0') - 1))(p)
+	},
+	"cake": func(p map[string]string) []Box {
+		return []Box{box(1+2*float64(p["bites"][0]-'0'), 0, 1, 15, 8, 15)}
+	},
+	"ladder": func(p map[string]string) []Box { return thin(p["facing"]) },
+	"door": func(p map[string]string) []Box {
+		if p["half"] == "upper" {
+			return fromBelow
+		}
+		if p["open"] == "false" {
+			return thin(p["facing"])
+		}
+		// the hinge is stored in the upper half, assume it's on the left
+		return thin(map[string]string{"east": "south", "south": "west", "west": "north", "north": "east"}[p["facing"]])
+	},
+	"trapdoor": func(p map[string]string) []Box {
+		switch {
+		case p["open"] == "true":
+			return thin(p["facing"])
+		case p["half"] == "top":
+			return []Box{box(0, 13, 0, 16, 16, 16)}
+		default:
+			return []Box{box(0, 0, 0, 16, 3, 16)}
+		}
+	},
+	"fence_gate": func(p map[string]string) []Box {
+		if p["open"] == "true" {
+			return nil
+		}
+		if p["facing"] == "north" || p["facing"] == "south" {
+			return []Box{box(0, 0, 6, 16, 24, 10)}
+		}
+		return []Box{box(6, 0, 0, 10, 24, 16)}
+	},
+	"end_portal_frame": func(p map[string]string) []Box {
+		if p["eye"] == "true" {
+			return []Box{box(0, 0, 0, 16, 13, 16), box(4, 13, 4, 12, 16, 12)}
+		}
+		return height(13)(p)
+	},
+	"anvil": func(p map[string]string) []Box {
+		if p["facing"] == "east" || p["facing"] == "west" {
+			return []Box{box(0, 0, 2, 16, 16, 14)}
+		}
+		return []Box{box(2, 0, 0, 14, 16, 16)}
+	},
+	"end_rod": func(p map[string]string) []Box {
+		switch p["facing"] {
+		case "east", "west":
+			return []Box{box(0, 6, 6, 16, 10, 10)}
+		case "north", "south":
+			return []Box{box(6, 6, 0, 10, 10, 16)}
+		default:
+			return []Box{box(6, 0, 6, 10, 16, 10)}
+		}
+	},
+}
+
+func height(h float64) func(map[string]string) []Box {
+	return func(map[string]string) []Box { return []Box{box(0, 0, 0, 16, h, 16)} }
+}
+
+// hollow is a block with a floor and four 2/16 thick walls, like cauldrons
+func hollow(floor float64) func(map[string]string) []Box {
+	return func(map[string]string) []Box {
+		return []Box{
+			box(0, 0, 0, 16, floor, 16),
+			box(0, 0, 0, 2, 16, 16), box(14, 0, 0, 16, 16, 16),
+			box(0, 0, 0, 16, 16, 2), box(0, 0, 14, 16, 16, 16),
+		}
+	}
+}
+
+// thin return a 3/16 thick box against the side of the block opposite to facing,
+// like a ladder facing north which is on the south side of the block.
+func thin(facing string) []Box {
+	switch facing {
+	case "north":
+		return []Box{box(0, 0, 13, 16, 16, 16)}
+	case "south":
+		return []Box{box(0, 0, 0, 16, 16, 3)}
+	case "west":
+		return []Box{box(13, 0, 0, 16, 16, 16)}
+	default:
+		return []Box{box(0, 0, 0, 3, 16, 16)}
+	}
+}
@@ -0,0 +1,36 @@
+// Package legacy is the block state registry of Minecraft 1.12.2.
+// Before 1.13, a block state is a numeric block ID and a 4 bits metadata,
+// sent in the packets as a global state ID (id<<4 | meta).
+// The properties of each block are decoded from the metadata by
+// generator/main.go, following blocks.json.
+package legacy
+
+// BitsPerBlock is the number of bits of a global state ID
+const BitsPerBlock = 13
+
+// StateID is a global state ID (id<<4 | meta)
+type StateID int
+
+type Block interface {
+	ID() string
+}
+
+// ToStateID is the reverse of StateList
+var ToStateID map[Block]StateID
+
+func init() {
+	ToStateID = make(map[Block]StateID)
+	for i, b := range StateList {
+		if b != nil {
+			ToStateID[b] = StateID(i)
+		}
+	}
+}
+
+// ByStateID return the block of a global state ID, or nil if the ID or the metadata is invalid
+func ByStateID(s StateID) Block {
+	if s < 0 || int(s) >= len(StateList) {
+		return nil
+	}
+	return StateList[s]
+}
@@ -0,0 +1,82 @@
+package legacy
+
+import (
+	"testing"
+
+	"github.com/edouard127/mc-go-1.12.2/maths"
+)
+
+func TestByStateID(t *testing.T) {
+	for _, test := range []struct {
+		state StateID
+		want  Block
+	}{
+		{1<<4 | 1, Stone{Variant: StoneVariantGranite}},
+		{17<<4 | 0b1001, Log{Variant: WoodTypeSpruce, Axis: LogAxisZ}},
+		{53<<4 | 0b0111, OakStairs{Facing: FacingNorth, Half: HalfTop}},
+		{64<<4 | 0b0110, WoodenDoor{Half: DoubleBlockHalfLower, Facing: FacingWest, Open: true}},
+		{64<<4 | 0b1001, WoodenDoor{Half: DoubleBlockHalfUpper, Hinge: HingeRight}},
+		{50<<4 | 5, Torch{Facing: FacingUp}},
+		{78<<4 | 7, SnowLayer{Layers: 8}},
+		{99<<4 | 15, BrownMushroomBlock{Variant: HugeMushroomVariantAllStem}},
+		{251<<4 | 14, Concrete{Color: ColorRed}},
+	} {
+		if got := ByStateID(test.state); got != test.want {
+			t.Errorf("ByStateID(%#x) = %#v, want %#v", test.state, got, test.want)
+		}
+		if got := ToStateID[test.want]; got != test.state {
+			t.Errorf("ToStateID[%#v] = %#x, want %#x", test.want, got, test.state)
+		}
+	}
+
+	// Invalid metadata
+	for _, s := range []StateID{2<<4 | 1, 50<<4 | 0, 99<<4 | 12, 92<<4 | 7, 253 << 4, -1, 1 << 12} {
+		if b := ByStateID(s); b != nil {
+			t.Errorf("ByStateID(%#x) = %#v, want nil", s, b)
+		}
+	}
+	if FromID["minecraft:chest"] != (Chest{Facing: FacingNorth}) {
+		t.Errorf("wrong default state of chest: %#v", FromID["minecraft:chest"])
+	}
+}
+
+func TestCollisionBoxes(t *testing.T) {
+	box := func(minX, minY, minZ, maxX, maxY, maxZ float64) maths.AABB {
+		return maths.AABB{Min: maths.Vector3{X: minX, Y: minY, Z: minZ}, Max: maths.Vector3{X: maxX, Y: maxY, Z: maxZ}}
+	}
+	door := ToStateID[WoodenDoor{Half: DoubleBlockHalfLower, Facing: FacingSouth, Open: false}]
+	for _, test := range []struct {
+		name     string
+		s, below StateID
+		want     []maths.AABB
+	}{
+		{"air", 0, -1, nil},
+		{"stone", 1 << 4, -1, []maths.AABB{box(0, 0, 0, 1, 1, 1)}},
+		{"top slab", ToStateID[StoneSlab{Variant: StoneSlabVariantBrick, Half: HalfTop}], -1, []maths.AABB{box(0, 0.5, 0, 1, 1, 1)}},
+		{"stairs", ToStateID[OakStairs{Facing: FacingNorth, Half: HalfBottom}], -1, []maths.AABB{box(0, 0, 0, 1, 0.5, 1), box(0, 0.5, 0, 1, 1, 0.5)}},
+		{"fence", 85 << 4, -1, []maths.AABB{box(0.375, 0, 0.375, 0.625, 1.5, 0.625)}},
+		{"carpet", ToStateID[Carpet{Color: ColorRed}], -1, []maths.AABB{box(0, 0, 0, 1, 0.0625, 1)}},
+		{"snow", ToStateID[SnowLayer{Layers: 3}], -1, []maths.AABB{box(0, 0, 0, 1, 0.25, 1)}},
+		{"upper door", ToStateID[WoodenDoor{Half: DoubleBlockHalfUpper}], door, []maths.AABB{box(0, 0, 0, 1, 1, 0.1875)}},
+		{"upper door alone", ToStateID[WoodenDoor{Half: DoubleBlockHalfUpper}], 1 << 4, []maths.AABB{box(0, 0, 0, 0.1875, 1, 1)}},
+		{"invalid", 1<<4 | 15, -1, nil},
+	} {
+		got := CollisionBoxes(test.s, test.below)
+		if len(got) != len(test.want) {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != test.want[i] {
+				t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+			}
+		}
+	}
+
+	if p := Physics[49]; p.Tool != ToolPickaxe || !p.RequiresTool || p.HarvestLevel != 3 {
+		t.Errorf("wrong harvest tool of obsidian: %+v", p)
+	}
+	if p := Physics[9]; !p.Liquid || !p.Replaceable || p.Climbable {
+		t.Errorf("wrong flags of water: %+v", p)
+	}
+}
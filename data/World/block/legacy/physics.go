@@ -0,0 +1,58 @@
+package legacy
+
+import "github.com/edouard127/mc-go-1.12.2/maths"
+
+// Tool is the kind of tool which breaks a block faster
+type Tool byte
+
+const (
+	ToolNone Tool = iota
+	ToolPickaxe
+	ToolAxe
+	ToolShovel
+	ToolSword
+	ToolShears
+)
+
+var strTool = [...]string{"none", "pickaxe", "axe", "shovel", "sword", "shears"}
+
+func (t Tool) String() string {
+	if int(t) < len(strTool) {
+		return strTool[t]
+	}
+	return "invalid Tool"
+}
+
+// Physical is the physical properties of a block
+type Physical struct {
+	Hardness        float64 // -1 if the block can't be broken
+	BlastResistance float64
+	Tool            Tool
+	RequiresTool    bool // the block drops nothing if it's not broken with Tool
+	HarvestLevel    int  // the minimum material of Tool: 0 wood or gold, 1 stone, 2 iron, 3 diamond
+
+	Liquid      bool
+	Climbable   bool
+	Replaceable bool // a block can be placed in it, like in air or water
+	Transparent bool // it doesn't hide the blocks behind it
+}
+
+// CollisionBoxes return the collision boxes of a state, relative to the position of the block.
+// The upper half of a door has the collision box of its lower half, so below should be the state
+// under s. If it isn't the lower half of the same door, a closed door facing east is assumed.
+// Otherwise below is ignored.
+//
+// The connections of fences, walls and panes to their neighbours are ignored, only their post collides.
+func CollisionBoxes(s, below StateID) []maths.AABB {
+	if s < 0 || int(s) >= len(stateShapes) {
+		return nil
+	}
+	i := stateShapes[s]
+	if i == shapeFromBelow {
+		if below>>4 != s>>4 || stateShapes[below] == shapeFromBelow {
+			below = ToStateID[FromID[Names[s>>4]]]
+		}
+		i = stateShapes[below]
+	}
+	return shapes[i]
+}
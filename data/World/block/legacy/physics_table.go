@@ -0,0 +1,1505 @@
+// Code generated by generator/main.go; DO NOT EDIT.
+package legacy
+
+import "github.com/edouard127/mc-go-1.12.2/maths"
+
+// Physics contains the physical properties of the blocks by numeric ID
+var Physics = [256]Physical{
+	0:   {Hardness: 0, BlastResistance: 0, Replaceable: true, Transparent: true},
+	1:   {Hardness: 1.5, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true},
+	2:   {Hardness: 0.6, BlastResistance: 3, Tool: ToolShovel},
+	3:   {Hardness: 0.5, BlastResistance: 2.5, Tool: ToolShovel},
+	4:   {Hardness: 2, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true},
+	5:   {Hardness: 2, BlastResistance: 15, Tool: ToolAxe},
+	6:   {Hardness: 0, BlastResistance: 0, Transparent: true},
+	7:   {Hardness: -1, BlastResistance: 18000000},
+	8:   {Hardness: 100, BlastResistance: 500, Liquid: true, Replaceable: true, Transparent: true},
+	9:   {Hardness: 100, BlastResistance: 500, Liquid: true, Replaceable: true, Transparent: true},
+	10:  {Hardness: 100, BlastResistance: 500, Liquid: true, Replaceable: true, Transparent: true},
+	11:  {Hardness: 100, BlastResistance: 500, Liquid: true, Replaceable: true, Transparent: true},
+	12:  {Hardness: 0.5, BlastResistance: 2.5, Tool: ToolShovel},
+	13:  {Hardness: 0.6, BlastResistance: 3, Tool: ToolShovel},
+	14:  {Hardness: 3, BlastResistance: 15, Tool: ToolPickaxe, RequiresTool: true, HarvestLevel: 2},
+	15:  {Hardness: 3, BlastResistance: 15, Tool: ToolPickaxe, RequiresTool: true, HarvestLevel: 1},
+	16:  {Hardness: 3, BlastResistance: 15, Tool: ToolPickaxe, RequiresTool: true},
+	17:  {Hardness: 2, BlastResistance: 10, Tool: ToolAxe},
+	18:  {Hardness: 0.2, BlastResistance: 1, Tool: ToolShears, Transparent: true},
+	19:  {Hardness: 0.6, BlastResistance: 3},
+	20:  {Hardness: 0.3, BlastResistance: 1.5, Transparent: true},
+	21:  {Hardness: 3, BlastResistance: 15, Tool: ToolPickaxe, RequiresTool: true, HarvestLevel: 1},
+	22:  {Hardness: 3, BlastResistance: 15, Tool: ToolPickaxe, RequiresTool: true, HarvestLevel: 1},
+	23:  {Hardness: 3.5, BlastResistance: 17.5, Tool: ToolPickaxe, RequiresTool: true},
+	24:  {Hardness: 0.8, BlastResistance: 4, Tool: ToolPickaxe, RequiresTool: true},
+	25:  {Hardness: 0.8, BlastResistance: 4, Tool: ToolAxe},
+	26:  {Hardness: 0.2, BlastResistance: 1, Transparent: true},
+	27:  {Hardness: 0.7, BlastResistance: 3.5, Tool: ToolPickaxe, Transparent: true},
+	28:  {Hardness: 0.7, BlastResistance: 3.5, Tool: ToolPickaxe, Transparent: true},
+	29:  {Hardness: 0.5, BlastResistance: 2.5, Tool: ToolPickaxe},
+	30:  {Hardness: 4, BlastResistance: 20, Tool: ToolSword, RequiresTool: true, Transparent: true},
+	31:  {Hardness: 0, BlastResistance: 0, Replaceable: true, Transparent: true},
+	32:  {Hardness: 0, BlastResistance: 0, Replaceable: true, Transparent: true},
+	33:  {Hardness: 0.5, BlastResistance: 2.5, Tool: ToolPickaxe},
+	34:  {Hardness: 0.5, BlastResistance: 2.5, Tool: ToolPickaxe},
+	35:  {Hardness: 0.8, BlastResistance: 4, Tool: ToolShears},
+	36:  {Hardness: -1, BlastResistance: 0, Transparent: true},
+	37:  {Hardness: 0, BlastResistance: 0, Transparent: true},
+	38:  {Hardness: 0, BlastResistance: 0, Transparent: true},
+	39:  {Hardness: 0, BlastResistance: 0, Transparent: true},
+	40:  {Hardness: 0, BlastResistance: 0, Transparent: true},
+	41:  {Hardness: 3, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true, HarvestLevel: 2},
+	42:  {Hardness: 5, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true, HarvestLevel: 1},
+	43:  {Hardness: 2, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true},
+	44:  {Hardness: 2, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true, Transparent: true},
+	45:  {Hardness: 2, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true},
+	46:  {Hardness: 0, BlastResistance: 0},
+	47:  {Hardness: 1.5, BlastResistance: 7.5, Tool: ToolAxe},
+	48:  {Hardness: 2, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true},
+	49:  {Hardness: 50, BlastResistance: 6000, Tool: ToolPickaxe, RequiresTool: true, HarvestLevel: 3},
+	50:  {Hardness: 0, BlastResistance: 0, Transparent: true},
+	51:  {Hardness: 0, BlastResistance: 0, Replaceable: true, Transparent: true},
+	52:  {Hardness: 5, BlastResistance: 25, Tool: ToolPickaxe, RequiresTool: true, Transparent: true},
+	53:  {Hardness: 2, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	54:  {Hardness: 2.5, BlastResistance: 12.5, Tool: ToolAxe, Transparent: true},
+	55:  {Hardness: 0, BlastResistance: 0, Transparent: true},
+	56:  {Hardness: 3, BlastResistance: 15, Tool: ToolPickaxe, RequiresTool: true, HarvestLevel: 2},
+	57:  {Hardness: 5, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true, HarvestLevel: 2},
+	58:  {Hardness: 2.5, BlastResistance: 12.5, Tool: ToolAxe},
+	59:  {Hardness: 0, BlastResistance: 0, Transparent: true},
+	60:  {Hardness: 0.6, BlastResistance: 3, Tool: ToolShovel, Transparent: true},
+	61:  {Hardness: 3.5, BlastResistance: 17.5, Tool: ToolPickaxe, RequiresTool: true},
+	62:  {Hardness: 3.5, BlastResistance: 17.5, Tool: ToolPickaxe, RequiresTool: true},
+	63:  {Hardness: 1, BlastResistance: 5, Tool: ToolAxe, Transparent: true},
+	64:  {Hardness: 3, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	65:  {Hardness: 0.4, BlastResistance: 2, Tool: ToolAxe, Climbable: true, Transparent: true},
+	66:  {Hardness: 0.7, BlastResistance: 3.5, Tool: ToolPickaxe, Transparent: true},
+	67:  {Hardness: 2, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true, Transparent: true},
+	68:  {Hardness: 1, BlastResistance: 5, Tool: ToolAxe, Transparent: true},
+	69:  {Hardness: 0.5, BlastResistance: 2.5, Transparent: true},
+	70:  {Hardness: 0.5, BlastResistance: 2.5, Tool: ToolPickaxe, RequiresTool: true, Transparent: true},
+	71:  {Hardness: 5, BlastResistance: 25, Tool: ToolPickaxe, RequiresTool: true, Transparent: true},
+	72:  {Hardness: 0.5, BlastResistance: 2.5, Tool: ToolAxe, Transparent: true},
+	73:  {Hardness: 3, BlastResistance: 15, Tool: ToolPickaxe, RequiresTool: true, HarvestLevel: 2},
+	74:  {Hardness: 3, BlastResistance: 15, Tool: ToolPickaxe, RequiresTool: true, HarvestLevel: 2},
+	75:  {Hardness: 0, BlastResistance: 0, Transparent: true},
+	76:  {Hardness: 0, BlastResistance: 0, Transparent: true},
+	77:  {Hardness: 0.5, BlastResistance: 2.5, Tool: ToolPickaxe, Transparent: true},
+	78:  {Hardness: 0.1, BlastResistance: 0.5, Tool: ToolShovel, RequiresTool: true, Replaceable: true, Transparent: true},
+	79:  {Hardness: 0.5, BlastResistance: 2.5, Tool: ToolPickaxe, Transparent: true},
+	80:  {Hardness: 0.2, BlastResistance: 1, Tool: ToolShovel, RequiresTool: true},
+	81:  {Hardness: 0.4, BlastResistance: 2, Transparent: true},
+	82:  {Hardness: 0.6, BlastResistance: 3, Tool: ToolShovel},
+	83:  {Hardness: 0, BlastResistance: 0, Transparent: true},
+	84:  {Hardness: 2, BlastResistance: 30, Tool: ToolAxe},
+	85:  {Hardness: 2, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	86:  {Hardness: 1, BlastResistance: 5, Tool: ToolAxe},
+	87:  {Hardness: 0.4, BlastResistance: 2, Tool: ToolPickaxe, RequiresTool: true},
+	88:  {Hardness: 0.5, BlastResistance: 2.5, Tool: ToolShovel, Transparent: true},
+	89:  {Hardness: 0.3, BlastResistance: 1.5},
+	90:  {Hardness: -1, BlastResistance: 0, Transparent: true},
+	91:  {Hardness: 1, BlastResistance: 5, Tool: ToolAxe},
+	92:  {Hardness: 0.5, BlastResistance: 2.5, Transparent: true},
+	93:  {Hardness: 0, BlastResistance: 0, Transparent: true},
+	94:  {Hardness: 0, BlastResistance: 0, Transparent: true},
+	95:  {Hardness: 0.3, BlastResistance: 1.5, Transparent: true},
+	96:  {Hardness: 3, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	97:  {Hardness: 0.75, BlastResistance: 3.75},
+	98:  {Hardness: 1.5, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true},
+	99:  {Hardness: 0.2, BlastResistance: 1, Tool: ToolAxe},
+	100: {Hardness: 0.2, BlastResistance: 1, Tool: ToolAxe},
+	101: {Hardness: 5, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true, Transparent: true},
+	102: {Hardness: 0.3, BlastResistance: 1.5, Transparent: true},
+	103: {Hardness: 1, BlastResistance: 5, Tool: ToolAxe},
+	104: {Hardness: 0, BlastResistance: 0, Transparent: true},
+	105: {Hardness: 0, BlastResistance: 0, Transparent: true},
+	106: {Hardness: 0.2, BlastResistance: 1, Tool: ToolShears, Climbable: true, Replaceable: true, Transparent: true},
+	107: {Hardness: 2, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	108: {Hardness: 2, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true, Transparent: true},
+	109: {Hardness: 1.5, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true, Transparent: true},
+	110: {Hardness: 0.6, BlastResistance: 3, Tool: ToolShovel},
+	111: {Hardness: 0, BlastResistance: 0, Transparent: true},
+	112: {Hardness: 2, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true},
+	113: {Hardness: 2, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true, Transparent: true},
+	114: {Hardness: 2, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true, Transparent: true},
+	115: {Hardness: 0, BlastResistance: 0, Transparent: true},
+	116: {Hardness: 5, BlastResistance: 6000, Tool: ToolPickaxe, RequiresTool: true, Transparent: true},
+	117: {Hardness: 0.5, BlastResistance: 2.5, Tool: ToolPickaxe, RequiresTool: true, Transparent: true},
+	118: {Hardness: 2, BlastResistance: 10, Tool: ToolPickaxe, RequiresTool: true, Transparent: true},
+	119: {Hardness: -1, BlastResistance: 18000000, Transparent: true},
+	120: {Hardness: -1, BlastResistance: 18000000, Transparent: true},
+	121: {Hardness: 3, BlastResistance: 45, Tool: ToolPickaxe, RequiresTool: true},
+	122: {Hardness: 3, BlastResistance: 45, Transparent: true},
+	123: {Hardness: 0.3, BlastResistance: 1.5},
+	124: {Hardness: 0.3, BlastResistance: 1.5},
+	125: {Hardness: 2, BlastResistance: 15, Tool: ToolAxe},
+	126: {Hardness: 2, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	127: {Hardness: 0.2, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	128: {Hardness: 0.8, BlastResistance: 4, Tool: ToolPickaxe, RequiresTool: true, Transparent: true},
+	129: {Hardness: 3, BlastResistance: 15, Tool: ToolPickaxe, RequiresTool: true, HarvestLevel: 2},
+	130: {Hardness: 22.5, BlastResistance: 3000, Tool: ToolPickaxe, RequiresTool: true, Transparent: true},
+	131: {Hardness: 0, BlastResistance: 0, Transparent: true},
+	132: {Hardness: 0, BlastResistance: 0, Transparent: true},
+	133: {Hardness: 5, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true, HarvestLevel: 2},
+	134: {Hardness: 2, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	135: {Hardness: 2, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	136: {Hardness: 2, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	137: {Hardness: -1, BlastResistance: 18000000},
+	138: {Hardness: 3, BlastResistance: 15, Transparent: true},
+	139: {Hardness: 2, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true, Transparent: true},
+	140: {Hardness: 0, BlastResistance: 0, Transparent: true},
+	141: {Hardness: 0, BlastResistance: 0, Transparent: true},
+	142: {Hardness: 0, BlastResistance: 0, Transparent: true},
+	143: {Hardness: 0.5, BlastResistance: 2.5, Tool: ToolAxe, Transparent: true},
+	144: {Hardness: 1, BlastResistance: 5, Transparent: true},
+	145: {Hardness: 5, BlastResistance: 6000, Tool: ToolPickaxe, RequiresTool: true, Transparent: true},
+	146: {Hardness: 2.5, BlastResistance: 12.5, Tool: ToolAxe, Transparent: true},
+	147: {Hardness: 0.5, BlastResistance: 2.5, Tool: ToolPickaxe, RequiresTool: true, Transparent: true},
+	148: {Hardness: 0.5, BlastResistance: 2.5, Tool: ToolPickaxe, RequiresTool: true, Transparent: true},
+	149: {Hardness: 0, BlastResistance: 0, Transparent: true},
+	150: {Hardness: 0, BlastResistance: 0, Transparent: true},
+	151: {Hardness: 0.2, BlastResistance: 1, Tool: ToolAxe, Transparent: true},
+	152: {Hardness: 5, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true},
+	153: {Hardness: 3, BlastResistance: 15, Tool: ToolPickaxe, RequiresTool: true},
+	154: {Hardness: 3, BlastResistance: 24, Tool: ToolPickaxe, RequiresTool: true, Transparent: true},
+	155: {Hardness: 0.8, BlastResistance: 4, Tool: ToolPickaxe, RequiresTool: true},
+	156: {Hardness: 0.8, BlastResistance: 4, Tool: ToolPickaxe, RequiresTool: true, Transparent: true},
+	157: {Hardness: 0.7, BlastResistance: 3.5, Tool: ToolPickaxe, Transparent: true},
+	158: {Hardness: 3.5, BlastResistance: 17.5, Tool: ToolPickaxe, RequiresTool: true},
+	159: {Hardness: 1.25, BlastResistance: 21, Tool: ToolPickaxe, RequiresTool: true},
+	160: {Hardness: 0.3, BlastResistance: 1.5, Transparent: true},
+	161: {Hardness: 0.2, BlastResistance: 1, Tool: ToolShears, Transparent: true},
+	162: {Hardness: 2, BlastResistance: 10, Tool: ToolAxe},
+	163: {Hardness: 2, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	164: {Hardness: 2, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	165: {Hardness: 0, BlastResistance: 0, Transparent: true},
+	166: {Hardness: -1, BlastResistance: 18000003, Transparent: true},
+	167: {Hardness: 5, BlastResistance: 25, Tool: ToolPickaxe, RequiresTool: true, Transparent: true},
+	168: {Hardness: 1.5, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true},
+	169: {Hardness: 0.3, BlastResistance: 1.5},
+	170: {Hardness: 0.5, BlastResistance: 2.5},
+	171: {Hardness: 0.1, BlastResistance: 0.5, Transparent: true},
+	172: {Hardness: 1.25, BlastResistance: 21, Tool: ToolPickaxe, RequiresTool: true},
+	173: {Hardness: 5, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true},
+	174: {Hardness: 0.5, BlastResistance: 2.5, Tool: ToolPickaxe},
+	175: {Hardness: 0, BlastResistance: 0, Replaceable: true, Transparent: true},
+	176: {Hardness: 1, BlastResistance: 5, Tool: ToolAxe, Transparent: true},
+	177: {Hardness: 1, BlastResistance: 5, Tool: ToolAxe, Transparent: true},
+	178: {Hardness: 0.2, BlastResistance: 1, Tool: ToolAxe, Transparent: true},
+	179: {Hardness: 0.8, BlastResistance: 4, Tool: ToolPickaxe, RequiresTool: true},
+	180: {Hardness: 0.8, BlastResistance: 4, Tool: ToolPickaxe, RequiresTool: true, Transparent: true},
+	181: {Hardness: 2, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true},
+	182: {Hardness: 2, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true, Transparent: true},
+	183: {Hardness: 2, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	184: {Hardness: 2, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	185: {Hardness: 2, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	186: {Hardness: 2, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	187: {Hardness: 2, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	188: {Hardness: 2, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	189: {Hardness: 2, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	190: {Hardness: 2, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	191: {Hardness: 2, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	192: {Hardness: 2, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	193: {Hardness: 3, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	194: {Hardness: 3, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	195: {Hardness: 3, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	196: {Hardness: 3, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	197: {Hardness: 3, BlastResistance: 15, Tool: ToolAxe, Transparent: true},
+	198: {Hardness: 0, BlastResistance: 0, Transparent: true},
+	199: {Hardness: 0.4, BlastResistance: 2, Tool: ToolAxe, Transparent: true},
+	200: {Hardness: 0.4, BlastResistance: 2, Tool: ToolAxe},
+	201: {Hardness: 1.5, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true},
+	202: {Hardness: 1.5, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true},
+	203: {Hardness: 1.5, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true, Transparent: true},
+	204: {Hardness: 2, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true},
+	205: {Hardness: 2, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true, Transparent: true},
+	206: {Hardness: 0.8, BlastResistance: 4, Tool: ToolPickaxe, RequiresTool: true},
+	207: {Hardness: 0, BlastResistance: 0, Transparent: true},
+	208: {Hardness: 0.65, BlastResistance: 3.25, Tool: ToolShovel, Transparent: true},
+	209: {Hardness: -1, BlastResistance: 18000000, Transparent: true},
+	210: {Hardness: -1, BlastResistance: 18000000},
+	211: {Hardness: -1, BlastResistance: 18000000},
+	212: {Hardness: 0.5, BlastResistance: 2.5, Tool: ToolPickaxe, Transparent: true},
+	213: {Hardness: 0.5, BlastResistance: 2.5, Tool: ToolPickaxe, RequiresTool: true},
+	214: {Hardness: 1, BlastResistance: 5},
+	215: {Hardness: 2, BlastResistance: 30, Tool: ToolPickaxe, RequiresTool: true},
+	216: {Hardness: 2, BlastResistance: 10, Tool: ToolPickaxe, RequiresTool: true},
+	217: {Hardness: 0, BlastResistance: 0, Replaceable: true, Transparent: true},
+	218: {Hardness: 3, BlastResistance: 17.5, Tool: ToolPickaxe, RequiresTool: true},
+	219: {Hardness: 2, BlastResistance: 10, Tool: ToolPickaxe},
+	220: {Hardness: 2, BlastResistance: 10, Tool: ToolPickaxe},
+	221: {Hardness: 2, BlastResistance: 10, Tool: ToolPickaxe},
+	222: {Hardness: 2, BlastResistance: 10, Tool: ToolPickaxe},
+	223: {Hardness: 2, BlastResistance: 10, Tool: ToolPickaxe},
+	224: {Hardness: 2, BlastResistance: 10, Tool: ToolPickaxe},
+	225: {Hardness: 2, BlastResistance: 10, Tool: ToolPickaxe},
+	226: {Hardness: 2, BlastResistance: 10, Tool: ToolPickaxe},
+	227: {Hardness: 2, BlastResistance: 10, Tool: ToolPickaxe},
+	228: {Hardness: 2, BlastResistance: 10, Tool: ToolPickaxe},
+	229: {Hardness: 2, BlastResistance: 10, Tool: ToolPickaxe},
+	230: {Hardness: 2, BlastResistance: 10, Tool: ToolPickaxe},
+	231: {Hardness: 2, BlastResistance: 10, Tool: ToolPickaxe},
+	232: {Hardness: 2, BlastResistance: 10, Tool: ToolPickaxe},
+	233: {Hardness: 2, BlastResistance: 10, Tool: ToolPickaxe},
+	234: {Hardness: 2, BlastResistance: 10, Tool: ToolPickaxe},
+	235: {Hardness: 1.4, BlastResistance: 7, Tool: ToolPickaxe, RequiresTool: true},
+	236: {Hardness: 1.4, BlastResistance: 7, Tool: ToolPickaxe, RequiresTool: true},
+	237: {Hardness: 1.4, BlastResistance: 7, Tool: ToolPickaxe, RequiresTool: true},
+	238: {Hardness: 1.4, BlastResistance: 7, Tool: ToolPickaxe, RequiresTool: true},
+	239: {Hardness: 1.4, BlastResistance: 7, Tool: ToolPickaxe, RequiresTool: true},
+	240: {Hardness: 1.4, BlastResistance: 7, Tool: ToolPickaxe, RequiresTool: true},
+	241: {Hardness: 1.4, BlastResistance: 7, Tool: ToolPickaxe, RequiresTool: true},
+	242: {Hardness: 1.4, BlastResistance: 7, Tool: ToolPickaxe, RequiresTool: true},
+	243: {Hardness: 1.4, BlastResistance: 7, Tool: ToolPickaxe, RequiresTool: true},
+	244: {Hardness: 1.4, BlastResistance: 7, Tool: ToolPickaxe, RequiresTool: true},
+	245: {Hardness: 1.4, BlastResistance: 7, Tool: ToolPickaxe, RequiresTool: true},
+	246: {Hardness: 1.4, BlastResistance: 7, Tool: ToolPickaxe, RequiresTool: true},
+	247: {Hardness: 1.4, BlastResistance: 7, Tool: ToolPickaxe, RequiresTool: true},
+	248: {Hardness: 1.4, BlastResistance: 7, Tool: ToolPickaxe, RequiresTool: true},
+	249: {Hardness: 1.4, BlastResistance: 7, Tool: ToolPickaxe, RequiresTool: true},
+	250: {Hardness: 1.4, BlastResistance: 7, Tool: ToolPickaxe, RequiresTool: true},
+	251: {Hardness: 1.8, BlastResistance: 9, Tool: ToolPickaxe, RequiresTool: true},
+	252: {Hardness: 0.5, BlastResistance: 2.5, Tool: ToolShovel},
+	255: {Hardness: -1, BlastResistance: 18000000},
+}
+
+const shapeFromBelow = 0xFF
+
+// shapes contains the different collision boxes, shapes[0] is an empty block
+var shapes = [...][]maths.AABB{
+	{},
+	{{Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 1, Z: 1}}},
+	{{Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 0.5625, Z: 1}}},
+	{{Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 0.5, Z: 1}}},
+	{{Min: maths.Vector3{X: 0, Y: 0.5, Z: 0}, Max: maths.Vector3{X: 1, Y: 1, Z: 1}}},
+	{{Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 0.5, Z: 1}}, {Min: maths.Vector3{X: 0.5, Y: 0.5, Z: 0}, Max: maths.Vector3{X: 1, Y: 1, Z: 1}}},
+	{{Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 0.5, Z: 1}}, {Min: maths.Vector3{X: 0, Y: 0.5, Z: 0}, Max: maths.Vector3{X: 0.5, Y: 1, Z: 1}}},
+	{{Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 0.5, Z: 1}}, {Min: maths.Vector3{X: 0, Y: 0.5, Z: 0.5}, Max: maths.Vector3{X: 1, Y: 1, Z: 1}}},
+	{{Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 0.5, Z: 1}}, {Min: maths.Vector3{X: 0, Y: 0.5, Z: 0}, Max: maths.Vector3{X: 1, Y: 1, Z: 0.5}}},
+	{{Min: maths.Vector3{X: 0, Y: 0.5, Z: 0}, Max: maths.Vector3{X: 1, Y: 1, Z: 1}}, {Min: maths.Vector3{X: 0.5, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 0.5, Z: 1}}},
+	{{Min: maths.Vector3{X: 0, Y: 0.5, Z: 0}, Max: maths.Vector3{X: 1, Y: 1, Z: 1}}, {Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 0.5, Y: 0.5, Z: 1}}},
+	{{Min: maths.Vector3{X: 0, Y: 0.5, Z: 0}, Max: maths.Vector3{X: 1, Y: 1, Z: 1}}, {Min: maths.Vector3{X: 0, Y: 0, Z: 0.5}, Max: maths.Vector3{X: 1, Y: 0.5, Z: 1}}},
+	{{Min: maths.Vector3{X: 0, Y: 0.5, Z: 0}, Max: maths.Vector3{X: 1, Y: 1, Z: 1}}, {Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 0.5, Z: 0.5}}},
+	{{Min: maths.Vector3{X: 0.0625, Y: 0, Z: 0.0625}, Max: maths.Vector3{X: 0.9375, Y: 0.875, Z: 0.9375}}},
+	{{Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 0.9375, Z: 1}}},
+	{{Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 0.1875, Y: 1, Z: 1}}},
+	{{Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 1, Z: 0.1875}}},
+	{{Min: maths.Vector3{X: 0.8125, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 1, Z: 1}}},
+	{{Min: maths.Vector3{X: 0, Y: 0, Z: 0.8125}, Max: maths.Vector3{X: 1, Y: 1, Z: 1}}},
+	{{Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 0.125, Z: 1}}},
+	{{Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 0.25, Z: 1}}},
+	{{Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 0.375, Z: 1}}},
+	{{Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 0.625, Z: 1}}},
+	{{Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 0.75, Z: 1}}},
+	{{Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 0.875, Z: 1}}},
+	{{Min: maths.Vector3{X: 0.0625, Y: 0, Z: 0.0625}, Max: maths.Vector3{X: 0.9375, Y: 0.9375, Z: 0.9375}}},
+	{{Min: maths.Vector3{X: 0.375, Y: 0, Z: 0.375}, Max: maths.Vector3{X: 0.625, Y: 1.5, Z: 0.625}}},
+	{{Min: maths.Vector3{X: 0.0625, Y: 0, Z: 0.0625}, Max: maths.Vector3{X: 0.9375, Y: 0.5, Z: 0.9375}}},
+	{{Min: maths.Vector3{X: 0.1875, Y: 0, Z: 0.0625}, Max: maths.Vector3{X: 0.9375, Y: 0.5, Z: 0.9375}}},
+	{{Min: maths.Vector3{X: 0.3125, Y: 0, Z: 0.0625}, Max: maths.Vector3{X: 0.9375, Y: 0.5, Z: 0.9375}}},
+	{{Min: maths.Vector3{X: 0.4375, Y: 0, Z: 0.0625}, Max: maths.Vector3{X: 0.9375, Y: 0.5, Z: 0.9375}}},
+	{{Min: maths.Vector3{X: 0.5625, Y: 0, Z: 0.0625}, Max: maths.Vector3{X: 0.9375, Y: 0.5, Z: 0.9375}}},
+	{{Min: maths.Vector3{X: 0.6875, Y: 0, Z: 0.0625}, Max: maths.Vector3{X: 0.9375, Y: 0.5, Z: 0.9375}}},
+	{{Min: maths.Vector3{X: 0.8125, Y: 0, Z: 0.0625}, Max: maths.Vector3{X: 0.9375, Y: 0.5, Z: 0.9375}}},
+	{{Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 0.1875, Z: 1}}},
+	{{Min: maths.Vector3{X: 0, Y: 0.8125, Z: 0}, Max: maths.Vector3{X: 1, Y: 1, Z: 1}}},
+	{{Min: maths.Vector3{X: 0.4375, Y: 0, Z: 0.4375}, Max: maths.Vector3{X: 0.5625, Y: 1, Z: 0.5625}}},
+	{{Min: maths.Vector3{X: 0, Y: 0, Z: 0.375}, Max: maths.Vector3{X: 1, Y: 1.5, Z: 0.625}}},
+	{{Min: maths.Vector3{X: 0.375, Y: 0, Z: 0}, Max: maths.Vector3{X: 0.625, Y: 1.5, Z: 1}}},
+	{{Min: maths.Vector3{X: 0.0625, Y: 0, Z: 0.0625}, Max: maths.Vector3{X: 0.9375, Y: 0.09375, Z: 0.9375}}},
+	{{Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 0.125, Z: 1}}, {Min: maths.Vector3{X: 0.4375, Y: 0, Z: 0.4375}, Max: maths.Vector3{X: 0.5625, Y: 0.875, Z: 0.5625}}},
+	{{Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 0.3125, Z: 1}}, {Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 0.125, Y: 1, Z: 1}}, {Min: maths.Vector3{X: 0.875, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 1, Z: 1}}, {Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 1, Z: 0.125}}, {Min: maths.Vector3{X: 0, Y: 0, Z: 0.875}, Max: maths.Vector3{X: 1, Y: 1, Z: 1}}},
+	{{Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 0.8125, Z: 1}}},
+	{{Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 0.8125, Z: 1}}, {Min: maths.Vector3{X: 0.25, Y: 0.8125, Z: 0.25}, Max: maths.Vector3{X: 0.75, Y: 1, Z: 0.75}}},
+	{{Min: maths.Vector3{X: 0.0625, Y: 0, Z: 0.0625}, Max: maths.Vector3{X: 0.9375, Y: 1, Z: 0.9375}}},
+	{{Min: maths.Vector3{X: 0.25, Y: 0, Z: 0.25}, Max: maths.Vector3{X: 0.75, Y: 1.5, Z: 0.75}}},
+	{{Min: maths.Vector3{X: 0.3125, Y: 0, Z: 0.3125}, Max: maths.Vector3{X: 0.6875, Y: 0.375, Z: 0.6875}}},
+	{{Min: maths.Vector3{X: 0.25, Y: 0, Z: 0.25}, Max: maths.Vector3{X: 0.75, Y: 0.5, Z: 0.75}}},
+	{{Min: maths.Vector3{X: 0.125, Y: 0, Z: 0}, Max: maths.Vector3{X: 0.875, Y: 1, Z: 1}}},
+	{{Min: maths.Vector3{X: 0, Y: 0, Z: 0.125}, Max: maths.Vector3{X: 1, Y: 1, Z: 0.875}}},
+	{{Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 0.625, Z: 1}}, {Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 0.125, Y: 1, Z: 1}}, {Min: maths.Vector3{X: 0.875, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 1, Z: 1}}, {Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 1, Z: 0.125}}, {Min: maths.Vector3{X: 0, Y: 0, Z: 0.875}, Max: maths.Vector3{X: 1, Y: 1, Z: 1}}},
+	{{Min: maths.Vector3{X: 0, Y: 0, Z: 0}, Max: maths.Vector3{X: 1, Y: 0.0625, Z: 1}}},
+	{{Min: maths.Vector3{X: 0.375, Y: 0, Z: 0.375}, Max: maths.Vector3{X: 0.625, Y: 1, Z: 0.625}}},
+	{{Min: maths.Vector3{X: 0.375, Y: 0.375, Z: 0}, Max: maths.Vector3{X: 0.625, Y: 0.625, Z: 1}}},
+	{{Min: maths.Vector3{X: 0, Y: 0.375, Z: 0.375}, Max: maths.Vector3{X: 1, Y: 0.625, Z: 0.625}}},
+	{{Min: maths.Vector3{X: 0.1875, Y: 0.1875, Z: 0.1875}, Max: maths.Vector3{X: 0.8125, Y: 0.8125, Z: 0.8125}}},
+}
+
+// stateShapes contains the index in shapes of each state
+var stateShapes = [256 << 4]uint8{
+	0x010: 1,
+	0x011: 1,
+	0x012: 1,
+	0x013: 1,
+	0x014: 1,
+	0x015: 1,
+	0x016: 1,
+	0x020: 1,
+	0x030: 1,
+	0x031: 1,
+	0x032: 1,
+	0x040: 1,
+	0x050: 1,
+	0x051: 1,
+	0x052: 1,
+	0x053: 1,
+	0x054: 1,
+	0x055: 1,
+	0x070: 1,
+	0x0c0: 1,
+	0x0c1: 1,
+	0x0d0: 1,
+	0x0e0: 1,
+	0x0f0: 1,
+	0x100: 1,
+	0x110: 1,
+	0x111: 1,
+	0x112: 1,
+	0x113: 1,
+	0x114: 1,
+	0x115: 1,
+	0x116: 1,
+	0x117: 1,
+	0x118: 1,
+	0x119: 1,
+	0x11a: 1,
+	0x11b: 1,
+	0x11c: 1,
+	0x11d: 1,
+	0x11e: 1,
+	0x11f: 1,
+	0x120: 1,
+	0x121: 1,
+	0x122: 1,
+	0x123: 1,
+	0x124: 1,
+	0x125: 1,
+	0x126: 1,
+	0x127: 1,
+	0x128: 1,
+	0x129: 1,
+	0x12a: 1,
+	0x12b: 1,
+	0x12c: 1,
+	0x12d: 1,
+	0x12e: 1,
+	0x12f: 1,
+	0x130: 1,
+	0x131: 1,
+	0x140: 1,
+	0x150: 1,
+	0x160: 1,
+	0x170: 1,
+	0x171: 1,
+	0x172: 1,
+	0x173: 1,
+	0x174: 1,
+	0x175: 1,
+	0x178: 1,
+	0x179: 1,
+	0x17a: 1,
+	0x17b: 1,
+	0x17c: 1,
+	0x17d: 1,
+	0x180: 1,
+	0x181: 1,
+	0x182: 1,
+	0x190: 1,
+	0x1a0: 2,
+	0x1a1: 2,
+	0x1a2: 2,
+	0x1a3: 2,
+	0x1a8: 2,
+	0x1a9: 2,
+	0x1aa: 2,
+	0x1ab: 2,
+	0x1ac: 2,
+	0x1ad: 2,
+	0x1ae: 2,
+	0x1af: 2,
+	0x1d0: 1,
+	0x1d1: 1,
+	0x1d2: 1,
+	0x1d3: 1,
+	0x1d4: 1,
+	0x1d5: 1,
+	0x1d8: 1,
+	0x1d9: 1,
+	0x1da: 1,
+	0x1db: 1,
+	0x1dc: 1,
+	0x1dd: 1,
+	0x210: 1,
+	0x211: 1,
+	0x212: 1,
+	0x213: 1,
+	0x214: 1,
+	0x215: 1,
+	0x218: 1,
+	0x219: 1,
+	0x21a: 1,
+	0x21b: 1,
+	0x21c: 1,
+	0x21d: 1,
+	0x220: 1,
+	0x221: 1,
+	0x222: 1,
+	0x223: 1,
+	0x224: 1,
+	0x225: 1,
+	0x228: 1,
+	0x229: 1,
+	0x22a: 1,
+	0x22b: 1,
+	0x22c: 1,
+	0x22d: 1,
+	0x230: 1,
+	0x231: 1,
+	0x232: 1,
+	0x233: 1,
+	0x234: 1,
+	0x235: 1,
+	0x236: 1,
+	0x237: 1,
+	0x238: 1,
+	0x239: 1,
+	0x23a: 1,
+	0x23b: 1,
+	0x23c: 1,
+	0x23d: 1,
+	0x23e: 1,
+	0x23f: 1,
+	0x290: 1,
+	0x2a0: 1,
+	0x2b0: 1,
+	0x2b1: 1,
+	0x2b2: 1,
+	0x2b3: 1,
+	0x2b4: 1,
+	0x2b5: 1,
+	0x2b6: 1,
+	0x2b7: 1,
+	0x2b8: 1,
+	0x2b9: 1,
+	0x2ba: 1,
+	0x2bb: 1,
+	0x2bc: 1,
+	0x2bd: 1,
+	0x2be: 1,
+	0x2bf: 1,
+	0x2c0: 3,
+	0x2c1: 3,
+	0x2c2: 3,
+	0x2c3: 3,
+	0x2c4: 3,
+	0x2c5: 3,
+	0x2c6: 3,
+	0x2c7: 3,
+	0x2c8: 4,
+	0x2c9: 4,
+	0x2ca: 4,
+	0x2cb: 4,
+	0x2cc: 4,
+	0x2cd: 4,
+	0x2ce: 4,
+	0x2cf: 4,
+	0x2d0: 1,
+	0x2e0: 1,
+	0x2e1: 1,
+	0x2f0: 1,
+	0x300: 1,
+	0x310: 1,
+	0x340: 1,
+	0x350: 5,
+	0x351: 6,
+	0x352: 7,
+	0x353: 8,
+	0x354: 9,
+	0x355: 10,
+	0x356: 11,
+	0x357: 12,
+	0x362: 13,
+	0x363: 13,
+	0x364: 13,
+	0x365: 13,
+	0x380: 1,
+	0x390: 1,
+	0x3a0: 1,
+	0x3c0: 14,
+	0x3c1: 14,
+	0x3c2: 14,
+	0x3c3: 14,
+	0x3c4: 14,
+	0x3c5: 14,
+	0x3c6: 14,
+	0x3c7: 14,
+	0x3d2: 1,
+	0x3d3: 1,
+	0x3d4: 1,
+	0x3d5: 1,
+	0x3e2: 1,
+	0x3e3: 1,
+	0x3e4: 1,
+	0x3e5: 1,
+	0x400: 15,
+	0x401: 16,
+	0x402: 17,
+	0x403: 18,
+	0x404: 16,
+	0x405: 17,
+	0x406: 18,
+	0x407: 15,
+	0x408: 255,
+	0x409: 255,
+	0x40a: 255,
+	0x40b: 255,
+	0x412: 18,
+	0x413: 16,
+	0x414: 17,
+	0x415: 15,
+	0x430: 5,
+	0x431: 6,
+	0x432: 7,
+	0x433: 8,
+	0x434: 9,
+	0x435: 10,
+	0x436: 11,
+	0x437: 12,
+	0x470: 15,
+	0x471: 16,
+	0x472: 17,
+	0x473: 18,
+	0x474: 16,
+	0x475: 17,
+	0x476: 18,
+	0x477: 15,
+	0x478: 255,
+	0x479: 255,
+	0x47a: 255,
+	0x47b: 255,
+	0x490: 1,
+	0x4a0: 1,
+	0x4e1: 19,
+	0x4e2: 20,
+	0x4e3: 21,
+	0x4e4: 3,
+	0x4e5: 22,
+	0x4e6: 23,
+	0x4e7: 24,
+	0x4f0: 1,
+	0x500: 1,
+	0x510: 25,
+	0x511: 25,
+	0x512: 25,
+	0x513: 25,
+	0x514: 25,
+	0x515: 25,
+	0x516: 25,
+	0x517: 25,
+	0x518: 25,
+	0x519: 25,
+	0x51a: 25,
+	0x51b: 25,
+	0x51c: 25,
+	0x51d: 25,
+	0x51e: 25,
+	0x51f: 25,
+	0x520: 1,
+	0x540: 1,
+	0x541: 1,
+	0x550: 26,
+	0x560: 1,
+	0x561: 1,
+	0x562: 1,
+	0x563: 1,
+	0x570: 1,
+	0x580: 24,
+	0x590: 1,
+	0x5b0: 1,
+	0x5b1: 1,
+	0x5b2: 1,
+	0x5b3: 1,
+	0x5c0: 27,
+	0x5c1: 28,
+	0x5c2: 29,
+	0x5c3: 30,
+	0x5c4: 31,
+	0x5c5: 32,
+	0x5c6: 33,
+	0x5d0: 19,
+	0x5d1: 19,
+	0x5d2: 19,
+	0x5d3: 19,
+	0x5d4: 19,
+	0x5d5: 19,
+	0x5d6: 19,
+	0x5d7: 19,
+	0x5d8: 19,
+	0x5d9: 19,
+	0x5da: 19,
+	0x5db: 19,
+	0x5dc: 19,
+	0x5dd: 19,
+	0x5de: 19,
+	0x5df: 19,
+	0x5e0: 19,
+	0x5e1: 19,
+	0x5e2: 19,
+	0x5e3: 19,
+	0x5e4: 19,
+	0x5e5: 19,
+	0x5e6: 19,
+	0x5e7: 19,
+	0x5e8: 19,
+	0x5e9: 19,
+	0x5ea: 19,
+	0x5eb: 19,
+	0x5ec: 19,
+	0x5ed: 19,
+	0x5ee: 19,
+	0x5ef: 19,
+	0x5f0: 1,
+	0x5f1: 1,
+	0x5f2: 1,
+	0x5f3: 1,
+	0x5f4: 1,
+	0x5f5: 1,
+	0x5f6: 1,
+	0x5f7: 1,
+	0x5f8: 1,
+	0x5f9: 1,
+	0x5fa: 1,
+	0x5fb: 1,
+	0x5fc: 1,
+	0x5fd: 1,
+	0x5fe: 1,
+	0x5ff: 1,
+	0x600: 34,
+	0x601: 34,
+	0x602: 34,
+	0x603: 34,
+	0x604: 18,
+	0x605: 16,
+	0x606: 17,
+	0x607: 15,
+	0x608: 35,
+	0x609: 35,
+	0x60a: 35,
+	0x60b: 35,
+	0x60c: 18,
+	0x60d: 16,
+	0x60e: 17,
+	0x60f: 15,
+	0x610: 1,
+	0x611: 1,
+	0x612: 1,
+	0x613: 1,
+	0x614: 1,
+	0x615: 1,
+	0x620: 1,
+	0x621: 1,
+	0x622: 1,
+	0x623: 1,
+	0x630: 1,
+	0x631: 1,
+	0x632: 1,
+	0x633: 1,
+	0x634: 1,
+	0x635: 1,
+	0x636: 1,
+	0x637: 1,
+	0x638: 1,
+	0x639: 1,
+	0x63a: 1,
+	0x63e: 1,
+	0x63f: 1,
+	0x640: 1,
+	0x641: 1,
+	0x642: 1,
+	0x643: 1,
+	0x644: 1,
+	0x645: 1,
+	0x646: 1,
+	0x647: 1,
+	0x648: 1,
+	0x649: 1,
+	0x64a: 1,
+	0x64e: 1,
+	0x64f: 1,
+	0x650: 36,
+	0x660: 36,
+	0x670: 1,
+	0x6b0: 37,
+	0x6b1: 38,
+	0x6b2: 37,
+	0x6b3: 38,
+	0x6b8: 37,
+	0x6b9: 38,
+	0x6ba: 37,
+	0x6bb: 38,
+	0x6c0: 5,
+	0x6c1: 6,
+	0x6c2: 7,
+	0x6c3: 8,
+	0x6c4: 9,
+	0x6c5: 10,
+	0x6c6: 11,
+	0x6c7: 12,
+	0x6d0: 5,
+	0x6d1: 6,
+	0x6d2: 7,
+	0x6d3: 8,
+	0x6d4: 9,
+	0x6d5: 10,
+	0x6d6: 11,
+	0x6d7: 12,
+	0x6e0: 1,
+	0x6f0: 39,
+	0x700: 1,
+	0x710: 26,
+	0x720: 5,
+	0x721: 6,
+	0x722: 7,
+	0x723: 8,
+	0x724: 9,
+	0x725: 10,
+	0x726: 11,
+	0x727: 12,
+	0x740: 23,
+	0x750: 40,
+	0x751: 40,
+	0x752: 40,
+	0x753: 40,
+	0x754: 40,
+	0x755: 40,
+	0x756: 40,
+	0x757: 40,
+	0x760: 41,
+	0x761: 41,
+	0x762: 41,
+	0x763: 41,
+	0x780: 42,
+	0x781: 42,
+	0x782: 42,
+	0x783: 42,
+	0x784: 43,
+	0x785: 43,
+	0x786: 43,
+	0x787: 43,
+	0x790: 1,
+	0x7a0: 44,
+	0x7b0: 1,
+	0x7c0: 1,
+	0x7d0: 1,
+	0x7d1: 1,
+	0x7d2: 1,
+	0x7d3: 1,
+	0x7d4: 1,
+	0x7d5: 1,
+	0x7e0: 3,
+	0x7e1: 3,
+	0x7e2: 3,
+	0x7e3: 3,
+	0x7e4: 3,
+	0x7e5: 3,
+	0x7e8: 4,
+	0x7e9: 4,
+	0x7ea: 4,
+	0x7eb: 4,
+	0x7ec: 4,
+	0x7ed: 4,
+	0x800: 5,
+	0x801: 6,
+	0x802: 7,
+	0x803: 8,
+	0x804: 9,
+	0x805: 10,
+	0x806: 11,
+	0x807: 12,
+	0x810: 1,
+	0x822: 13,
+	0x823: 13,
+	0x824: 13,
+	0x825: 13,
+	0x850: 1,
+	0x860: 5,
+	0x861: 6,
+	0x862: 7,
+	0x863: 8,
+	0x864: 9,
+	0x865: 10,
+	0x866: 11,
+	0x867: 12,
+	0x870: 5,
+	0x871: 6,
+	0x872: 7,
+	0x873: 8,
+	0x874: 9,
+	0x875: 10,
+	0x876: 11,
+	0x877: 12,
+	0x880: 5,
+	0x881: 6,
+	0x882: 7,
+	0x883: 8,
+	0x884: 9,
+	0x885: 10,
+	0x886: 11,
+	0x887: 12,
+	0x890: 1,
+	0x891: 1,
+	0x892: 1,
+	0x893: 1,
+	0x894: 1,
+	0x895: 1,
+	0x898: 1,
+	0x899: 1,
+	0x89a: 1,
+	0x89b: 1,
+	0x89c: 1,
+	0x89d: 1,
+	0x8a0: 1,
+	0x8b0: 45,
+	0x8b1: 45,
+	0x8c0: 46,
+	0x8c1: 46,
+	0x8c2: 46,
+	0x8c3: 46,
+	0x8c4: 46,
+	0x8c5: 46,
+	0x8c6: 46,
+	0x8c7: 46,
+	0x8c8: 46,
+	0x8c9: 46,
+	0x8ca: 46,
+	0x8cb: 46,
+	0x8cc: 46,
+	0x8cd: 46,
+	0x8ce: 46,
+	0x8cf: 46,
+	0x901: 47,
+	0x902: 47,
+	0x903: 47,
+	0x904: 47,
+	0x905: 47,
+	0x909: 47,
+	0x90a: 47,
+	0x90b: 47,
+	0x90c: 47,
+	0x90d: 47,
+	0x910: 48,
+	0x911: 49,
+	0x912: 48,
+	0x913: 49,
+	0x914: 48,
+	0x915: 49,
+	0x916: 48,
+	0x917: 49,
+	0x918: 48,
+	0x919: 49,
+	0x91a: 48,
+	0x91b: 49,
+	0x922: 13,
+	0x923: 13,
+	0x924: 13,
+	0x925: 13,
+	0x950: 19,
+	0x951: 19,
+	0x952: 19,
+	0x953: 19,
+	0x954: 19,
+	0x955: 19,
+	0x956: 19,
+	0x957: 19,
+	0x958: 19,
+	0x959: 19,
+	0x95a: 19,
+	0x95b: 19,
+	0x95c: 19,
+	0x95d: 19,
+	0x95e: 19,
+	0x95f: 19,
+	0x960: 19,
+	0x961: 19,
+	0x962: 19,
+	0x963: 19,
+	0x964: 19,
+	0x965: 19,
+	0x966: 19,
+	0x967: 19,
+	0x968: 19,
+	0x969: 19,
+	0x96a: 19,
+	0x96b: 19,
+	0x96c: 19,
+	0x96d: 19,
+	0x96e: 19,
+	0x96f: 19,
+	0x970: 21,
+	0x971: 21,
+	0x972: 21,
+	0x973: 21,
+	0x974: 21,
+	0x975: 21,
+	0x976: 21,
+	0x977: 21,
+	0x978: 21,
+	0x979: 21,
+	0x97a: 21,
+	0x97b: 21,
+	0x97c: 21,
+	0x97d: 21,
+	0x97e: 21,
+	0x97f: 21,
+	0x980: 1,
+	0x990: 1,
+	0x9a0: 50,
+	0x9a2: 50,
+	0x9a3: 50,
+	0x9a4: 50,
+	0x9a5: 50,
+	0x9a8: 50,
+	0x9aa: 50,
+	0x9ab: 50,
+	0x9ac: 50,
+	0x9ad: 50,
+	0x9b0: 1,
+	0x9b1: 1,
+	0x9b2: 1,
+	0x9b3: 1,
+	0x9b4: 1,
+	0x9c0: 5,
+	0x9c1: 6,
+	0x9c2: 7,
+	0x9c3: 8,
+	0x9c4: 9,
+	0x9c5: 10,
+	0x9c6: 11,
+	0x9c7: 12,
+	0x9e0: 1,
+	0x9e1: 1,
+	0x9e2: 1,
+	0x9e3: 1,
+	0x9e4: 1,
+	0x9e5: 1,
+	0x9e8: 1,
+	0x9e9: 1,
+	0x9ea: 1,
+	0x9eb: 1,
+	0x9ec: 1,
+	0x9ed: 1,
+	0x9f0: 1,
+	0x9f1: 1,
+	0x9f2: 1,
+	0x9f3: 1,
+	0x9f4: 1,
+	0x9f5: 1,
+	0x9f6: 1,
+	0x9f7: 1,
+	0x9f8: 1,
+	0x9f9: 1,
+	0x9fa: 1,
+	0x9fb: 1,
+	0x9fc: 1,
+	0x9fd: 1,
+	0x9fe: 1,
+	0x9ff: 1,
+	0xa00: 36,
+	0xa01: 36,
+	0xa02: 36,
+	0xa03: 36,
+	0xa04: 36,
+	0xa05: 36,
+	0xa06: 36,
+	0xa07: 36,
+	0xa08: 36,
+	0xa09: 36,
+	0xa0a: 36,
+	0xa0b: 36,
+	0xa0c: 36,
+	0xa0d: 36,
+	0xa0e: 36,
+	0xa0f: 36,
+	0xa10: 1,
+	0xa11: 1,
+	0xa14: 1,
+	0xa15: 1,
+	0xa18: 1,
+	0xa19: 1,
+	0xa1c: 1,
+	0xa1d: 1,
+	0xa20: 1,
+	0xa21: 1,
+	0xa24: 1,
+	0xa25: 1,
+	0xa28: 1,
+	0xa29: 1,
+	0xa2c: 1,
+	0xa2d: 1,
+	0xa30: 5,
+	0xa31: 6,
+	0xa32: 7,
+	0xa33: 8,
+	0xa34: 9,
+	0xa35: 10,
+	0xa36: 11,
+	0xa37: 12,
+	0xa40: 5,
+	0xa41: 6,
+	0xa42: 7,
+	0xa43: 8,
+	0xa44: 9,
+	0xa45: 10,
+	0xa46: 11,
+	0xa47: 12,
+	0xa50: 1,
+	0xa60: 1,
+	0xa70: 34,
+	0xa71: 34,
+	0xa72: 34,
+	0xa73: 34,
+	0xa74: 18,
+	0xa75: 16,
+	0xa76: 17,
+	0xa77: 15,
+	0xa78: 35,
+	0xa79: 35,
+	0xa7a: 35,
+	0xa7b: 35,
+	0xa7c: 18,
+	0xa7d: 16,
+	0xa7e: 17,
+	0xa7f: 15,
+	0xa80: 1,
+	0xa81: 1,
+	0xa82: 1,
+	0xa90: 1,
+	0xaa0: 1,
+	0xaa4: 1,
+	0xaa8: 1,
+	0xab0: 51,
+	0xab1: 51,
+	0xab2: 51,
+	0xab3: 51,
+	0xab4: 51,
+	0xab5: 51,
+	0xab6: 51,
+	0xab7: 51,
+	0xab8: 51,
+	0xab9: 51,
+	0xaba: 51,
+	0xabb: 51,
+	0xabc: 51,
+	0xabd: 51,
+	0xabe: 51,
+	0xabf: 51,
+	0xac0: 1,
+	0xad0: 1,
+	0xae0: 1,
+	0xb20: 21,
+	0xb21: 21,
+	0xb22: 21,
+	0xb23: 21,
+	0xb24: 21,
+	0xb25: 21,
+	0xb26: 21,
+	0xb27: 21,
+	0xb28: 21,
+	0xb29: 21,
+	0xb2a: 21,
+	0xb2b: 21,
+	0xb2c: 21,
+	0xb2d: 21,
+	0xb2e: 21,
+	0xb2f: 21,
+	0xb30: 1,
+	0xb31: 1,
+	0xb32: 1,
+	0xb40: 5,
+	0xb41: 6,
+	0xb42: 7,
+	0xb43: 8,
+	0xb44: 9,
+	0xb45: 10,
+	0xb46: 11,
+	0xb47: 12,
+	0xb50: 1,
+	0xb58: 1,
+	0xb60: 3,
+	0xb68: 4,
+	0xb70: 37,
+	0xb71: 38,
+	0xb72: 37,
+	0xb73: 38,
+	0xb78: 37,
+	0xb79: 38,
+	0xb7a: 37,
+	0xb7b: 38,
+	0xb80: 37,
+	0xb81: 38,
+	0xb82: 37,
+	0xb83: 38,
+	0xb88: 37,
+	0xb89: 38,
+	0xb8a: 37,
+	0xb8b: 38,
+	0xb90: 37,
+	0xb91: 38,
+	0xb92: 37,
+	0xb93: 38,
+	0xb98: 37,
+	0xb99: 38,
+	0xb9a: 37,
+	0xb9b: 38,
+	0xba0: 37,
+	0xba1: 38,
+	0xba2: 37,
+	0xba3: 38,
+	0xba8: 37,
+	0xba9: 38,
+	0xbaa: 37,
+	0xbab: 38,
+	0xbb0: 37,
+	0xbb1: 38,
+	0xbb2: 37,
+	0xbb3: 38,
+	0xbb8: 37,
+	0xbb9: 38,
+	0xbba: 37,
+	0xbbb: 38,
+	0xbc0: 26,
+	0xbd0: 26,
+	0xbe0: 26,
+	0xbf0: 26,
+	0xc00: 26,
+	0xc10: 15,
+	0xc11: 16,
+	0xc12: 17,
+	0xc13: 18,
+	0xc14: 16,
+	0xc15: 17,
+	0xc16: 18,
+	0xc17: 15,
+	0xc18: 255,
+	0xc19: 255,
+	0xc1a: 255,
+	0xc1b: 255,
+	0xc20: 15,
+	0xc21: 16,
+	0xc22: 17,
+	0xc23: 18,
+	0xc24: 16,
+	0xc25: 17,
+	0xc26: 18,
+	0xc27: 15,
+	0xc28: 255,
+	0xc29: 255,
+	0xc2a: 255,
+	0xc2b: 255,
+	0xc30: 15,
+	0xc31: 16,
+	0xc32: 17,
+	0xc33: 18,
+	0xc34: 16,
+	0xc35: 17,
+	0xc36: 18,
+	0xc37: 15,
+	0xc38: 255,
+	0xc39: 255,
+	0xc3a: 255,
+	0xc3b: 255,
+	0xc40: 15,
+	0xc41: 16,
+	0xc42: 17,
+	0xc43: 18,
+	0xc44: 16,
+	0xc45: 17,
+	0xc46: 18,
+	0xc47: 15,
+	0xc48: 255,
+	0xc49: 255,
+	0xc4a: 255,
+	0xc4b: 255,
+	0xc50: 15,
+	0xc51: 16,
+	0xc52: 17,
+	0xc53: 18,
+	0xc54: 16,
+	0xc55: 17,
+	0xc56: 18,
+	0xc57: 15,
+	0xc58: 255,
+	0xc59: 255,
+	0xc5a: 255,
+	0xc5b: 255,
+	0xc60: 52,
+	0xc61: 52,
+	0xc62: 53,
+	0xc63: 53,
+	0xc64: 54,
+	0xc65: 54,
+	0xc70: 55,
+	0xc80: 1,
+	0xc81: 1,
+	0xc82: 1,
+	0xc83: 1,
+	0xc84: 1,
+	0xc85: 1,
+	0xc90: 1,
+	0xca0: 1,
+	0xca4: 1,
+	0xca8: 1,
+	0xcb0: 5,
+	0xcb1: 6,
+	0xcb2: 7,
+	0xcb3: 8,
+	0xcb4: 9,
+	0xcb5: 10,
+	0xcb6: 11,
+	0xcb7: 12,
+	0xcc0: 1,
+	0xcd0: 3,
+	0xcd8: 4,
+	0xce0: 1,
+	0xd00: 14,
+	0xd20: 1,
+	0xd21: 1,
+	0xd22: 1,
+	0xd23: 1,
+	0xd24: 1,
+	0xd25: 1,
+	0xd28: 1,
+	0xd29: 1,
+	0xd2a: 1,
+	0xd2b: 1,
+	0xd2c: 1,
+	0xd2d: 1,
+	0xd30: 1,
+	0xd31: 1,
+	0xd32: 1,
+	0xd33: 1,
+	0xd34: 1,
+	0xd35: 1,
+	0xd38: 1,
+	0xd39: 1,
+	0xd3a: 1,
+	0xd3b: 1,
+	0xd3c: 1,
+	0xd3d: 1,
+	0xd40: 1,
+	0xd41: 1,
+	0xd42: 1,
+	0xd43: 1,
+	0xd50: 1,
+	0xd60: 1,
+	0xd70: 1,
+	0xd80: 1,
+	0xd84: 1,
+	0xd88: 1,
+	0xda0: 1,
+	0xda1: 1,
+	0xda2: 1,
+	0xda3: 1,
+	0xda4: 1,
+	0xda5: 1,
+	0xda8: 1,
+	0xda9: 1,
+	0xdaa: 1,
+	0xdab: 1,
+	0xdac: 1,
+	0xdad: 1,
+	0xdb0: 1,
+	0xdb1: 1,
+	0xdb2: 1,
+	0xdb3: 1,
+	0xdb4: 1,
+	0xdb5: 1,
+	0xdc0: 1,
+	0xdc1: 1,
+	0xdc2: 1,
+	0xdc3: 1,
+	0xdc4: 1,
+	0xdc5: 1,
+	0xdd0: 1,
+	0xdd1: 1,
+	0xdd2: 1,
+	0xdd3: 1,
+	0xdd4: 1,
+	0xdd5: 1,
+	0xde0: 1,
+	0xde1: 1,
+	0xde2: 1,
+	0xde3: 1,
+	0xde4: 1,
+	0xde5: 1,
+	0xdf0: 1,
+	0xdf1: 1,
+	0xdf2: 1,
+	0xdf3: 1,
+	0xdf4: 1,
+	0xdf5: 1,
+	0xe00: 1,
+	0xe01: 1,
+	0xe02: 1,
+	0xe03: 1,
+	0xe04: 1,
+	0xe05: 1,
+	0xe10: 1,
+	0xe11: 1,
+	0xe12: 1,
+	0xe13: 1,
+	0xe14: 1,
+	0xe15: 1,
+	0xe20: 1,
+	0xe21: 1,
+	0xe22: 1,
+	0xe23: 1,
+	0xe24: 1,
+	0xe25: 1,
+	0xe30: 1,
+	0xe31: 1,
+	0xe32: 1,
+	0xe33: 1,
+	0xe34: 1,
+	0xe35: 1,
+	0xe40: 1,
+	0xe41: 1,
+	0xe42: 1,
+	0xe43: 1,
+	0xe44: 1,
+	0xe45: 1,
+	0xe50: 1,
+	0xe51: 1,
+	0xe52: 1,
+	0xe53: 1,
+	0xe54: 1,
+	0xe55: 1,
+	0xe60: 1,
+	0xe61: 1,
+	0xe62: 1,
+	0xe63: 1,
+	0xe64: 1,
+	0xe65: 1,
+	0xe70: 1,
+	0xe71: 1,
+	0xe72: 1,
+	0xe73: 1,
+	0xe74: 1,
+	0xe75: 1,
+	0xe80: 1,
+	0xe81: 1,
+	0xe82: 1,
+	0xe83: 1,
+	0xe84: 1,
+	0xe85: 1,
+	0xe90: 1,
+	0xe91: 1,
+	0xe92: 1,
+	0xe93: 1,
+	0xe94: 1,
+	0xe95: 1,
+	0xea0: 1,
+	0xea1: 1,
+	0xea2: 1,
+	0xea3: 1,
+	0xea4: 1,
+	0xea5: 1,
+	0xeb0: 1,
+	0xeb1: 1,
+	0xeb2: 1,
+	0xeb3: 1,
+	0xec0: 1,
+	0xec1: 1,
+	0xec2: 1,
+	0xec3: 1,
+	0xed0: 1,
+	0xed1: 1,
+	0xed2: 1,
+	0xed3: 1,
+	0xee0: 1,
+	0xee1: 1,
+	0xee2: 1,
+	0xee3: 1,
+	0xef0: 1,
+	0xef1: 1,
+	0xef2: 1,
+	0xef3: 1,
+	0xf00: 1,
+	0xf01: 1,
+	0xf02: 1,
+	0xf03: 1,
+	0xf10: 1,
+	0xf11: 1,
+	0xf12: 1,
+	0xf13: 1,
+	0xf20: 1,
+	0xf21: 1,
+	0xf22: 1,
+	0xf23: 1,
+	0xf30: 1,
+	0xf31: 1,
+	0xf32: 1,
+	0xf33: 1,
+	0xf40: 1,
+	0xf41: 1,
+	0xf42: 1,
+	0xf43: 1,
+	0xf50: 1,
+	0xf51: 1,
+	0xf52: 1,
+	0xf53: 1,
+	0xf60: 1,
+	0xf61: 1,
+	0xf62: 1,
+	0xf63: 1,
+	0xf70: 1,
+	0xf71: 1,
+	0xf72: 1,
+	0xf73: 1,
+	0xf80: 1,
+	0xf81: 1,
+	0xf82: 1,
+	0xf83: 1,
+	0xf90: 1,
+	0xf91: 1,
+	0xf92: 1,
+	0xf93: 1,
+	0xfa0: 1,
+	0xfa1: 1,
+	0xfa2: 1,
+	0xfa3: 1,
+	0xfb0: 1,
+	0xfb1: 1,
+	0xfb2: 1,
+	0xfb3: 1,
+	0xfb4: 1,
+	0xfb5: 1,
+	0xfb6: 1,
+	0xfb7: 1,
+	0xfb8: 1,
+	0xfb9: 1,
+	0xfba: 1,
+	0xfbb: 1,
+	0xfbc: 1,
+	0xfbd: 1,
+	0xfbe: 1,
+	0xfbf: 1,
+	0xfc0: 1,
+	0xfc1: 1,
+	0xfc2: 1,
+	0xfc3: 1,
+	0xfc4: 1,
+	0xfc5: 1,
+	0xfc6: 1,
+	0xfc7: 1,
+	0xfc8: 1,
+	0xfc9: 1,
+	0xfca: 1,
+	0xfcb: 1,
+	0xfcc: 1,
+	0xfcd: 1,
+	0xfce: 1,
+	0xfcf: 1,
+	0xff0: 1,
+	0xff1: 1,
+	0xff2: 1,
+	0xff3: 1,
+}
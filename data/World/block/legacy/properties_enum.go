@@ -0,0 +1,1286 @@
+// Code generated by generator/main.go; DO NOT EDIT.
+package legacy
+
+import (
+	"errors"
+	"strconv"
+)
+
+type Color byte
+
+const (
+	ColorWhite Color = iota
+	ColorOrange
+	ColorMagenta
+	ColorLightBlue
+	ColorYellow
+	ColorLime
+	ColorPink
+	ColorGray
+	ColorSilver
+	ColorCyan
+	ColorPurple
+	ColorBlue
+	ColorBrown
+	ColorGreen
+	ColorRed
+	ColorBlack
+)
+
+var strColor = [...]string{"white", "orange", "magenta", "light_blue", "yellow", "lime", "pink", "gray", "silver", "cyan", "purple", "blue", "brown", "green", "red", "black"}
+
+func (c Color) String() string {
+	if int(c) < len(strColor) {
+		return strColor[c]
+	}
+	return "invalid Color"
+}
+
+func (c Color) MarshalText() (text []byte, err error) {
+	if int(c) < len(strColor) {
+		return []byte(strColor[c]), nil
+	}
+	return nil, errors.New("invalid Color: " + strconv.Itoa(int(c)))
+}
+
+func (c *Color) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "white":
+		*c = ColorWhite
+	case "orange":
+		*c = ColorOrange
+	case "magenta":
+		*c = ColorMagenta
+	case "light_blue":
+		*c = ColorLightBlue
+	case "yellow":
+		*c = ColorYellow
+	case "lime":
+		*c = ColorLime
+	case "pink":
+		*c = ColorPink
+	case "gray":
+		*c = ColorGray
+	case "silver":
+		*c = ColorSilver
+	case "cyan":
+		*c = ColorCyan
+	case "purple":
+		*c = ColorPurple
+	case "blue":
+		*c = ColorBlue
+	case "brown":
+		*c = ColorBrown
+	case "green":
+		*c = ColorGreen
+	case "red":
+		*c = ColorRed
+	case "black":
+		*c = ColorBlack
+	default:
+		return errors.New("unknown Color: " + str)
+	}
+	return nil
+}
+
+type WoodType byte
+
+const (
+	WoodTypeOak WoodType = iota
+	WoodTypeSpruce
+	WoodTypeBirch
+	WoodTypeJungle
+	WoodTypeAcacia
+	WoodTypeDarkOak
+)
+
+var strWoodType = [...]string{"oak", "spruce", "birch", "jungle", "acacia", "dark_oak"}
+
+func (w WoodType) String() string {
+	if int(w) < len(strWoodType) {
+		return strWoodType[w]
+	}
+	return "invalid WoodType"
+}
+
+func (w WoodType) MarshalText() (text []byte, err error) {
+	if int(w) < len(strWoodType) {
+		return []byte(strWoodType[w]), nil
+	}
+	return nil, errors.New("invalid WoodType: " + strconv.Itoa(int(w)))
+}
+
+func (w *WoodType) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "oak":
+		*w = WoodTypeOak
+	case "spruce":
+		*w = WoodTypeSpruce
+	case "birch":
+		*w = WoodTypeBirch
+	case "jungle":
+		*w = WoodTypeJungle
+	case "acacia":
+		*w = WoodTypeAcacia
+	case "dark_oak":
+		*w = WoodTypeDarkOak
+	default:
+		return errors.New("unknown WoodType: " + str)
+	}
+	return nil
+}
+
+type Facing byte
+
+const (
+	FacingDown Facing = iota
+	FacingUp
+	FacingNorth
+	FacingSouth
+	FacingWest
+	FacingEast
+)
+
+var strFacing = [...]string{"down", "up", "north", "south", "west", "east"}
+
+func (f Facing) String() string {
+	if int(f) < len(strFacing) {
+		return strFacing[f]
+	}
+	return "invalid Facing"
+}
+
+func (f Facing) MarshalText() (text []byte, err error) {
+	if int(f) < len(strFacing) {
+		return []byte(strFacing[f]), nil
+	}
+	return nil, errors.New("invalid Facing: " + strconv.Itoa(int(f)))
+}
+
+func (f *Facing) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "down":
+		*f = FacingDown
+	case "up":
+		*f = FacingUp
+	case "north":
+		*f = FacingNorth
+	case "south":
+		*f = FacingSouth
+	case "west":
+		*f = FacingWest
+	case "east":
+		*f = FacingEast
+	default:
+		return errors.New("unknown Facing: " + str)
+	}
+	return nil
+}
+
+type Axis byte
+
+const (
+	AxisX Axis = iota
+	AxisY
+	AxisZ
+)
+
+var strAxis = [...]string{"x", "y", "z"}
+
+func (a Axis) String() string {
+	if int(a) < len(strAxis) {
+		return strAxis[a]
+	}
+	return "invalid Axis"
+}
+
+func (a Axis) MarshalText() (text []byte, err error) {
+	if int(a) < len(strAxis) {
+		return []byte(strAxis[a]), nil
+	}
+	return nil, errors.New("invalid Axis: " + strconv.Itoa(int(a)))
+}
+
+func (a *Axis) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "x":
+		*a = AxisX
+	case "y":
+		*a = AxisY
+	case "z":
+		*a = AxisZ
+	default:
+		return errors.New("unknown Axis: " + str)
+	}
+	return nil
+}
+
+type LogAxis byte
+
+const (
+	LogAxisX LogAxis = iota
+	LogAxisY
+	LogAxisZ
+	LogAxisNone
+)
+
+var strLogAxis = [...]string{"x", "y", "z", "none"}
+
+func (l LogAxis) String() string {
+	if int(l) < len(strLogAxis) {
+		return strLogAxis[l]
+	}
+	return "invalid LogAxis"
+}
+
+func (l LogAxis) MarshalText() (text []byte, err error) {
+	if int(l) < len(strLogAxis) {
+		return []byte(strLogAxis[l]), nil
+	}
+	return nil, errors.New("invalid LogAxis: " + strconv.Itoa(int(l)))
+}
+
+func (l *LogAxis) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "x":
+		*l = LogAxisX
+	case "y":
+		*l = LogAxisY
+	case "z":
+		*l = LogAxisZ
+	case "none":
+		*l = LogAxisNone
+	default:
+		return errors.New("unknown LogAxis: " + str)
+	}
+	return nil
+}
+
+type Half byte
+
+const (
+	HalfTop Half = iota
+	HalfBottom
+)
+
+var strHalf = [...]string{"top", "bottom"}
+
+func (h Half) String() string {
+	if int(h) < len(strHalf) {
+		return strHalf[h]
+	}
+	return "invalid Half"
+}
+
+func (h Half) MarshalText() (text []byte, err error) {
+	if int(h) < len(strHalf) {
+		return []byte(strHalf[h]), nil
+	}
+	return nil, errors.New("invalid Half: " + strconv.Itoa(int(h)))
+}
+
+func (h *Half) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "top":
+		*h = HalfTop
+	case "bottom":
+		*h = HalfBottom
+	default:
+		return errors.New("unknown Half: " + str)
+	}
+	return nil
+}
+
+type DoubleBlockHalf byte
+
+const (
+	DoubleBlockHalfUpper DoubleBlockHalf = iota
+	DoubleBlockHalfLower
+)
+
+var strDoubleBlockHalf = [...]string{"upper", "lower"}
+
+func (d DoubleBlockHalf) String() string {
+	if int(d) < len(strDoubleBlockHalf) {
+		return strDoubleBlockHalf[d]
+	}
+	return "invalid DoubleBlockHalf"
+}
+
+func (d DoubleBlockHalf) MarshalText() (text []byte, err error) {
+	if int(d) < len(strDoubleBlockHalf) {
+		return []byte(strDoubleBlockHalf[d]), nil
+	}
+	return nil, errors.New("invalid DoubleBlockHalf: " + strconv.Itoa(int(d)))
+}
+
+func (d *DoubleBlockHalf) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "upper":
+		*d = DoubleBlockHalfUpper
+	case "lower":
+		*d = DoubleBlockHalfLower
+	default:
+		return errors.New("unknown DoubleBlockHalf: " + str)
+	}
+	return nil
+}
+
+type Hinge byte
+
+const (
+	HingeLeft Hinge = iota
+	HingeRight
+)
+
+var strHinge = [...]string{"left", "right"}
+
+func (h Hinge) String() string {
+	if int(h) < len(strHinge) {
+		return strHinge[h]
+	}
+	return "invalid Hinge"
+}
+
+func (h Hinge) MarshalText() (text []byte, err error) {
+	if int(h) < len(strHinge) {
+		return []byte(strHinge[h]), nil
+	}
+	return nil, errors.New("invalid Hinge: " + strconv.Itoa(int(h)))
+}
+
+func (h *Hinge) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "left":
+		*h = HingeLeft
+	case "right":
+		*h = HingeRight
+	default:
+		return errors.New("unknown Hinge: " + str)
+	}
+	return nil
+}
+
+type BedPart byte
+
+const (
+	BedPartHead BedPart = iota
+	BedPartFoot
+)
+
+var strBedPart = [...]string{"head", "foot"}
+
+func (b BedPart) String() string {
+	if int(b) < len(strBedPart) {
+		return strBedPart[b]
+	}
+	return "invalid BedPart"
+}
+
+func (b BedPart) MarshalText() (text []byte, err error) {
+	if int(b) < len(strBedPart) {
+		return []byte(strBedPart[b]), nil
+	}
+	return nil, errors.New("invalid BedPart: " + strconv.Itoa(int(b)))
+}
+
+func (b *BedPart) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "head":
+		*b = BedPartHead
+	case "foot":
+		*b = BedPartFoot
+	default:
+		return errors.New("unknown BedPart: " + str)
+	}
+	return nil
+}
+
+type PistonType byte
+
+const (
+	PistonTypeNormal PistonType = iota
+	PistonTypeSticky
+)
+
+var strPistonType = [...]string{"normal", "sticky"}
+
+func (p PistonType) String() string {
+	if int(p) < len(strPistonType) {
+		return strPistonType[p]
+	}
+	return "invalid PistonType"
+}
+
+func (p PistonType) MarshalText() (text []byte, err error) {
+	if int(p) < len(strPistonType) {
+		return []byte(strPistonType[p]), nil
+	}
+	return nil, errors.New("invalid PistonType: " + strconv.Itoa(int(p)))
+}
+
+func (p *PistonType) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "normal":
+		*p = PistonTypeNormal
+	case "sticky":
+		*p = PistonTypeSticky
+	default:
+		return errors.New("unknown PistonType: " + str)
+	}
+	return nil
+}
+
+type ComparatorMode byte
+
+const (
+	ComparatorModeCompare ComparatorMode = iota
+	ComparatorModeSubtract
+)
+
+var strComparatorMode = [...]string{"compare", "subtract"}
+
+func (c ComparatorMode) String() string {
+	if int(c) < len(strComparatorMode) {
+		return strComparatorMode[c]
+	}
+	return "invalid ComparatorMode"
+}
+
+func (c ComparatorMode) MarshalText() (text []byte, err error) {
+	if int(c) < len(strComparatorMode) {
+		return []byte(strComparatorMode[c]), nil
+	}
+	return nil, errors.New("invalid ComparatorMode: " + strconv.Itoa(int(c)))
+}
+
+func (c *ComparatorMode) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "compare":
+		*c = ComparatorModeCompare
+	case "subtract":
+		*c = ComparatorModeSubtract
+	default:
+		return errors.New("unknown ComparatorMode: " + str)
+	}
+	return nil
+}
+
+type StructureMode byte
+
+const (
+	StructureModeSave StructureMode = iota
+	StructureModeLoad
+	StructureModeCorner
+	StructureModeData
+)
+
+var strStructureMode = [...]string{"save", "load", "corner", "data"}
+
+func (s StructureMode) String() string {
+	if int(s) < len(strStructureMode) {
+		return strStructureMode[s]
+	}
+	return "invalid StructureMode"
+}
+
+func (s StructureMode) MarshalText() (text []byte, err error) {
+	if int(s) < len(strStructureMode) {
+		return []byte(strStructureMode[s]), nil
+	}
+	return nil, errors.New("invalid StructureMode: " + strconv.Itoa(int(s)))
+}
+
+func (s *StructureMode) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "save":
+		*s = StructureModeSave
+	case "load":
+		*s = StructureModeLoad
+	case "corner":
+		*s = StructureModeCorner
+	case "data":
+		*s = StructureModeData
+	default:
+		return errors.New("unknown StructureMode: " + str)
+	}
+	return nil
+}
+
+type RailShape byte
+
+const (
+	RailShapeNorthSouth RailShape = iota
+	RailShapeEastWest
+	RailShapeAscendingEast
+	RailShapeAscendingWest
+	RailShapeAscendingNorth
+	RailShapeAscendingSouth
+	RailShapeSouthEast
+	RailShapeSouthWest
+	RailShapeNorthWest
+	RailShapeNorthEast
+)
+
+var strRailShape = [...]string{"north_south", "east_west", "ascending_east", "ascending_west", "ascending_north", "ascending_south", "south_east", "south_west", "north_west", "north_east"}
+
+func (r RailShape) String() string {
+	if int(r) < len(strRailShape) {
+		return strRailShape[r]
+	}
+	return "invalid RailShape"
+}
+
+func (r RailShape) MarshalText() (text []byte, err error) {
+	if int(r) < len(strRailShape) {
+		return []byte(strRailShape[r]), nil
+	}
+	return nil, errors.New("invalid RailShape: " + strconv.Itoa(int(r)))
+}
+
+func (r *RailShape) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "north_south":
+		*r = RailShapeNorthSouth
+	case "east_west":
+		*r = RailShapeEastWest
+	case "ascending_east":
+		*r = RailShapeAscendingEast
+	case "ascending_west":
+		*r = RailShapeAscendingWest
+	case "ascending_north":
+		*r = RailShapeAscendingNorth
+	case "ascending_south":
+		*r = RailShapeAscendingSouth
+	case "south_east":
+		*r = RailShapeSouthEast
+	case "south_west":
+		*r = RailShapeSouthWest
+	case "north_west":
+		*r = RailShapeNorthWest
+	case "north_east":
+		*r = RailShapeNorthEast
+	default:
+		return errors.New("unknown RailShape: " + str)
+	}
+	return nil
+}
+
+type LeverOrientation byte
+
+const (
+	LeverOrientationDownX LeverOrientation = iota
+	LeverOrientationEast
+	LeverOrientationWest
+	LeverOrientationSouth
+	LeverOrientationNorth
+	LeverOrientationUpZ
+	LeverOrientationUpX
+	LeverOrientationDownZ
+)
+
+var strLeverOrientation = [...]string{"down_x", "east", "west", "south", "north", "up_z", "up_x", "down_z"}
+
+func (l LeverOrientation) String() string {
+	if int(l) < len(strLeverOrientation) {
+		return strLeverOrientation[l]
+	}
+	return "invalid LeverOrientation"
+}
+
+func (l LeverOrientation) MarshalText() (text []byte, err error) {
+	if int(l) < len(strLeverOrientation) {
+		return []byte(strLeverOrientation[l]), nil
+	}
+	return nil, errors.New("invalid LeverOrientation: " + strconv.Itoa(int(l)))
+}
+
+func (l *LeverOrientation) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "down_x":
+		*l = LeverOrientationDownX
+	case "east":
+		*l = LeverOrientationEast
+	case "west":
+		*l = LeverOrientationWest
+	case "south":
+		*l = LeverOrientationSouth
+	case "north":
+		*l = LeverOrientationNorth
+	case "up_z":
+		*l = LeverOrientationUpZ
+	case "up_x":
+		*l = LeverOrientationUpX
+	case "down_z":
+		*l = LeverOrientationDownZ
+	default:
+		return errors.New("unknown LeverOrientation: " + str)
+	}
+	return nil
+}
+
+type StoneVariant byte
+
+const (
+	StoneVariantStone StoneVariant = iota
+	StoneVariantGranite
+	StoneVariantSmoothGranite
+	StoneVariantDiorite
+	StoneVariantSmoothDiorite
+	StoneVariantAndesite
+	StoneVariantSmoothAndesite
+)
+
+var strStoneVariant = [...]string{"stone", "granite", "smooth_granite", "diorite", "smooth_diorite", "andesite", "smooth_andesite"}
+
+func (s StoneVariant) String() string {
+	if int(s) < len(strStoneVariant) {
+		return strStoneVariant[s]
+	}
+	return "invalid StoneVariant"
+}
+
+func (s StoneVariant) MarshalText() (text []byte, err error) {
+	if int(s) < len(strStoneVariant) {
+		return []byte(strStoneVariant[s]), nil
+	}
+	return nil, errors.New("invalid StoneVariant: " + strconv.Itoa(int(s)))
+}
+
+func (s *StoneVariant) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "stone":
+		*s = StoneVariantStone
+	case "granite":
+		*s = StoneVariantGranite
+	case "smooth_granite":
+		*s = StoneVariantSmoothGranite
+	case "diorite":
+		*s = StoneVariantDiorite
+	case "smooth_diorite":
+		*s = StoneVariantSmoothDiorite
+	case "andesite":
+		*s = StoneVariantAndesite
+	case "smooth_andesite":
+		*s = StoneVariantSmoothAndesite
+	default:
+		return errors.New("unknown StoneVariant: " + str)
+	}
+	return nil
+}
+
+type DirtVariant byte
+
+const (
+	DirtVariantDirt DirtVariant = iota
+	DirtVariantCoarseDirt
+	DirtVariantPodzol
+)
+
+var strDirtVariant = [...]string{"dirt", "coarse_dirt", "podzol"}
+
+func (d DirtVariant) String() string {
+	if int(d) < len(strDirtVariant) {
+		return strDirtVariant[d]
+	}
+	return "invalid DirtVariant"
+}
+
+func (d DirtVariant) MarshalText() (text []byte, err error) {
+	if int(d) < len(strDirtVariant) {
+		return []byte(strDirtVariant[d]), nil
+	}
+	return nil, errors.New("invalid DirtVariant: " + strconv.Itoa(int(d)))
+}
+
+func (d *DirtVariant) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "dirt":
+		*d = DirtVariantDirt
+	case "coarse_dirt":
+		*d = DirtVariantCoarseDirt
+	case "podzol":
+		*d = DirtVariantPodzol
+	default:
+		return errors.New("unknown DirtVariant: " + str)
+	}
+	return nil
+}
+
+type SandVariant byte
+
+const (
+	SandVariantSand SandVariant = iota
+	SandVariantRedSand
+)
+
+var strSandVariant = [...]string{"sand", "red_sand"}
+
+func (s SandVariant) String() string {
+	if int(s) < len(strSandVariant) {
+		return strSandVariant[s]
+	}
+	return "invalid SandVariant"
+}
+
+func (s SandVariant) MarshalText() (text []byte, err error) {
+	if int(s) < len(strSandVariant) {
+		return []byte(strSandVariant[s]), nil
+	}
+	return nil, errors.New("invalid SandVariant: " + strconv.Itoa(int(s)))
+}
+
+func (s *SandVariant) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "sand":
+		*s = SandVariantSand
+	case "red_sand":
+		*s = SandVariantRedSand
+	default:
+		return errors.New("unknown SandVariant: " + str)
+	}
+	return nil
+}
+
+type SandstoneType byte
+
+const (
+	SandstoneTypeSandstone SandstoneType = iota
+	SandstoneTypeChiseledSandstone
+	SandstoneTypeSmoothSandstone
+)
+
+var strSandstoneType = [...]string{"sandstone", "chiseled_sandstone", "smooth_sandstone"}
+
+func (s SandstoneType) String() string {
+	if int(s) < len(strSandstoneType) {
+		return strSandstoneType[s]
+	}
+	return "invalid SandstoneType"
+}
+
+func (s SandstoneType) MarshalText() (text []byte, err error) {
+	if int(s) < len(strSandstoneType) {
+		return []byte(strSandstoneType[s]), nil
+	}
+	return nil, errors.New("invalid SandstoneType: " + strconv.Itoa(int(s)))
+}
+
+func (s *SandstoneType) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "sandstone":
+		*s = SandstoneTypeSandstone
+	case "chiseled_sandstone":
+		*s = SandstoneTypeChiseledSandstone
+	case "smooth_sandstone":
+		*s = SandstoneTypeSmoothSandstone
+	default:
+		return errors.New("unknown SandstoneType: " + str)
+	}
+	return nil
+}
+
+type RedSandstoneType byte
+
+const (
+	RedSandstoneTypeRedSandstone RedSandstoneType = iota
+	RedSandstoneTypeChiseledRedSandstone
+	RedSandstoneTypeSmoothRedSandstone
+)
+
+var strRedSandstoneType = [...]string{"red_sandstone", "chiseled_red_sandstone", "smooth_red_sandstone"}
+
+func (r RedSandstoneType) String() string {
+	if int(r) < len(strRedSandstoneType) {
+		return strRedSandstoneType[r]
+	}
+	return "invalid RedSandstoneType"
+}
+
+func (r RedSandstoneType) MarshalText() (text []byte, err error) {
+	if int(r) < len(strRedSandstoneType) {
+		return []byte(strRedSandstoneType[r]), nil
+	}
+	return nil, errors.New("invalid RedSandstoneType: " + strconv.Itoa(int(r)))
+}
+
+func (r *RedSandstoneType) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "red_sandstone":
+		*r = RedSandstoneTypeRedSandstone
+	case "chiseled_red_sandstone":
+		*r = RedSandstoneTypeChiseledRedSandstone
+	case "smooth_red_sandstone":
+		*r = RedSandstoneTypeSmoothRedSandstone
+	default:
+		return errors.New("unknown RedSandstoneType: " + str)
+	}
+	return nil
+}
+
+type TallGrassType byte
+
+const (
+	TallGrassTypeDeadBush TallGrassType = iota
+	TallGrassTypeTallGrass
+	TallGrassTypeFern
+)
+
+var strTallGrassType = [...]string{"dead_bush", "tall_grass", "fern"}
+
+func (t TallGrassType) String() string {
+	if int(t) < len(strTallGrassType) {
+		return strTallGrassType[t]
+	}
+	return "invalid TallGrassType"
+}
+
+func (t TallGrassType) MarshalText() (text []byte, err error) {
+	if int(t) < len(strTallGrassType) {
+		return []byte(strTallGrassType[t]), nil
+	}
+	return nil, errors.New("invalid TallGrassType: " + strconv.Itoa(int(t)))
+}
+
+func (t *TallGrassType) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "dead_bush":
+		*t = TallGrassTypeDeadBush
+	case "tall_grass":
+		*t = TallGrassTypeTallGrass
+	case "fern":
+		*t = TallGrassTypeFern
+	default:
+		return errors.New("unknown TallGrassType: " + str)
+	}
+	return nil
+}
+
+type FlowerType byte
+
+const (
+	FlowerTypePoppy FlowerType = iota
+	FlowerTypeBlueOrchid
+	FlowerTypeAllium
+	FlowerTypeHoustonia
+	FlowerTypeRedTulip
+	FlowerTypeOrangeTulip
+	FlowerTypeWhiteTulip
+	FlowerTypePinkTulip
+	FlowerTypeOxeyeDaisy
+)
+
+var strFlowerType = [...]string{"poppy", "blue_orchid", "allium", "houstonia", "red_tulip", "orange_tulip", "white_tulip", "pink_tulip", "oxeye_daisy"}
+
+func (f FlowerType) String() string {
+	if int(f) < len(strFlowerType) {
+		return strFlowerType[f]
+	}
+	return "invalid FlowerType"
+}
+
+func (f FlowerType) MarshalText() (text []byte, err error) {
+	if int(f) < len(strFlowerType) {
+		return []byte(strFlowerType[f]), nil
+	}
+	return nil, errors.New("invalid FlowerType: " + strconv.Itoa(int(f)))
+}
+
+func (f *FlowerType) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "poppy":
+		*f = FlowerTypePoppy
+	case "blue_orchid":
+		*f = FlowerTypeBlueOrchid
+	case "allium":
+		*f = FlowerTypeAllium
+	case "houstonia":
+		*f = FlowerTypeHoustonia
+	case "red_tulip":
+		*f = FlowerTypeRedTulip
+	case "orange_tulip":
+		*f = FlowerTypeOrangeTulip
+	case "white_tulip":
+		*f = FlowerTypeWhiteTulip
+	case "pink_tulip":
+		*f = FlowerTypePinkTulip
+	case "oxeye_daisy":
+		*f = FlowerTypeOxeyeDaisy
+	default:
+		return errors.New("unknown FlowerType: " + str)
+	}
+	return nil
+}
+
+type StoneSlabVariant byte
+
+const (
+	StoneSlabVariantStone StoneSlabVariant = iota
+	StoneSlabVariantSand
+	StoneSlabVariantWoodOld
+	StoneSlabVariantCobblestone
+	StoneSlabVariantBrick
+	StoneSlabVariantStoneBrick
+	StoneSlabVariantNetherBrick
+	StoneSlabVariantQuartz
+)
+
+var strStoneSlabVariant = [...]string{"stone", "sand", "wood_old", "cobblestone", "brick", "stone_brick", "nether_brick", "quartz"}
+
+func (s StoneSlabVariant) String() string {
+	if int(s) < len(strStoneSlabVariant) {
+		return strStoneSlabVariant[s]
+	}
+	return "invalid StoneSlabVariant"
+}
+
+func (s StoneSlabVariant) MarshalText() (text []byte, err error) {
+	if int(s) < len(strStoneSlabVariant) {
+		return []byte(strStoneSlabVariant[s]), nil
+	}
+	return nil, errors.New("invalid StoneSlabVariant: " + strconv.Itoa(int(s)))
+}
+
+func (s *StoneSlabVariant) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "stone":
+		*s = StoneSlabVariantStone
+	case "sand":
+		*s = StoneSlabVariantSand
+	case "wood_old":
+		*s = StoneSlabVariantWoodOld
+	case "cobblestone":
+		*s = StoneSlabVariantCobblestone
+	case "brick":
+		*s = StoneSlabVariantBrick
+	case "stone_brick":
+		*s = StoneSlabVariantStoneBrick
+	case "nether_brick":
+		*s = StoneSlabVariantNetherBrick
+	case "quartz":
+		*s = StoneSlabVariantQuartz
+	default:
+		return errors.New("unknown StoneSlabVariant: " + str)
+	}
+	return nil
+}
+
+type SilverfishVariant byte
+
+const (
+	SilverfishVariantStone SilverfishVariant = iota
+	SilverfishVariantCobblestone
+	SilverfishVariantStoneBrick
+	SilverfishVariantMossyBrick
+	SilverfishVariantCrackedBrick
+	SilverfishVariantChiseledBrick
+)
+
+var strSilverfishVariant = [...]string{"stone", "cobblestone", "stone_brick", "mossy_brick", "cracked_brick", "chiseled_brick"}
+
+func (s SilverfishVariant) String() string {
+	if int(s) < len(strSilverfishVariant) {
+		return strSilverfishVariant[s]
+	}
+	return "invalid SilverfishVariant"
+}
+
+func (s SilverfishVariant) MarshalText() (text []byte, err error) {
+	if int(s) < len(strSilverfishVariant) {
+		return []byte(strSilverfishVariant[s]), nil
+	}
+	return nil, errors.New("invalid SilverfishVariant: " + strconv.Itoa(int(s)))
+}
+
+func (s *SilverfishVariant) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "stone":
+		*s = SilverfishVariantStone
+	case "cobblestone":
+		*s = SilverfishVariantCobblestone
+	case "stone_brick":
+		*s = SilverfishVariantStoneBrick
+	case "mossy_brick":
+		*s = SilverfishVariantMossyBrick
+	case "cracked_brick":
+		*s = SilverfishVariantCrackedBrick
+	case "chiseled_brick":
+		*s = SilverfishVariantChiseledBrick
+	default:
+		return errors.New("unknown SilverfishVariant: " + str)
+	}
+	return nil
+}
+
+type StoneBrickVariant byte
+
+const (
+	StoneBrickVariantStonebrick StoneBrickVariant = iota
+	StoneBrickVariantMossyStonebrick
+	StoneBrickVariantCrackedStonebrick
+	StoneBrickVariantChiseledStonebrick
+)
+
+var strStoneBrickVariant = [...]string{"stonebrick", "mossy_stonebrick", "cracked_stonebrick", "chiseled_stonebrick"}
+
+func (s StoneBrickVariant) String() string {
+	if int(s) < len(strStoneBrickVariant) {
+		return strStoneBrickVariant[s]
+	}
+	return "invalid StoneBrickVariant"
+}
+
+func (s StoneBrickVariant) MarshalText() (text []byte, err error) {
+	if int(s) < len(strStoneBrickVariant) {
+		return []byte(strStoneBrickVariant[s]), nil
+	}
+	return nil, errors.New("invalid StoneBrickVariant: " + strconv.Itoa(int(s)))
+}
+
+func (s *StoneBrickVariant) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "stonebrick":
+		*s = StoneBrickVariantStonebrick
+	case "mossy_stonebrick":
+		*s = StoneBrickVariantMossyStonebrick
+	case "cracked_stonebrick":
+		*s = StoneBrickVariantCrackedStonebrick
+	case "chiseled_stonebrick":
+		*s = StoneBrickVariantChiseledStonebrick
+	default:
+		return errors.New("unknown StoneBrickVariant: " + str)
+	}
+	return nil
+}
+
+type HugeMushroomVariant byte
+
+const (
+	HugeMushroomVariantAllInside HugeMushroomVariant = iota
+	HugeMushroomVariantNorthWest
+	HugeMushroomVariantNorth
+	HugeMushroomVariantNorthEast
+	HugeMushroomVariantWest
+	HugeMushroomVariantCenter
+	HugeMushroomVariantEast
+	HugeMushroomVariantSouthWest
+	HugeMushroomVariantSouth
+	HugeMushroomVariantSouthEast
+	HugeMushroomVariantStem
+	HugeMushroomVariantAllOutside
+	HugeMushroomVariantAllStem
+)
+
+var strHugeMushroomVariant = [...]string{"all_inside", "north_west", "north", "north_east", "west", "center", "east", "south_west", "south", "south_east", "stem", "all_outside", "all_stem"}
+
+func (h HugeMushroomVariant) String() string {
+	if int(h) < len(strHugeMushroomVariant) {
+		return strHugeMushroomVariant[h]
+	}
+	return "invalid HugeMushroomVariant"
+}
+
+func (h HugeMushroomVariant) MarshalText() (text []byte, err error) {
+	if int(h) < len(strHugeMushroomVariant) {
+		return []byte(strHugeMushroomVariant[h]), nil
+	}
+	return nil, errors.New("invalid HugeMushroomVariant: " + strconv.Itoa(int(h)))
+}
+
+func (h *HugeMushroomVariant) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "all_inside":
+		*h = HugeMushroomVariantAllInside
+	case "north_west":
+		*h = HugeMushroomVariantNorthWest
+	case "north":
+		*h = HugeMushroomVariantNorth
+	case "north_east":
+		*h = HugeMushroomVariantNorthEast
+	case "west":
+		*h = HugeMushroomVariantWest
+	case "center":
+		*h = HugeMushroomVariantCenter
+	case "east":
+		*h = HugeMushroomVariantEast
+	case "south_west":
+		*h = HugeMushroomVariantSouthWest
+	case "south":
+		*h = HugeMushroomVariantSouth
+	case "south_east":
+		*h = HugeMushroomVariantSouthEast
+	case "stem":
+		*h = HugeMushroomVariantStem
+	case "all_outside":
+		*h = HugeMushroomVariantAllOutside
+	case "all_stem":
+		*h = HugeMushroomVariantAllStem
+	default:
+		return errors.New("unknown HugeMushroomVariant: " + str)
+	}
+	return nil
+}
+
+type WallVariant byte
+
+const (
+	WallVariantCobblestone WallVariant = iota
+	WallVariantMossyCobblestone
+)
+
+var strWallVariant = [...]string{"cobblestone", "mossy_cobblestone"}
+
+func (w WallVariant) String() string {
+	if int(w) < len(strWallVariant) {
+		return strWallVariant[w]
+	}
+	return "invalid WallVariant"
+}
+
+func (w WallVariant) MarshalText() (text []byte, err error) {
+	if int(w) < len(strWallVariant) {
+		return []byte(strWallVariant[w]), nil
+	}
+	return nil, errors.New("invalid WallVariant: " + strconv.Itoa(int(w)))
+}
+
+func (w *WallVariant) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "cobblestone":
+		*w = WallVariantCobblestone
+	case "mossy_cobblestone":
+		*w = WallVariantMossyCobblestone
+	default:
+		return errors.New("unknown WallVariant: " + str)
+	}
+	return nil
+}
+
+type QuartzVariant byte
+
+const (
+	QuartzVariantDefault QuartzVariant = iota
+	QuartzVariantChiseled
+	QuartzVariantLinesY
+	QuartzVariantLinesX
+	QuartzVariantLinesZ
+)
+
+var strQuartzVariant = [...]string{"default", "chiseled", "lines_y", "lines_x", "lines_z"}
+
+func (q QuartzVariant) String() string {
+	if int(q) < len(strQuartzVariant) {
+		return strQuartzVariant[q]
+	}
+	return "invalid QuartzVariant"
+}
+
+func (q QuartzVariant) MarshalText() (text []byte, err error) {
+	if int(q) < len(strQuartzVariant) {
+		return []byte(strQuartzVariant[q]), nil
+	}
+	return nil, errors.New("invalid QuartzVariant: " + strconv.Itoa(int(q)))
+}
+
+func (q *QuartzVariant) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "default":
+		*q = QuartzVariantDefault
+	case "chiseled":
+		*q = QuartzVariantChiseled
+	case "lines_y":
+		*q = QuartzVariantLinesY
+	case "lines_x":
+		*q = QuartzVariantLinesX
+	case "lines_z":
+		*q = QuartzVariantLinesZ
+	default:
+		return errors.New("unknown QuartzVariant: " + str)
+	}
+	return nil
+}
+
+type PrismarineVariant byte
+
+const (
+	PrismarineVariantPrismarine PrismarineVariant = iota
+	PrismarineVariantPrismarineBricks
+	PrismarineVariantDarkPrismarine
+)
+
+var strPrismarineVariant = [...]string{"prismarine", "prismarine_bricks", "dark_prismarine"}
+
+func (p PrismarineVariant) String() string {
+	if int(p) < len(strPrismarineVariant) {
+		return strPrismarineVariant[p]
+	}
+	return "invalid PrismarineVariant"
+}
+
+func (p PrismarineVariant) MarshalText() (text []byte, err error) {
+	if int(p) < len(strPrismarineVariant) {
+		return []byte(strPrismarineVariant[p]), nil
+	}
+	return nil, errors.New("invalid PrismarineVariant: " + strconv.Itoa(int(p)))
+}
+
+func (p *PrismarineVariant) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "prismarine":
+		*p = PrismarineVariantPrismarine
+	case "prismarine_bricks":
+		*p = PrismarineVariantPrismarineBricks
+	case "dark_prismarine":
+		*p = PrismarineVariantDarkPrismarine
+	default:
+		return errors.New("unknown PrismarineVariant: " + str)
+	}
+	return nil
+}
+
+type DoublePlantVariant byte
+
+const (
+	DoublePlantVariantSunflower DoublePlantVariant = iota
+	DoublePlantVariantSyringa
+	DoublePlantVariantDoubleGrass
+	DoublePlantVariantDoubleFern
+	DoublePlantVariantDoubleRose
+	DoublePlantVariantPaeonia
+)
+
+var strDoublePlantVariant = [...]string{"sunflower", "syringa", "double_grass", "double_fern", "double_rose", "paeonia"}
+
+func (d DoublePlantVariant) String() string {
+	if int(d) < len(strDoublePlantVariant) {
+		return strDoublePlantVariant[d]
+	}
+	return "invalid DoublePlantVariant"
+}
+
+func (d DoublePlantVariant) MarshalText() (text []byte, err error) {
+	if int(d) < len(strDoublePlantVariant) {
+		return []byte(strDoublePlantVariant[d]), nil
+	}
+	return nil, errors.New("invalid DoublePlantVariant: " + strconv.Itoa(int(d)))
+}
+
+func (d *DoublePlantVariant) UnmarshalText(text []byte) error {
+	switch str := string(text); str {
+	case "sunflower":
+		*d = DoublePlantVariantSunflower
+	case "syringa":
+		*d = DoublePlantVariantSyringa
+	case "double_grass":
+		*d = DoublePlantVariantDoubleGrass
+	case "double_fern":
+		*d = DoublePlantVariantDoubleFern
+	case "double_rose":
+		*d = DoublePlantVariantDoubleRose
+	case "paeonia":
+		*d = DoublePlantVariantPaeonia
+	default:
+		return errors.New("unknown DoublePlantVariant: " + str)
+	}
+	return nil
+}
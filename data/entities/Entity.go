@@ -1,6 +1,7 @@
 package entities
 
 import (
+	"github.com/edouard127/mc-go-1.12.2/data"
 	. "github.com/edouard127/mc-go-1.12.2/maths"
 )
 
@@ -12,6 +13,42 @@ type Entity struct {
 	Rotation Vector2
 	Velocity Vector3
 	OnGround bool
+
+	Width, Height float64 // the size of the bounding box, 0 if unknown
+
+	// Set by the metadata sent by the server
+	Flags      byte       // the bits Flag*
+	CustomName string     // empty if the entity has no name
+	Health     float32    // of a living entity
+	Item       *data.Slot // the item of a dropped item or an item frame, nil for the other entities
+}
+
+// Bits of Entity.Flags
+const (
+	FlagOnFire       = 0x01
+	FlagCrouched     = 0x02
+	FlagSprinting    = 0x08
+	FlagInvisible    = 0x20
+	FlagGlowing      = 0x40
+	FlagElytraFlying = 0x80
+)
+
+// HasFlag return true if all the bits of flag are set
+func (e *Entity) HasFlag(flag byte) bool {
+	return e.Flags&flag == flag
+}
+
+// BoundingBox return the box of the entity in the world.
+// If the size of the entity is unknown, it's the one of a player.
+func (e *Entity) BoundingBox() AABB {
+	w, h := e.Width, e.Height
+	if w == 0 || h == 0 {
+		w, h = 0.6, 1.8
+	}
+	return AABB{
+		Min: e.Position.Sub(Vector3{X: w / 2, Z: w / 2}),
+		Max: e.Position.Add(Vector3{X: w / 2, Y: h, Z: w / 2}),
+	}
 }
 
 func (e *Entity) SetPosition(v3 Vector3, onGround bool) {
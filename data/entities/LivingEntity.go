@@ -7,8 +7,6 @@ import (
 
 type LivingEntity struct {
 	Entity
-	Health   float32
-	OnGround bool
 }
 
 func (p *LivingEntity) SetPosition(v3 Vector3) {
@@ -43,25 +41,22 @@ func (p *LivingEntity) ShouldSendGround() bool {
 	return false
 }
 
-// GetFacing return the direction the player is facing
-/*
-	0: South
-	1: West
-	2: North
-	3: East
-*/
+// GetFacing return the cardinal direction the player is facing
 func (p *LivingEntity) GetFacing() Direction {
-	yaw := p.Rotation.X
+	// The yaw is 0 toward the south and grows clockwise, bring it in [-180, 180)
+	yaw := math.Mod(float64(p.Rotation.X)+180, 360)
+	if yaw < 0 {
+		yaw += 360
+	}
+	yaw -= 180
 	switch {
 	case yaw >= -45 && yaw < 45:
 		return DSouth
 	case yaw >= 45 && yaw < 135:
 		return DWest
-	case yaw >= 135 && yaw > -135:
-		return DNorth
 	case yaw >= -135 && yaw < -45:
 		return DEast
 	default:
-		return DSouth
+		return DNorth
 	}
 }
@@ -0,0 +1,21 @@
+package entities
+
+import "testing"
+
+func TestLivingEntity_GetFacing(t *testing.T) {
+	for _, test := range []struct {
+		yaw  float32
+		want Direction
+	}{
+		{0, DSouth}, {44, DSouth}, {-30, DSouth}, {360, DSouth},
+		{90, DWest}, {-270, DWest},
+		{180, DNorth}, {-180, DNorth}, {140, DNorth}, {-140, DNorth},
+		{-90, DEast}, {270, DEast}, {630, DEast},
+	} {
+		var e LivingEntity
+		e.SetYaw(test.yaw)
+		if got := e.GetFacing(); got != test.want {
+			t.Errorf("yaw %v: got %v, want %v", test.yaw, got, test.want)
+		}
+	}
+}
@@ -11,12 +11,35 @@ type Player struct {
 	LivingEntity
 	UUID [2]int64 //128bit UUID
 
-	OnGround bool
-
 	HeldItem       int
 	Inventory      []_struct.Slot
 	Food           int32
 	FoodSaturation float32
+	Effects        map[int8]PotionEffect // by effect ID
+}
+
+// PotionEffect is an effect applied on the player
+type PotionEffect struct {
+	ID        int8
+	Amplifier int8  // the level of the effect minus 1
+	Duration  int32 // in ticks
+}
+
+// Effect IDs
+const (
+	EffectSpeed         = 1
+	EffectSlowness      = 2
+	EffectHaste         = 3
+	EffectMiningFatigue = 4
+	EffectJumpBoost     = 8
+)
+
+// EffectLevel return the level of an effect on the player, 0 if the player doesn't have it
+func (p *Player) EffectLevel(id int8) int {
+	if e, ok := p.Effects[id]; ok {
+		return int(e.Amplifier) + 1
+	}
+	return 0
 }
 
 type Direction byte
@@ -34,6 +57,14 @@ func (p *Player) GetPosition() Vector3 {
 	return p.Position
 }
 
+// EyeHeight is the height of the player's eyes from its feet
+const EyeHeight = 1.62
+
+// EyePosition return the position of the player's eyes
+func (p *Player) EyePosition() Vector3 {
+	return p.Position.Add(Vector3{Y: EyeHeight})
+}
+
 // GetBlockPos return the position of the Block at player's feet
 func (p *Player) GetBlockPos() Vector3 {
 	return Vector3{X: math.Floor(p.Position.X), Y: math.Floor(p.Position.Y), Z: math.Floor(p.Position.Z)}
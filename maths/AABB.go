@@ -0,0 +1,130 @@
+package maths
+
+import (
+	"fmt"
+	"math"
+)
+
+// AABB is an axis-aligned bounding box
+type AABB struct {
+	Min, Max Vector3
+}
+
+// Offset return the box moved by v
+func (b AABB) Offset(v Vector3) AABB {
+	return AABB{Min: b.Min.Add(v), Max: b.Max.Add(v)}
+}
+
+// Intersects return true if the two boxes overlap.
+// Boxes which only touch each other don't intersect.
+func (b AABB) Intersects(o AABB) bool {
+	return b.Min.X < o.Max.X && b.Max.X > o.Min.X &&
+		b.Min.Y < o.Max.Y && b.Max.Y > o.Min.Y &&
+		b.Min.Z < o.Max.Z && b.Max.Z > o.Min.Z
+}
+
+func (b AABB) String() string {
+	return fmt.Sprintf("AABB{Min: %v, Max: %v}", b.Min, b.Max)
+}
+
+// Center return the point at the center of the box
+func (b AABB) Center() Vector3 {
+	return Vector3{X: (b.Min.X + b.Max.X) / 2, Y: (b.Min.Y + b.Max.Y) / 2, Z: (b.Min.Z + b.Max.Z) / 2}
+}
+
+// RayIntersect return the distance along dir from origin to the point where the ray enters the box,
+// and the normal of the face it enters by. dir should be normalized.
+// Return false if the ray doesn't hit the box or starts in it.
+func (b AABB) RayIntersect(origin, dir Vector3) (t float64, normal Vector3, ok bool) {
+	o, d := [3]float64{origin.X, origin.Y, origin.Z}, [3]float64{dir.X, dir.Y, dir.Z}
+	min, max := [3]float64{b.Min.X, b.Min.Y, b.Min.Z}, [3]float64{b.Max.X, b.Max.Y, b.Max.Z}
+	tNear, tFar := math.Inf(-1), math.Inf(1)
+	axis, sign := 0, 0.0
+	for i := 0; i < 3; i++ {
+		if d[i] == 0 {
+			if o[i] < min[i] || o[i] > max[i] {
+				return 0, Vector3{}, false
+			}
+			continue
+		}
+		t1, t2 := (min[i]-o[i])/d[i], (max[i]-o[i])/d[i]
+		s := -1.0 // enter by the min face
+		if t1 > t2 {
+			t1, t2, s = t2, t1, 1
+		}
+		if t1 > tNear {
+			tNear, axis, sign = t1, i, s
+		}
+		tFar = math.Min(tFar, t2)
+	}
+	if tNear > tFar || tNear < 0 {
+		return 0, Vector3{}, false
+	}
+	n := [3]float64{}
+	n[axis] = sign
+	return tNear, Vector3{X: n[0], Y: n[1], Z: n[2]}, true
+}
+
+// Expand return the box stretched toward v, like the space swept when it moves by v
+func (b AABB) Expand(v Vector3) AABB {
+	if v.X < 0 {
+		b.Min.X += v.X
+	} else {
+		b.Max.X += v.X
+	}
+	if v.Y < 0 {
+		b.Min.Y += v.Y
+	} else {
+		b.Max.Y += v.Y
+	}
+	if v.Z < 0 {
+		b.Min.Z += v.Z
+	} else {
+		b.Max.Z += v.Z
+	}
+	return b
+}
+
+// Grow return the box grown by v on each side, it shrinks if v is negative
+func (b AABB) Grow(v Vector3) AABB {
+	return AABB{Min: b.Min.Sub(v), Max: b.Max.Add(v)}
+}
+
+// XOffset clip the movement dx of the box o along the X axis so that it doesn't go into b
+func (b AABB) XOffset(o AABB, dx float64) float64 {
+	if o.Max.Y <= b.Min.Y || o.Min.Y >= b.Max.Y || o.Max.Z <= b.Min.Z || o.Min.Z >= b.Max.Z {
+		return dx
+	}
+	if dx > 0 && o.Max.X <= b.Min.X {
+		dx = math.Min(dx, b.Min.X-o.Max.X)
+	} else if dx < 0 && o.Min.X >= b.Max.X {
+		dx = math.Max(dx, b.Max.X-o.Min.X)
+	}
+	return dx
+}
+
+// YOffset is XOffset along the Y axis
+func (b AABB) YOffset(o AABB, dy float64) float64 {
+	if o.Max.X <= b.Min.X || o.Min.X >= b.Max.X || o.Max.Z <= b.Min.Z || o.Min.Z >= b.Max.Z {
+		return dy
+	}
+	if dy > 0 && o.Max.Y <= b.Min.Y {
+		dy = math.Min(dy, b.Min.Y-o.Max.Y)
+	} else if dy < 0 && o.Min.Y >= b.Max.Y {
+		dy = math.Max(dy, b.Max.Y-o.Min.Y)
+	}
+	return dy
+}
+
+// ZOffset is XOffset along the Z axis
+func (b AABB) ZOffset(o AABB, dz float64) float64 {
+	if o.Max.X <= b.Min.X || o.Min.X >= b.Max.X || o.Max.Y <= b.Min.Y || o.Min.Y >= b.Max.Y {
+		return dz
+	}
+	if dz > 0 && o.Max.Z <= b.Min.Z {
+		dz = math.Min(dz, b.Min.Z-o.Max.Z)
+	} else if dz < 0 && o.Min.Z >= b.Max.Z {
+		dz = math.Max(dz, b.Max.Z-o.Min.Z)
+	}
+	return dz
+}
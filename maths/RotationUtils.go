@@ -31,3 +31,14 @@ func normalizeAngle(angle float64) float64 {
 func floatRemaining(a, b float64) float64 {
 	return a - math.Floor(a/b)*b
 }
+
+// GetVectorFromRotation return the unit vector a player looks along,
+// v2.X is the yaw and v2.Y the pitch in degrees.
+func GetVectorFromRotation(v2 Vector2) Vector3 {
+	yaw, pitch := float64(v2.X)*PiFloat/180, float64(v2.Y)*PiFloat/180
+	return Vector3{
+		X: -math.Sin(yaw) * math.Cos(pitch),
+		Y: -math.Sin(pitch),
+		Z: math.Cos(yaw) * math.Cos(pitch),
+	}
+}
@@ -0,0 +1,203 @@
+package packet
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// The limits of the vanilla server
+const (
+	MaxFrameSize = 1<<21 - 1 // the length of a packet on the wire, the largest 3 bytes VarInt (2 MiB)
+	MaxDataSize  = 1 << 23   // the length of a packet once decompressed (8 MiB)
+)
+
+var (
+	ErrFrameTooBig = errors.New("packet too big")
+	ErrDataTooBig  = errors.New("decompressed packet too big")
+)
+
+// buffers keeps the buffers of the packets read, see Packet.Release
+var buffers = sync.Pool{New: func() any { return new([]byte) }}
+
+func getBuffer(n int) *[]byte {
+	b := buffers.Get().(*[]byte)
+	if cap(*b) < n {
+		*b = make([]byte, n)
+	}
+	*b = (*b)[:n]
+	return b
+}
+
+func putBuffer(b *[]byte) {
+	if b != nil && cap(*b) <= MaxFrameSize { // don't keep the rare huge ones
+		buffers.Put(b)
+	}
+}
+
+// Release give the memory of a packet returned by Reader back for the next packets.
+// p.Data mustn't be used after.
+func (p *Packet) Release() {
+	putBuffer(p.buf)
+	p.buf, p.Data = nil, nil
+}
+
+// Reader reads the packets of a connection, one frame at a time.
+// R and Threshold can change between two packets, like when the connection gets encrypted.
+// The zero value is ready to use once R is set.
+type Reader struct {
+	R         io.Reader
+	Threshold int // the packets are compressed if it's > 0
+
+	zr  io.ReadCloser // reused between the packets
+	src bytes.Reader  // the compressed data, read by zr
+}
+
+// ReadPacket read the next packet. Its Data is valid until Release is called.
+func (r *Reader) ReadPacket() (*Packet, error) {
+	br, ok := r.R.(io.ByteReader)
+	if !ok {
+		br = byteReader{r.R}
+	}
+	length, err := UnpackVarInt(br)
+	if err != nil {
+		return nil, fmt.Errorf("read len of packet fail: %v", err)
+	}
+	if length < 1 {
+		return nil, fmt.Errorf("packet length too short")
+	}
+	if length > MaxFrameSize {
+		return nil, fmt.Errorf("read packet of %d bytes fail: %w", length, ErrFrameTooBig)
+	}
+
+	frame := getBuffer(int(length))
+	if _, err := io.ReadFull(r.R, *frame); err != nil {
+		putBuffer(frame)
+		return nil, fmt.Errorf("read content of packet fail: %v", err)
+	}
+	if r.Threshold <= 0 {
+		return &Packet{ID: (*frame)[0], Data: (*frame)[1:], buf: frame}, nil
+	}
+
+	data, err := r.decompress(*frame)
+	putBuffer(frame)
+	if err != nil {
+		return nil, err
+	}
+	if len(*data) == 0 {
+		putBuffer(data)
+		return nil, fmt.Errorf("packet length too short")
+	}
+	return &Packet{ID: (*data)[0], Data: (*data)[1:], buf: data}, nil
+}
+
+// decompress read a compressed frame: the length of the data, 0 if it's not compressed, then the data
+func (r *Reader) decompress(frame []byte) (*[]byte, error) {
+	r.src.Reset(frame)
+	size, err := UnpackVarInt(&r.src)
+	if err != nil {
+		return nil, fmt.Errorf("read len of data fail: %v", err)
+	}
+	rest := frame[len(frame)-r.src.Len():]
+	if size == 0 { // too small to be compressed
+		data := getBuffer(len(rest))
+		copy(*data, rest)
+		return data, nil
+	}
+	if size < 0 || size > MaxDataSize {
+		return nil, fmt.Errorf("decompress %d bytes fail: %w", size, ErrDataTooBig)
+	}
+	if int(size) < r.Threshold {
+		return nil, fmt.Errorf("badly compressed packet: %d bytes is below the threshold %d", size, r.Threshold)
+	}
+
+	if r.zr == nil {
+		r.zr, err = zlib.NewReader(&r.src)
+	} else {
+		err = r.zr.(zlib.Resetter).Reset(&r.src, nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("decompress fail: %v", err)
+	}
+	data := getBuffer(int(size))
+	if _, err := io.ReadFull(r.zr, *data); err != nil {
+		putBuffer(data)
+		return nil, fmt.Errorf("decompress fail: %v", err)
+	}
+	// Reading to the end checks the checksum
+	if n, err := r.zr.Read(make([]byte, 1)); n > 0 || err != io.EOF {
+		putBuffer(data)
+		if err == nil || err == io.EOF {
+			err = fmt.Errorf("more than %d bytes", size)
+		}
+		return nil, fmt.Errorf("decompress fail: %v", err)
+	}
+	return data, nil
+}
+
+// byteReader reads the length of the frames without reading ahead
+type byteReader struct {
+	r io.Reader
+}
+
+func (b byteReader) ReadByte() (byte, error) {
+	var bs [1]byte
+	_, err := io.ReadFull(b.r, bs[:])
+	return bs[0], err
+}
+
+// Writer writes the packets to a connection, each in one call to W.Write.
+// W and Threshold can change between two packets.
+// The zero value is ready to use once W is set.
+type Writer struct {
+	W         io.Writer
+	Threshold int // the packets larger than it are compressed if it's > 0
+
+	zw    *zlib.Writer // reused between the packets
+	frame bytes.Buffer
+	data  bytes.Buffer // the compressed data
+}
+
+// WritePacket write p in a frame
+func (w *Writer) WritePacket(p *Packet) error {
+	n := 1 + len(p.Data)
+	w.frame.Reset()
+	switch {
+	case w.Threshold <= 0:
+		w.frame.Write(PackVarInt(int32(n)))
+		w.frame.WriteByte(p.ID)
+		w.frame.Write(p.Data)
+	case n <= w.Threshold:
+		w.frame.Write(PackVarInt(int32(n + 1)))
+		w.frame.WriteByte(0) // not compressed
+		w.frame.WriteByte(p.ID)
+		w.frame.Write(p.Data)
+	default:
+		if n > MaxDataSize {
+			return fmt.Errorf("write packet of %d bytes fail: %w", n, ErrDataTooBig)
+		}
+		w.data.Reset()
+		if w.zw == nil {
+			w.zw = zlib.NewWriter(&w.data)
+		} else {
+			w.zw.Reset(&w.data)
+		}
+		w.zw.Write([]byte{p.ID})
+		w.zw.Write(p.Data)
+		if err := w.zw.Close(); err != nil {
+			return fmt.Errorf("compress fail: %v", err)
+		}
+		size := PackVarInt(int32(n))
+		w.frame.Write(PackVarInt(int32(len(size) + w.data.Len())))
+		w.frame.Write(size)
+		w.frame.Write(w.data.Bytes())
+	}
+	if w.frame.Len() > MaxFrameSize+3 {
+		return fmt.Errorf("write packet of %d bytes fail: %w", w.frame.Len(), ErrFrameTooBig)
+	}
+	_, err := w.W.Write(w.frame.Bytes())
+	return err
+}
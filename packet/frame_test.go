@@ -0,0 +1,163 @@
+package packet
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"io"
+	"math/rand"
+	"testing"
+)
+
+func TestReader_RoundTrip(t *testing.T) {
+	packets := []*Packet{
+		{ID: 0x1F, Data: PackUint64(42)},
+		{ID: 0x00},
+		{ID: 0x20, Data: chunkData(1)},
+		{ID: 0x0F, Data: PackString("hi")},
+	}
+	for _, threshold := range []int{-1, 0, 256} {
+		var b bytes.Buffer
+		w := Writer{W: &b, Threshold: threshold}
+		for _, p := range packets {
+			if err := w.WritePacket(p); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if old := packets[2].Pack(threshold); !bytes.Contains(b.Bytes(), old) {
+			t.Errorf("threshold %d: Pack and WritePacket differ", threshold)
+		}
+
+		r := Reader{R: &b, Threshold: threshold}
+		for _, want := range packets {
+			p, err := r.ReadPacket()
+			if err != nil {
+				t.Fatalf("threshold %d: %v", threshold, err)
+			}
+			if p.ID != want.ID || !bytes.Equal(p.Data, want.Data) {
+				t.Errorf("threshold %d: read 0x%02X of %d bytes, want 0x%02X of %d bytes", threshold, p.ID, len(p.Data), want.ID, len(want.Data))
+			}
+			p.Release()
+		}
+		if _, err := r.ReadPacket(); err == nil {
+			t.Errorf("threshold %d: no error at the end", threshold)
+		}
+	}
+}
+
+func TestReader_Limits(t *testing.T) {
+	compressed := func(size int32, data []byte) []byte {
+		var z bytes.Buffer
+		zw := zlib.NewWriter(&z)
+		zw.Write(data)
+		zw.Close()
+		body := append(PackVarInt(size), z.Bytes()...)
+		return append(PackVarInt(int32(len(body))), body...)
+	}
+	data := make([]byte, 1000)
+
+	for _, test := range []struct {
+		name  string
+		frame []byte
+		is    error
+	}{
+		{"frame too big", PackVarInt(MaxFrameSize + 1), ErrFrameTooBig},
+		{"data too big", compressed(MaxDataSize+1, data), ErrDataTooBig},
+		{"negative size", compressed(-1, data), ErrDataTooBig},
+		{"below threshold", compressed(10, data[:10]), nil},
+		{"wrong size", compressed(500, data), nil},
+		{"truncated", PackVarInt(10), nil},
+		{"empty", PackVarInt(0), nil},
+	} {
+		r := Reader{R: bytes.NewReader(test.frame), Threshold: 256}
+		_, err := r.ReadPacket()
+		if err == nil || test.is != nil && !errors.Is(err, test.is) {
+			t.Errorf("%s: got %v", test.name, err)
+		}
+	}
+}
+
+// chunkData return the data of a Chunk Data packet with 16 sections, compressible like the real ones
+func chunkData(seed int64) []byte {
+	rnd := rand.New(rand.NewSource(seed))
+	data := make([]byte, 16*(2048+4096+2048)+256)
+	for i := range data {
+		data[i] = byte(rnd.Intn(8))
+	}
+	return data
+}
+
+// chunkTraffic return the frames of n compressed Chunk Data packets
+func chunkTraffic(b *testing.B, n int) []byte {
+	var buf bytes.Buffer
+	w := Writer{W: &buf, Threshold: 256}
+	for i := 0; i < n; i++ {
+		if err := w.WritePacket(&Packet{ID: 0x20, Data: chunkData(int64(i))}); err != nil {
+			b.Fatal(err)
+		}
+	}
+	return buf.Bytes()
+}
+
+const chunks = 32
+
+func BenchmarkReader_Chunks(b *testing.B) {
+	traffic := chunkTraffic(b, chunks)
+	b.SetBytes(int64(chunks * len(chunkData(0))))
+	b.ReportAllocs()
+	src := bytes.NewReader(traffic)
+	r := Reader{R: bufio.NewReader(src), Threshold: 256}
+	for i := 0; i < b.N; i++ {
+		src.Reset(traffic)
+		for j := 0; j < chunks; j++ {
+			p, err := r.ReadPacket()
+			if err != nil {
+				b.Fatal(err)
+			}
+			p.Release()
+		}
+	}
+}
+
+// BenchmarkReader_ChunksByteLoop reads like before the Reader, one byte at a time and a zlib reader per packet
+func BenchmarkReader_ChunksByteLoop(b *testing.B) {
+	traffic := chunkTraffic(b, chunks)
+	b.SetBytes(int64(chunks * len(chunkData(0))))
+	b.ReportAllocs()
+	src := bytes.NewReader(traffic)
+	br := bufio.NewReader(src)
+	for i := 0; i < b.N; i++ {
+		src.Reset(traffic)
+		br.Reset(src)
+		for j := 0; j < chunks; j++ {
+			length, err := UnpackVarInt(br)
+			if err != nil {
+				b.Fatal(err)
+			}
+			data := make([]byte, length)
+			for k := range data {
+				if data[k], err = br.ReadByte(); err != nil {
+					b.Fatal(err)
+				}
+			}
+			if _, err := UnCompress(data); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkWriter_Chunks(b *testing.B) {
+	data := chunkData(0)
+	b.SetBytes(int64(chunks * len(data)))
+	b.ReportAllocs()
+	w := Writer{W: io.Discard, Threshold: 256}
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < chunks; j++ {
+			if err := w.WritePacket(&Packet{ID: 0x20, Data: data}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	. "github.com/edouard127/mc-go-1.12.2/data"
 	"github.com/edouard127/mc-go-1.12.2/maths"
+	"github.com/edouard127/mc-go-1.12.2/nbt"
 	"io"
 	"math"
 )
@@ -14,33 +15,16 @@ import (
 type Packet struct {
 	ID   byte
 	Data []byte
+
+	buf *[]byte // the pooled buffer of Data, see Release
 }
 
 // Pack 打包一个数据包
 func (p *Packet) Pack(threshold int) (pack []byte) {
-	data := []byte{p.ID}           //data
-	data = append(data, p.Data...) //data
-
-	if threshold > 0 { //是否启用了压缩
-		if len(data) > threshold { //是否需要压缩
-			Len := len(data)
-			VarLen := PackVarInt(int32(Len))
-			data = Compress(data)
-
-			pack = append(pack, PackVarInt(int32(len(VarLen)+len(data)))...)
-			pack = append(pack, VarLen...)
-			pack = append(pack, data...)
-		} else {
-			pack = append(pack, PackVarInt(int32(len(data)+1))...)
-			pack = append(pack, 0x00)
-			pack = append(pack, data...)
-		}
-	} else {
-		pack = append(pack, PackVarInt(int32(len(data)))...) //len
-		pack = append(pack, data...)
-	}
-
-	return
+	var b bytes.Buffer
+	w := Writer{W: &b, Threshold: threshold}
+	w.WritePacket(p)
+	return b.Bytes()
 }
 
 // PackString 打包一个字符串
@@ -102,6 +86,12 @@ func PackPosition(v3 maths.Vector3) (p []byte) {
 	return p
 }
 
+// PackBlockPosition 打包一个方块坐标, 用于挖掘和放置方块等数据包
+func PackBlockPosition(v3 maths.Vector3) []byte {
+	x, y, z := int64(math.Floor(v3.X)), int64(math.Floor(v3.Y)), int64(math.Floor(v3.Z))
+	return PackUint64(uint64((x&0x3FFFFFF)<<38 | (y&0xFFF)<<26 | z&0x3FFFFFF))
+}
+
 func PackRotation(v2 maths.Vector2) (p []byte) {
 	p = append(p, PackFloat(float32(v2.X))...)
 	p = append(p, PackFloat(float32(v2.Y))...)
@@ -136,7 +126,17 @@ func UnpackBoolean(b io.ByteReader) (bool, error) {
 
 // ReadNBytes read N bytes from bytes.Reader
 func ReadNBytes(b io.ByteReader, n int) (bs []byte, err error) {
+	if n < 0 {
+		return nil, fmt.Errorf("read %d bytes fail: negative length", n)
+	}
+	if l, ok := b.(interface{ Len() int }); ok && l.Len() < n { // don't allocate for a wrong length
+		return nil, fmt.Errorf("read %d bytes fail: %v", n, io.ErrUnexpectedEOF)
+	}
 	bs = make([]byte, n)
+	if r, ok := b.(io.Reader); ok {
+		_, err = io.ReadFull(r, bs)
+		return
+	}
 	for i := 0; i < n; i++ {
 		bs[i], err = b.ReadByte()
 		if err != nil {
@@ -257,96 +257,69 @@ func UnpackDouble(b io.ByteReader) (float64, error) {
 	return math.Float64frombits(uint64(n)), err
 }
 
-type Metadata struct {
-	Index byte
-	Type  byte
-	Value byte
-}
-
-func UnpackMetadata(b io.ByteReader) (metadata []Metadata, err error) {
-	var index byte
-	for {
-		index, err = b.ReadByte()
-		if err != nil {
-			return
-		}
-		if index == 0xFF {
-			break
-		}
-		metadata = append(metadata, Metadata{
-			Index: index,
-			Type:  index >> 5,
-			Value: index & 0x1F,
-		})
-	}
-	return
-}
-
+// UnpackSlot read a slot in the 1.12.2 format: the item ID, -1 for an empty slot, the count, the damage and the NBT
 func UnpackSlot(b *bytes.Reader) (Slot, error) {
-	index := 0
-	p, err := b.ReadByte()
+	id, err := UnpackInt16(b)
+	if err != nil || id == -1 {
+		return Slot{}, err
+	}
+	count, err := b.ReadByte()
 	if err != nil {
 		return Slot{}, err
 	}
-	Present := p != 0x00
-	index++
-	if Present {
-		itemID, err := UnpackVarInt(b)
-		if err != nil {
-			return Slot{}, err
-		}
-		count, err := b.ReadByte()
-		if err != nil {
-			return Slot{}, err
-		}
-		index++
-
-		//nbt.Unmarshal(nbt.Uncompressed)
-
-		return Slot{
-			ID:    int(itemID),
-			Count: count,
-		}, nil
+	damage, err := UnpackInt16(b)
+	if err != nil {
+		return Slot{}, err
+	}
+	tag, err := unpackNBT(b)
+	if err != nil {
+		return Slot{}, fmt.Errorf("read NBT of slot fail: %v", err)
 	}
-	return Slot{}, nil
+	return NewItemStack(int(id), count, damage, tag)
 }
 
-// RecvPacket receive a packet from server
-func RecvPacket(r io.ByteReader, useZlib bool) (*Packet, error) {
-	var len int
-	for i := 0; i < 5; i++ { //读数据前的长度标记
-		b, err := r.ReadByte()
-		if err != nil {
-			return nil, fmt.Errorf("read len of packet fail: %v", err)
-		}
-		len |= (int(b&0x7F) << uint(7*i))
-		if b&0x80 == 0 {
-			break
-		}
+// unpackNBT read a named tag, nil for TAG_End
+func unpackNBT(b *bytes.Reader) ([]byte, error) {
+	start := b.Size() - int64(b.Len())
+	if t, err := b.ReadByte(); err != nil || t == nbt.TagEnd {
+		return nil, err
 	}
-
-	if len < 1 {
-		return nil, fmt.Errorf("packet length too short")
+	b.UnreadByte()
+	var msg nbt.RawMessage
+	if _, err := nbt.NewDecoder(b).Decode(&msg); err != nil {
+		return nil, err
 	}
+	tag := make([]byte, b.Size()-int64(b.Len())-start)
+	_, err := b.ReadAt(tag, start)
+	return tag, err
+}
 
-	data := make([]byte, len) //读包内容
-	var err error
-	for i := 0; i < len; i++ {
-		data[i], err = r.ReadByte()
-		if err != nil {
-			return nil, fmt.Errorf("read content of packet fail: %v", err)
-		}
+// PackSlot write a slot in the 1.12.2 format, the reverse of UnpackSlot
+func PackSlot(s Slot) ([]byte, error) {
+	if s.ID == 0 || s.Count == 0 {
+		return PackUint16(0xFFFF), nil
+	}
+	tag, err := s.Tag()
+	if err != nil {
+		return nil, err
+	}
+	p := PackUint16(uint16(s.ID))
+	p = append(p, s.Count)
+	p = append(p, PackUint16(uint16(s.Damage))...)
+	if tag == nil {
+		return append(p, nbt.TagEnd), nil
 	}
+	return append(p, tag...), nil
+}
 
-	// Decompress data
+// RecvPacket receive a packet from server.
+// Prefer a Reader kept for the connection, which reuses its buffers and zlib reader.
+func RecvPacket(r io.Reader, useZlib bool) (*Packet, error) {
+	rd := Reader{R: r}
 	if useZlib {
-		return UnCompress(data)
+		rd.Threshold = 1 // the real threshold isn't known here
 	}
-
-	return &Packet{
-		ID:   data[0],
-		Data: data[1:],
-	}, nil
+	return rd.ReadPacket()
 }
 
 func UnCompress(data []byte) (*Packet, error) {
@@ -356,6 +329,9 @@ func UnCompress(data []byte) (*Packet, error) {
 		return nil, err
 	}
 
+	if sizeUncompressed < 0 || sizeUncompressed > MaxDataSize {
+		return nil, fmt.Errorf("decompress %d bytes fail: %w", sizeUncompressed, ErrDataTooBig)
+	}
 	uncompressData := make([]byte, sizeUncompressed)
 	if sizeUncompressed != 0 { // != 0 means compressed, let's decompress
 		r, err := zlib.NewReader(reader)
@@ -371,6 +347,9 @@ func UnCompress(data []byte) (*Packet, error) {
 	} else {
 		uncompressData = data[1:]
 	}
+	if len(uncompressData) == 0 {
+		return nil, fmt.Errorf("packet length too short")
+	}
 	return &Packet{
 		ID:   uncompressData[0],
 		Data: uncompressData[1:],
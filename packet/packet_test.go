@@ -0,0 +1,69 @@
+package packet
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	. "github.com/edouard127/mc-go-1.12.2/data"
+	"github.com/edouard127/mc-go-1.12.2/nbt"
+)
+
+func TestUnpackSlot(t *testing.T) {
+	// a renamed diamond pickaxe, as sent by a 1.12.2 server
+	var tag struct {
+		Display struct {
+			Name string   `nbt:"Name"`
+			Lore []string `nbt:"Lore"`
+		} `nbt:"display"`
+		Ench        []Enchantment `nbt:"ench"`
+		Unbreakable byte          `nbt:"Unbreakable"`
+		RepairCost  int32         `nbt:"RepairCost"` // not decoded
+	}
+	tag.Display.Name = "Pick"
+	tag.Display.Lore = []string{"a", "b"}
+	tag.Ench = []Enchantment{{ID: EnchEfficiency, Level: 5}, {ID: EnchUnbreaking, Level: 3}}
+	tag.Unbreakable = 1
+	tag.RepairCost = 3
+	raw, err := nbt.Marshal(tag)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := append([]byte{0x01, 0x16, 3, 0, 10}, raw...) // ID 278, count 3, damage 10
+	data = append(data, 0xFF, 0xFF, 0x42)                // an empty slot, then the rest of the packet
+	r := bytes.NewReader(data)
+	s, err := UnpackSlot(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Slot{ID: 278, Count: 3, Damage: 10, DisplayName: "Pick", Lore: []string{"a", "b"}, Enchantments: tag.Ench, Unbreakable: true, NBT: raw}
+	if !reflect.DeepEqual(s, want) {
+		t.Errorf("got %+v, want %+v", s, want)
+	}
+	if s.EnchantmentLevel(EnchEfficiency) != 5 || s.EnchantmentLevel(EnchSilkTouch) != 0 {
+		t.Errorf("enchantment levels of %v", s.Enchantments)
+	}
+	if empty, err := UnpackSlot(r); err != nil || empty.ID != 0 || r.Len() != 1 {
+		t.Errorf("empty slot %+v, %v, %d bytes left", empty, err, r.Len())
+	}
+
+	// The item is sent back with its tag
+	if p, err := PackSlot(s); err != nil || !bytes.Equal(p, data[:5+len(raw)]) {
+		t.Errorf("PackSlot = % X, %v", p, err)
+	}
+
+	// A tag made from the fields is read back the same
+	s.NBT = nil
+	p, err := PackSlot(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := UnpackSlot(bytes.NewReader(p))
+	if got.NBT = nil; err != nil || !reflect.DeepEqual(got, s) {
+		t.Errorf("got %#v, %v, want %#v", got, err, s)
+	}
+	if p, _ := PackSlot(Slot{ID: 1, Count: 1}); !bytes.Equal(p, []byte{0, 1, 1, 0, 0, 0}) {
+		t.Errorf("slot without tag % X", p)
+	}
+}
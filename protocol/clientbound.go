@@ -0,0 +1,1930 @@
+package protocol
+
+import (
+	. "github.com/edouard127/mc-go-1.12.2/maths"
+)
+
+// The clientbound packets of the play state.
+// Chat fields are the JSON text, angles are in degrees and velocities in 1/8000 block per tick.
+
+type SpawnObject struct {
+	EntityID   int32
+	ObjectUUID [2]int64
+	Type       int8
+	Position   Vector3
+	Pitch, Yaw float32
+	Data       int32
+	Velocity   [3]int16
+}
+
+func (p *SpawnObject) Encode(w *Writer) {
+	w.VarInt(p.EntityID)
+	w.UUID(p.ObjectUUID)
+	w.Byte(p.Type)
+	writeVector(w, p.Position)
+	w.Angle(p.Pitch)
+	w.Angle(p.Yaw)
+	w.Int(p.Data)
+	writeShorts(w, p.Velocity)
+}
+
+func (p *SpawnObject) Decode(r *Reader) {
+	p.EntityID = r.VarInt()
+	p.ObjectUUID = r.UUID()
+	p.Type = r.Byte()
+	p.Position = readVector(r)
+	p.Pitch = r.Angle()
+	p.Yaw = r.Angle()
+	p.Data = r.Int()
+	p.Velocity = readShorts(r)
+}
+
+type SpawnExperienceOrb struct {
+	EntityID int32
+	Position Vector3
+	Count    int16
+}
+
+func (p *SpawnExperienceOrb) Encode(w *Writer) {
+	w.VarInt(p.EntityID)
+	writeVector(w, p.Position)
+	w.Short(p.Count)
+}
+
+func (p *SpawnExperienceOrb) Decode(r *Reader) {
+	p.EntityID = r.VarInt()
+	p.Position = readVector(r)
+	p.Count = r.Short()
+}
+
+// SpawnGlobalEntity spawns a thunderbolt, the only global entity (Type 1)
+type SpawnGlobalEntity struct {
+	EntityID int32
+	Type     int8
+	Position Vector3
+}
+
+func (p *SpawnGlobalEntity) Encode(w *Writer) {
+	w.VarInt(p.EntityID)
+	w.Byte(p.Type)
+	writeVector(w, p.Position)
+}
+
+func (p *SpawnGlobalEntity) Decode(r *Reader) {
+	p.EntityID = r.VarInt()
+	p.Type = r.Byte()
+	p.Position = readVector(r)
+}
+
+// SpawnMob spawns a living entity
+type SpawnMob struct {
+	EntityID              int32
+	EntityUUID            [2]int64
+	Type                  int32
+	Position              Vector3
+	Yaw, Pitch, HeadPitch float32
+	Velocity              [3]int16
+	Metadata              Metadata
+}
+
+func (p *SpawnMob) Encode(w *Writer) {
+	w.VarInt(p.EntityID)
+	w.UUID(p.EntityUUID)
+	w.VarInt(p.Type)
+	writeVector(w, p.Position)
+	w.Angle(p.Yaw)
+	w.Angle(p.Pitch)
+	w.Angle(p.HeadPitch)
+	writeShorts(w, p.Velocity)
+	w.Metadata(p.Metadata)
+}
+
+func (p *SpawnMob) Decode(r *Reader) {
+	p.EntityID = r.VarInt()
+	p.EntityUUID = r.UUID()
+	p.Type = r.VarInt()
+	p.Position = readVector(r)
+	p.Yaw = r.Angle()
+	p.Pitch = r.Angle()
+	p.HeadPitch = r.Angle()
+	p.Velocity = readShorts(r)
+	p.Metadata = r.Metadata()
+}
+
+type SpawnPainting struct {
+	EntityID   int32
+	EntityUUID [2]int64
+	Title      string
+	Location   Vector3
+	Direction  int8
+}
+
+func (p *SpawnPainting) Encode(w *Writer) {
+	w.VarInt(p.EntityID)
+	w.UUID(p.EntityUUID)
+	w.String(p.Title)
+	w.Position(p.Location)
+	w.Byte(p.Direction)
+}
+
+func (p *SpawnPainting) Decode(r *Reader) {
+	p.EntityID = r.VarInt()
+	p.EntityUUID = r.UUID()
+	p.Title = r.String()
+	p.Location = r.Position()
+	p.Direction = r.Byte()
+}
+
+// SpawnPlayer spawns another player
+type SpawnPlayer struct {
+	EntityID   int32
+	PlayerUUID [2]int64
+	Position   Vector3
+	Yaw, Pitch float32
+	Metadata   Metadata
+}
+
+func (p *SpawnPlayer) Encode(w *Writer) {
+	w.VarInt(p.EntityID)
+	w.UUID(p.PlayerUUID)
+	writeVector(w, p.Position)
+	w.Angle(p.Yaw)
+	w.Angle(p.Pitch)
+	w.Metadata(p.Metadata)
+}
+
+func (p *SpawnPlayer) Decode(r *Reader) {
+	p.EntityID = r.VarInt()
+	p.PlayerUUID = r.UUID()
+	p.Position = readVector(r)
+	p.Yaw = r.Angle()
+	p.Pitch = r.Angle()
+	p.Metadata = r.Metadata()
+}
+
+type AnimationClientbound struct {
+	EntityID  int32
+	Animation byte
+}
+
+func (p *AnimationClientbound) Encode(w *Writer) {
+	w.VarInt(p.EntityID)
+	w.UByte(p.Animation)
+}
+
+func (p *AnimationClientbound) Decode(r *Reader) {
+	p.EntityID = r.VarInt()
+	p.Animation = r.UByte()
+}
+
+type Statistic struct {
+	Name  string
+	Value int32
+}
+
+type Statistics struct {
+	Statistics []Statistic
+}
+
+func (p *Statistics) Encode(w *Writer) {
+	w.VarInt(int32(len(p.Statistics)))
+	for _, s := range p.Statistics {
+		w.String(s.Name)
+		w.VarInt(s.Value)
+	}
+}
+
+func (p *Statistics) Decode(r *Reader) {
+	p.Statistics = nil
+	for i, n := 0, r.Count(); i < n; i++ {
+		p.Statistics = append(p.Statistics, Statistic{Name: r.String(), Value: r.VarInt()})
+	}
+}
+
+// BlockBreakAnimation shows the cracks of a block, DestroyStage 0 to 9, or another value to remove them
+type BlockBreakAnimation struct {
+	EntityID     int32
+	Location     Vector3
+	DestroyStage int8
+}
+
+func (p *BlockBreakAnimation) Encode(w *Writer) {
+	w.VarInt(p.EntityID)
+	w.Position(p.Location)
+	w.Byte(p.DestroyStage)
+}
+
+func (p *BlockBreakAnimation) Decode(r *Reader) {
+	p.EntityID = r.VarInt()
+	p.Location = r.Position()
+	p.DestroyStage = r.Byte()
+}
+
+type UpdateBlockEntity struct {
+	Location Vector3
+	Action   byte
+	NBTData  NBT
+}
+
+func (p *UpdateBlockEntity) Encode(w *Writer) {
+	w.Position(p.Location)
+	w.UByte(p.Action)
+	w.NBT(p.NBTData)
+}
+
+func (p *UpdateBlockEntity) Decode(r *Reader) {
+	p.Location = r.Position()
+	p.Action = r.UByte()
+	p.NBTData = r.NBT()
+}
+
+type BlockAction struct {
+	Location    Vector3
+	ActionID    byte
+	ActionParam byte
+	BlockType   int32
+}
+
+func (p *BlockAction) Encode(w *Writer) {
+	w.Position(p.Location)
+	w.UByte(p.ActionID)
+	w.UByte(p.ActionParam)
+	w.VarInt(p.BlockType)
+}
+
+func (p *BlockAction) Decode(r *Reader) {
+	p.Location = r.Position()
+	p.ActionID = r.UByte()
+	p.ActionParam = r.UByte()
+	p.BlockType = r.VarInt()
+}
+
+// BlockChange sets a block, BlockID is the block ID << 4 | metadata
+type BlockChange struct {
+	Location Vector3
+	BlockID  int32
+}
+
+func (p *BlockChange) Encode(w *Writer) {
+	w.Position(p.Location)
+	w.VarInt(p.BlockID)
+}
+
+func (p *BlockChange) Decode(r *Reader) {
+	p.Location = r.Position()
+	p.BlockID = r.VarInt()
+}
+
+// The actions of BossBar
+const (
+	BossBarAdd int32 = iota
+	BossBarRemove
+	BossBarUpdateHealth
+	BossBarUpdateTitle
+	BossBarUpdateStyle
+	BossBarUpdateFlags
+)
+
+// BossBar adds, removes or updates a boss bar, only the fields of Action are sent
+type BossBar struct {
+	UUID     [2]int64
+	Action   int32
+	Title    string
+	Health   float32
+	Color    int32
+	Division int32
+	Flags    byte
+}
+
+func (p *BossBar) Encode(w *Writer) {
+	w.UUID(p.UUID)
+	w.VarInt(p.Action)
+	switch p.Action {
+	case BossBarAdd:
+		w.String(p.Title)
+		w.Float(p.Health)
+		w.VarInt(p.Color)
+		w.VarInt(p.Division)
+		w.UByte(p.Flags)
+	case BossBarUpdateHealth:
+		w.Float(p.Health)
+	case BossBarUpdateTitle:
+		w.String(p.Title)
+	case BossBarUpdateStyle:
+		w.VarInt(p.Color)
+		w.VarInt(p.Division)
+	case BossBarUpdateFlags:
+		w.UByte(p.Flags)
+	}
+}
+
+func (p *BossBar) Decode(r *Reader) {
+	*p = BossBar{UUID: r.UUID(), Action: r.VarInt()}
+	switch p.Action {
+	case BossBarAdd:
+		p.Title = r.String()
+		p.Health = r.Float()
+		p.Color = r.VarInt()
+		p.Division = r.VarInt()
+		p.Flags = r.UByte()
+	case BossBarUpdateHealth:
+		p.Health = r.Float()
+	case BossBarUpdateTitle:
+		p.Title = r.String()
+	case BossBarUpdateStyle:
+		p.Color = r.VarInt()
+		p.Division = r.VarInt()
+	case BossBarUpdateFlags:
+		p.Flags = r.UByte()
+	}
+}
+
+type ServerDifficulty struct {
+	Difficulty byte
+}
+
+func (p *ServerDifficulty) Encode(w *Writer) { w.UByte(p.Difficulty) }
+func (p *ServerDifficulty) Decode(r *Reader) { p.Difficulty = r.UByte() }
+
+type TabCompleteClientbound struct {
+	Matches []string
+}
+
+func (p *TabCompleteClientbound) Encode(w *Writer) { writeStrings(w, p.Matches) }
+func (p *TabCompleteClientbound) Decode(r *Reader) { p.Matches = readStrings(r) }
+
+// ChatMessageClientbound is a message in the chat, Position 0 for the chat box, 1 for a system message and 2 above the hotbar
+type ChatMessageClientbound struct {
+	JSONData string
+	Position int8
+}
+
+func (p *ChatMessageClientbound) Encode(w *Writer) {
+	w.String(p.JSONData)
+	w.Byte(p.Position)
+}
+
+func (p *ChatMessageClientbound) Decode(r *Reader) {
+	p.JSONData = r.String()
+	p.Position = r.Byte()
+}
+
+// BlockRecord is a block changed in a chunk, X and Z are relative to the chunk
+type BlockRecord struct {
+	X, Z    byte
+	Y       byte
+	BlockID int32
+}
+
+type MultiBlockChange struct {
+	ChunkX, ChunkZ int32
+	Records        []BlockRecord
+}
+
+func (p *MultiBlockChange) Encode(w *Writer) {
+	w.Int(p.ChunkX)
+	w.Int(p.ChunkZ)
+	w.VarInt(int32(len(p.Records)))
+	for _, rec := range p.Records {
+		w.UByte(rec.X<<4 | rec.Z&0x0F)
+		w.UByte(rec.Y)
+		w.VarInt(rec.BlockID)
+	}
+}
+
+func (p *MultiBlockChange) Decode(r *Reader) {
+	p.ChunkX = r.Int()
+	p.ChunkZ = r.Int()
+	p.Records = nil
+	for i, n := 0, r.Count(); i < n; i++ {
+		xz := r.UByte()
+		p.Records = append(p.Records, BlockRecord{X: xz >> 4, Z: xz & 0x0F, Y: r.UByte(), BlockID: r.VarInt()})
+	}
+}
+
+type ConfirmTransactionClientbound struct {
+	WindowID     int8
+	ActionNumber int16
+	Accepted     bool
+}
+
+func (p *ConfirmTransactionClientbound) Encode(w *Writer) {
+	w.Byte(p.WindowID)
+	w.Short(p.ActionNumber)
+	w.Bool(p.Accepted)
+}
+
+func (p *ConfirmTransactionClientbound) Decode(r *Reader) {
+	p.WindowID = r.Byte()
+	p.ActionNumber = r.Short()
+	p.Accepted = r.Bool()
+}
+
+type CloseWindowClientbound struct {
+	WindowID byte
+}
+
+func (p *CloseWindowClientbound) Encode(w *Writer) { w.UByte(p.WindowID) }
+func (p *CloseWindowClientbound) Decode(r *Reader) { p.WindowID = r.UByte() }
+
+// OpenWindow opens a window, EntityID is only sent for the window of a horse
+type OpenWindow struct {
+	WindowID      byte
+	WindowType    string
+	WindowTitle   string
+	NumberOfSlots byte
+	EntityID      int32
+}
+
+func (p *OpenWindow) Encode(w *Writer) {
+	w.UByte(p.WindowID)
+	w.String(p.WindowType)
+	w.String(p.WindowTitle)
+	w.UByte(p.NumberOfSlots)
+	if p.WindowType == "EntityHorse" {
+		w.Int(p.EntityID)
+	}
+}
+
+func (p *OpenWindow) Decode(r *Reader) {
+	p.WindowID = r.UByte()
+	p.WindowType = r.String()
+	p.WindowTitle = r.String()
+	p.NumberOfSlots = r.UByte()
+	p.EntityID = 0
+	if p.WindowType == "EntityHorse" {
+		p.EntityID = r.Int()
+	}
+}
+
+type WindowItems struct {
+	WindowID byte
+	SlotData []Slot
+}
+
+func (p *WindowItems) Encode(w *Writer) {
+	w.UByte(p.WindowID)
+	w.Short(int16(len(p.SlotData)))
+	for _, s := range p.SlotData {
+		w.Slot(s)
+	}
+}
+
+func (p *WindowItems) Decode(r *Reader) {
+	p.WindowID = r.UByte()
+	n := int(r.Short())
+	if n < 0 || n*2 > r.Len() {
+		r.fail(errCount(n, r.Len()))
+		n = 0
+	}
+	p.SlotData = nil
+	for i := 0; i < n; i++ {
+		p.SlotData = append(p.SlotData, r.Slot())
+	}
+}
+
+type WindowProperty struct {
+	WindowID byte
+	Property int16
+	Value    int16
+}
+
+func (p *WindowProperty) Encode(w *Writer) {
+	w.UByte(p.WindowID)
+	w.Short(p.Property)
+	w.Short(p.Value)
+}
+
+func (p *WindowProperty) Decode(r *Reader) {
+	p.WindowID = r.UByte()
+	p.Property = r.Short()
+	p.Value = r.Short()
+}
+
+// SetSlot sets a slot of a window, WindowID -1 and Slot -1 for the item on the cursor
+type SetSlot struct {
+	WindowID int8
+	Slot     int16
+	SlotData Slot
+}
+
+func (p *SetSlot) Encode(w *Writer) {
+	w.Byte(p.WindowID)
+	w.Short(p.Slot)
+	w.Slot(p.SlotData)
+}
+
+func (p *SetSlot) Decode(r *Reader) {
+	p.WindowID = r.Byte()
+	p.Slot = r.Short()
+	p.SlotData = r.Slot()
+}
+
+type SetCooldown struct {
+	ItemID        int32
+	CooldownTicks int32
+}
+
+func (p *SetCooldown) Encode(w *Writer) {
+	w.VarInt(p.ItemID)
+	w.VarInt(p.CooldownTicks)
+}
+
+func (p *SetCooldown) Decode(r *Reader) {
+	p.ItemID = r.VarInt()
+	p.CooldownTicks = r.VarInt()
+}
+
+type PluginMessageClientbound struct {
+	Channel string
+	Data    []byte
+}
+
+func (p *PluginMessageClientbound) Encode(w *Writer) {
+	w.String(p.Channel)
+	w.Raw(p.Data)
+}
+
+func (p *PluginMessageClientbound) Decode(r *Reader) {
+	p.Channel = r.String()
+	p.Data = r.Rest()
+}
+
+// NamedSoundEffect plays a sound by its name, the position is in 1/8 block
+type NamedSoundEffect struct {
+	SoundName      string
+	SoundCategory  int32
+	EffectPosition [3]int32
+	Volume, Pitch  float32
+}
+
+func (p *NamedSoundEffect) Encode(w *Writer) {
+	w.String(p.SoundName)
+	w.VarInt(p.SoundCategory)
+	writeInts(w, p.EffectPosition)
+	w.Float(p.Volume)
+	w.Float(p.Pitch)
+}
+
+func (p *NamedSoundEffect) Decode(r *Reader) {
+	p.SoundName = r.String()
+	p.SoundCategory = r.VarInt()
+	p.EffectPosition = readInts(r)
+	p.Volume = r.Float()
+	p.Pitch = r.Float()
+}
+
+type Disconnect struct {
+	Reason string
+}
+
+func (p *Disconnect) Encode(w *Writer) { w.String(p.Reason) }
+func (p *Disconnect) Decode(r *Reader) { p.Reason = r.String() }
+
+type EntityStatus struct {
+	EntityID     int32
+	EntityStatus int8
+}
+
+func (p *EntityStatus) Encode(w *Writer) {
+	w.Int(p.EntityID)
+	w.Byte(p.EntityStatus)
+}
+
+func (p *EntityStatus) Decode(r *Reader) {
+	p.EntityID = r.Int()
+	p.EntityStatus = r.Byte()
+}
+
+// Explosion destroys the Records, which are relative to the center, and pushes the player
+type Explosion struct {
+	X, Y, Z      float32
+	Radius       float32
+	Records      [][3]int8
+	PlayerMotion [3]float32
+}
+
+func (p *Explosion) Encode(w *Writer) {
+	w.Float(p.X)
+	w.Float(p.Y)
+	w.Float(p.Z)
+	w.Float(p.Radius)
+	w.Int(int32(len(p.Records)))
+	for _, rec := range p.Records {
+		w.Byte(rec[0])
+		w.Byte(rec[1])
+		w.Byte(rec[2])
+	}
+	for _, f := range p.PlayerMotion {
+		w.Float(f)
+	}
+}
+
+func (p *Explosion) Decode(r *Reader) {
+	p.X = r.Float()
+	p.Y = r.Float()
+	p.Z = r.Float()
+	p.Radius = r.Float()
+	n := int(r.Int())
+	if n < 0 || n*3 > r.Len() {
+		r.fail(errCount(n, r.Len()))
+		n = 0
+	}
+	p.Records = nil
+	for i := 0; i < n; i++ {
+		p.Records = append(p.Records, [3]int8{r.Byte(), r.Byte(), r.Byte()})
+	}
+	for i := range p.PlayerMotion {
+		p.PlayerMotion[i] = r.Float()
+	}
+}
+
+type UnloadChunk struct {
+	ChunkX, ChunkZ int32
+}
+
+func (p *UnloadChunk) Encode(w *Writer) {
+	w.Int(p.ChunkX)
+	w.Int(p.ChunkZ)
+}
+
+func (p *UnloadChunk) Decode(r *Reader) {
+	p.ChunkX = r.Int()
+	p.ChunkZ = r.Int()
+}
+
+type ChangeGameState struct {
+	Reason byte
+	Value  float32
+}
+
+func (p *ChangeGameState) Encode(w *Writer) {
+	w.UByte(p.Reason)
+	w.Float(p.Value)
+}
+
+func (p *ChangeGameState) Decode(r *Reader) {
+	p.Reason = r.UByte()
+	p.Value = r.Float()
+}
+
+type KeepAliveClientbound struct {
+	KeepAliveID int64
+}
+
+func (p *KeepAliveClientbound) Encode(w *Writer) { writeKeepAliveID(w, p.KeepAliveID) }
+func (p *KeepAliveClientbound) Decode(r *Reader) { p.KeepAliveID = readKeepAliveID(r) }
+
+// ChunkData is a chunk column, Data holds the sections of PrimaryBitMask and the biomes if GroundUpContinuous
+type ChunkData struct {
+	ChunkX, ChunkZ     int32
+	GroundUpContinuous bool
+	PrimaryBitMask     int32
+	Data               []byte
+	BlockEntities      []NBT
+}
+
+func (p *ChunkData) Encode(w *Writer) {
+	w.Int(p.ChunkX)
+	w.Int(p.ChunkZ)
+	w.Bool(p.GroundUpContinuous)
+	w.VarInt(p.PrimaryBitMask)
+	w.ByteArray(p.Data)
+	w.VarInt(int32(len(p.BlockEntities)))
+	for _, tag := range p.BlockEntities {
+		w.NBT(tag)
+	}
+}
+
+func (p *ChunkData) Decode(r *Reader) {
+	p.ChunkX = r.Int()
+	p.ChunkZ = r.Int()
+	p.GroundUpContinuous = r.Bool()
+	p.PrimaryBitMask = r.VarInt()
+	p.Data = r.ByteArray()
+	p.BlockEntities = nil
+	for i, n := 0, r.Count(); i < n; i++ {
+		p.BlockEntities = append(p.BlockEntities, r.NBT())
+	}
+}
+
+type Effect struct {
+	EffectID              int32
+	Location              Vector3
+	Data                  int32
+	DisableRelativeVolume bool
+}
+
+func (p *Effect) Encode(w *Writer) {
+	w.Int(p.EffectID)
+	w.Position(p.Location)
+	w.Int(p.Data)
+	w.Bool(p.DisableRelativeVolume)
+}
+
+func (p *Effect) Decode(r *Reader) {
+	p.EffectID = r.Int()
+	p.Location = r.Position()
+	p.Data = r.Int()
+	p.DisableRelativeVolume = r.Bool()
+}
+
+// Particle spawns particles, Data has 2 elements for iconcrack, 1 for blockcrack, blockdust and fallingdust
+type Particle struct {
+	ParticleID    int32
+	LongDistance  bool
+	X, Y, Z       float32
+	Offset        [3]float32
+	ParticleData  float32
+	ParticleCount int32
+	Data          []int32
+}
+
+// particleData return the number of VarInt after a particle
+func particleData(id int32) int {
+	switch id {
+	case 36: // iconcrack
+		return 2
+	case 37, 38, 46: // blockcrack, blockdust, fallingdust
+		return 1
+	}
+	return 0
+}
+
+func (p *Particle) Encode(w *Writer) {
+	w.Int(p.ParticleID)
+	w.Bool(p.LongDistance)
+	w.Float(p.X)
+	w.Float(p.Y)
+	w.Float(p.Z)
+	for _, f := range p.Offset {
+		w.Float(f)
+	}
+	w.Float(p.ParticleData)
+	w.Int(p.ParticleCount)
+	for i := 0; i < particleData(p.ParticleID); i++ {
+		var n int32
+		if i < len(p.Data) {
+			n = p.Data[i]
+		}
+		w.VarInt(n)
+	}
+}
+
+func (p *Particle) Decode(r *Reader) {
+	p.ParticleID = r.Int()
+	p.LongDistance = r.Bool()
+	p.X = r.Float()
+	p.Y = r.Float()
+	p.Z = r.Float()
+	for i := range p.Offset {
+		p.Offset[i] = r.Float()
+	}
+	p.ParticleData = r.Float()
+	p.ParticleCount = r.Int()
+	p.Data = nil
+	for i := 0; i < particleData(p.ParticleID); i++ {
+		p.Data = append(p.Data, r.VarInt())
+	}
+}
+
+type JoinGame struct {
+	EntityID         int32
+	Gamemode         byte
+	Dimension        int32
+	Difficulty       byte
+	MaxPlayers       byte
+	LevelType        string
+	ReducedDebugInfo bool
+}
+
+func (p *JoinGame) Encode(w *Writer) {
+	w.Int(p.EntityID)
+	w.UByte(p.Gamemode)
+	w.Int(p.Dimension)
+	w.UByte(p.Difficulty)
+	w.UByte(p.MaxPlayers)
+	w.String(p.LevelType)
+	w.Bool(p.ReducedDebugInfo)
+}
+
+func (p *JoinGame) Decode(r *Reader) {
+	p.EntityID = r.Int()
+	p.Gamemode = r.UByte()
+	p.Dimension = r.Int()
+	p.Difficulty = r.UByte()
+	p.MaxPlayers = r.UByte()
+	p.LevelType = r.String()
+	p.ReducedDebugInfo = r.Bool()
+}
+
+type MapIcon struct {
+	DirectionAndType int8
+	X, Z             int8
+}
+
+// Map updates a map item, the colors are only sent if Columns isn't 0
+type Map struct {
+	ItemDamage       int32
+	Scale            int8
+	TrackingPosition bool
+	Icons            []MapIcon
+	Columns          byte
+	Rows             byte
+	X, Z             int8
+	Data             []byte
+}
+
+func (p *Map) Encode(w *Writer) {
+	w.VarInt(p.ItemDamage)
+	w.Byte(p.Scale)
+	w.Bool(p.TrackingPosition)
+	w.VarInt(int32(len(p.Icons)))
+	for _, icon := range p.Icons {
+		w.Byte(icon.DirectionAndType)
+		w.Byte(icon.X)
+		w.Byte(icon.Z)
+	}
+	w.UByte(p.Columns)
+	if p.Columns > 0 {
+		w.UByte(p.Rows)
+		w.Byte(p.X)
+		w.Byte(p.Z)
+		w.ByteArray(p.Data)
+	}
+}
+
+func (p *Map) Decode(r *Reader) {
+	*p = Map{ItemDamage: r.VarInt(), Scale: r.Byte(), TrackingPosition: r.Bool()}
+	for i, n := 0, r.Count(); i < n; i++ {
+		p.Icons = append(p.Icons, MapIcon{DirectionAndType: r.Byte(), X: r.Byte(), Z: r.Byte()})
+	}
+	if p.Columns = r.UByte(); p.Columns > 0 {
+		p.Rows = r.UByte()
+		p.X = r.Byte()
+		p.Z = r.Byte()
+		p.Data = r.ByteArray()
+	}
+}
+
+// Entity is sent when an entity doesn't move
+type Entity struct {
+	EntityID int32
+}
+
+func (p *Entity) Encode(w *Writer) { w.VarInt(p.EntityID) }
+func (p *Entity) Decode(r *Reader) { p.EntityID = r.VarInt() }
+
+// EntityRelativeMove moves an entity by Delta/4096 blocks
+type EntityRelativeMove struct {
+	EntityID int32
+	Delta    [3]int16
+	OnGround bool
+}
+
+func (p *EntityRelativeMove) Encode(w *Writer) {
+	w.VarInt(p.EntityID)
+	writeShorts(w, p.Delta)
+	w.Bool(p.OnGround)
+}
+
+func (p *EntityRelativeMove) Decode(r *Reader) {
+	p.EntityID = r.VarInt()
+	p.Delta = readShorts(r)
+	p.OnGround = r.Bool()
+}
+
+type EntityLookAndRelativeMove struct {
+	EntityID   int32
+	Delta      [3]int16
+	Yaw, Pitch float32
+	OnGround   bool
+}
+
+func (p *EntityLookAndRelativeMove) Encode(w *Writer) {
+	w.VarInt(p.EntityID)
+	writeShorts(w, p.Delta)
+	w.Angle(p.Yaw)
+	w.Angle(p.Pitch)
+	w.Bool(p.OnGround)
+}
+
+func (p *EntityLookAndRelativeMove) Decode(r *Reader) {
+	p.EntityID = r.VarInt()
+	p.Delta = readShorts(r)
+	p.Yaw = r.Angle()
+	p.Pitch = r.Angle()
+	p.OnGround = r.Bool()
+}
+
+type EntityLook struct {
+	EntityID   int32
+	Yaw, Pitch float32
+	OnGround   bool
+}
+
+func (p *EntityLook) Encode(w *Writer) {
+	w.VarInt(p.EntityID)
+	w.Angle(p.Yaw)
+	w.Angle(p.Pitch)
+	w.Bool(p.OnGround)
+}
+
+func (p *EntityLook) Decode(r *Reader) {
+	p.EntityID = r.VarInt()
+	p.Yaw = r.Angle()
+	p.Pitch = r.Angle()
+	p.OnGround = r.Bool()
+}
+
+type VehicleMoveClientbound struct {
+	Position   Vector3
+	Yaw, Pitch float32
+}
+
+func (p *VehicleMoveClientbound) Encode(w *Writer) {
+	writeVector(w, p.Position)
+	w.Float(p.Yaw)
+	w.Float(p.Pitch)
+}
+
+func (p *VehicleMoveClientbound) Decode(r *Reader) {
+	p.Position = readVector(r)
+	p.Yaw = r.Float()
+	p.Pitch = r.Float()
+}
+
+type OpenSignEditor struct {
+	Location Vector3
+}
+
+func (p *OpenSignEditor) Encode(w *Writer) { w.Position(p.Location) }
+func (p *OpenSignEditor) Decode(r *Reader) { p.Location = r.Position() }
+
+type CraftRecipeResponse struct {
+	WindowID int8
+	Recipe   int32
+}
+
+func (p *CraftRecipeResponse) Encode(w *Writer) {
+	w.Byte(p.WindowID)
+	w.VarInt(p.Recipe)
+}
+
+func (p *CraftRecipeResponse) Decode(r *Reader) {
+	p.WindowID = r.Byte()
+	p.Recipe = r.VarInt()
+}
+
+// PlayerAbilitiesClientbound flags: 0x01 invulnerable, 0x02 flying, 0x04 allow flying, 0x08 creative mode
+type PlayerAbilitiesClientbound struct {
+	Flags               int8
+	FlyingSpeed         float32
+	FieldOfViewModifier float32
+}
+
+func (p *PlayerAbilitiesClientbound) Encode(w *Writer) {
+	w.Byte(p.Flags)
+	w.Float(p.FlyingSpeed)
+	w.Float(p.FieldOfViewModifier)
+}
+
+func (p *PlayerAbilitiesClientbound) Decode(r *Reader) {
+	p.Flags = r.Byte()
+	p.FlyingSpeed = r.Float()
+	p.FieldOfViewModifier = r.Float()
+}
+
+// The events of CombatEvent
+const (
+	CombatEnter int32 = iota
+	CombatEnd
+	CombatEntityDead
+)
+
+// CombatEvent, Duration is only sent at the end of a combat, PlayerID and Message when the player dies
+type CombatEvent struct {
+	Event    int32
+	Duration int32
+	PlayerID int32
+	EntityID int32
+	Message  string
+}
+
+func (p *CombatEvent) Encode(w *Writer) {
+	w.VarInt(p.Event)
+	switch p.Event {
+	case CombatEnd:
+		w.VarInt(p.Duration)
+		w.Int(p.EntityID)
+	case CombatEntityDead:
+		w.VarInt(p.PlayerID)
+		w.Int(p.EntityID)
+		w.String(p.Message)
+	}
+}
+
+func (p *CombatEvent) Decode(r *Reader) {
+	*p = CombatEvent{Event: r.VarInt()}
+	switch p.Event {
+	case CombatEnd:
+		p.Duration = r.VarInt()
+		p.EntityID = r.Int()
+	case CombatEntityDead:
+		p.PlayerID = r.VarInt()
+		p.EntityID = r.Int()
+		p.Message = r.String()
+	}
+}
+
+// The actions of PlayerListItem
+const (
+	PlayerListAdd int32 = iota
+	PlayerListUpdateGamemode
+	PlayerListUpdateLatency
+	PlayerListUpdateDisplayName
+	PlayerListRemove
+)
+
+type PlayerProperty struct {
+	Name      string
+	Value     string
+	Signature *string
+}
+
+// PlayerListEntry is a player of PlayerListItem, only the fields of the action are sent
+type PlayerListEntry struct {
+	UUID        [2]int64
+	Name        string
+	Properties  []PlayerProperty
+	Gamemode    int32
+	Ping        int32
+	DisplayName *string
+}
+
+type PlayerListItem struct {
+	Action  int32
+	Players []PlayerListEntry
+}
+
+func (p *PlayerListItem) Encode(w *Writer) {
+	w.VarInt(p.Action)
+	w.VarInt(int32(len(p.Players)))
+	for _, pl := range p.Players {
+		w.UUID(pl.UUID)
+		switch p.Action {
+		case PlayerListAdd:
+			w.String(pl.Name)
+			w.VarInt(int32(len(pl.Properties)))
+			for _, prop := range pl.Properties {
+				w.String(prop.Name)
+				w.String(prop.Value)
+				writeOptString(w, prop.Signature)
+			}
+			w.VarInt(pl.Gamemode)
+			w.VarInt(pl.Ping)
+			writeOptString(w, pl.DisplayName)
+		case PlayerListUpdateGamemode:
+			w.VarInt(pl.Gamemode)
+		case PlayerListUpdateLatency:
+			w.VarInt(pl.Ping)
+		case PlayerListUpdateDisplayName:
+			writeOptString(w, pl.DisplayName)
+		}
+	}
+}
+
+func (p *PlayerListItem) Decode(r *Reader) {
+	*p = PlayerListItem{Action: r.VarInt()}
+	for i, n := 0, r.Count(); i < n; i++ {
+		pl := PlayerListEntry{UUID: r.UUID()}
+		switch p.Action {
+		case PlayerListAdd:
+			pl.Name = r.String()
+			for j, m := 0, r.Count(); j < m; j++ {
+				pl.Properties = append(pl.Properties, PlayerProperty{Name: r.String(), Value: r.String(), Signature: readOptString(r)})
+			}
+			pl.Gamemode = r.VarInt()
+			pl.Ping = r.VarInt()
+			pl.DisplayName = readOptString(r)
+		case PlayerListUpdateGamemode:
+			pl.Gamemode = r.VarInt()
+		case PlayerListUpdateLatency:
+			pl.Ping = r.VarInt()
+		case PlayerListUpdateDisplayName:
+			pl.DisplayName = readOptString(r)
+		}
+		p.Players = append(p.Players, pl)
+	}
+}
+
+// PlayerPositionAndLookClientbound teleports the player, the bits of Flags make X, Y, Z, Yaw, Pitch relative.
+// It must be confirmed with TeleportConfirm.
+type PlayerPositionAndLookClientbound struct {
+	Position   Vector3
+	Yaw, Pitch float32
+	Flags      int8
+	TeleportID int32
+}
+
+func (p *PlayerPositionAndLookClientbound) Encode(w *Writer) {
+	writeVector(w, p.Position)
+	w.Float(p.Yaw)
+	w.Float(p.Pitch)
+	w.Byte(p.Flags)
+	w.VarInt(p.TeleportID)
+}
+
+func (p *PlayerPositionAndLookClientbound) Decode(r *Reader) {
+	p.Position = readVector(r)
+	p.Yaw = r.Float()
+	p.Pitch = r.Float()
+	p.Flags = r.Byte()
+	p.TeleportID = r.VarInt()
+}
+
+type UseBed struct {
+	EntityID int32
+	Location Vector3
+}
+
+func (p *UseBed) Encode(w *Writer) {
+	w.VarInt(p.EntityID)
+	w.Position(p.Location)
+}
+
+func (p *UseBed) Decode(r *Reader) {
+	p.EntityID = r.VarInt()
+	p.Location = r.Position()
+}
+
+// UnlockRecipes, Action 0 init (with the recipes to display), 1 add, 2 remove
+type UnlockRecipes struct {
+	Action             int32
+	CraftingBookOpen   bool
+	FilteringCraftable bool
+	RecipeIDs          []int32
+	InitRecipeIDs      []int32
+}
+
+func (p *UnlockRecipes) Encode(w *Writer) {
+	w.VarInt(p.Action)
+	w.Bool(p.CraftingBookOpen)
+	w.Bool(p.FilteringCraftable)
+	writeVarInts(w, p.RecipeIDs)
+	if p.Action == 0 {
+		writeVarInts(w, p.InitRecipeIDs)
+	}
+}
+
+func (p *UnlockRecipes) Decode(r *Reader) {
+	*p = UnlockRecipes{Action: r.VarInt(), CraftingBookOpen: r.Bool(), FilteringCraftable: r.Bool()}
+	p.RecipeIDs = readVarInts(r)
+	if p.Action == 0 {
+		p.InitRecipeIDs = readVarInts(r)
+	}
+}
+
+type DestroyEntities struct {
+	EntityIDs []int32
+}
+
+func (p *DestroyEntities) Encode(w *Writer) { writeVarInts(w, p.EntityIDs) }
+func (p *DestroyEntities) Decode(r *Reader) { p.EntityIDs = readVarInts(r) }
+
+type RemoveEntityEffect struct {
+	EntityID int32
+	EffectID int8
+}
+
+func (p *RemoveEntityEffect) Encode(w *Writer) {
+	w.VarInt(p.EntityID)
+	w.Byte(p.EffectID)
+}
+
+func (p *RemoveEntityEffect) Decode(r *Reader) {
+	p.EntityID = r.VarInt()
+	p.EffectID = r.Byte()
+}
+
+type ResourcePackSend struct {
+	URL  string
+	Hash string
+}
+
+func (p *ResourcePackSend) Encode(w *Writer) {
+	w.String(p.URL)
+	w.String(p.Hash)
+}
+
+func (p *ResourcePackSend) Decode(r *Reader) {
+	p.URL = r.String()
+	p.Hash = r.String()
+}
+
+type Respawn struct {
+	Dimension  int32
+	Difficulty byte
+	Gamemode   byte
+	LevelType  string
+}
+
+func (p *Respawn) Encode(w *Writer) {
+	w.Int(p.Dimension)
+	w.UByte(p.Difficulty)
+	w.UByte(p.Gamemode)
+	w.String(p.LevelType)
+}
+
+func (p *Respawn) Decode(r *Reader) {
+	p.Dimension = r.Int()
+	p.Difficulty = r.UByte()
+	p.Gamemode = r.UByte()
+	p.LevelType = r.String()
+}
+
+type EntityHeadLook struct {
+	EntityID int32
+	HeadYaw  float32
+}
+
+func (p *EntityHeadLook) Encode(w *Writer) {
+	w.VarInt(p.EntityID)
+	w.Angle(p.HeadYaw)
+}
+
+func (p *EntityHeadLook) Decode(r *Reader) {
+	p.EntityID = r.VarInt()
+	p.HeadYaw = r.Angle()
+}
+
+type SelectAdvancementTab struct {
+	Identifier *string
+}
+
+func (p *SelectAdvancementTab) Encode(w *Writer) { writeOptString(w, p.Identifier) }
+func (p *SelectAdvancementTab) Decode(r *Reader) { p.Identifier = readOptString(r) }
+
+// The actions of WorldBorder
+const (
+	WorldBorderSetSize int32 = iota
+	WorldBorderLerpSize
+	WorldBorderSetCenter
+	WorldBorderInitialize
+	WorldBorderSetWarningTime
+	WorldBorderSetWarningBlocks
+)
+
+// WorldBorder, only the fields of Action are sent. Speed is in milliseconds.
+type WorldBorder struct {
+	Action                 int32
+	X, Z                   float64
+	OldDiameter            float64
+	NewDiameter            float64 // the diameter of WorldBorderSetSize
+	Speed                  int64
+	PortalTeleportBoundary int32
+	WarningTime            int32
+	WarningBlocks          int32
+}
+
+func (p *WorldBorder) Encode(w *Writer) {
+	w.VarInt(p.Action)
+	switch p.Action {
+	case WorldBorderSetSize:
+		w.Double(p.NewDiameter)
+	case WorldBorderLerpSize:
+		w.Double(p.OldDiameter)
+		w.Double(p.NewDiameter)
+		w.VarLong(p.Speed)
+	case WorldBorderSetCenter:
+		w.Double(p.X)
+		w.Double(p.Z)
+	case WorldBorderInitialize:
+		w.Double(p.X)
+		w.Double(p.Z)
+		w.Double(p.OldDiameter)
+		w.Double(p.NewDiameter)
+		w.VarLong(p.Speed)
+		w.VarInt(p.PortalTeleportBoundary)
+		w.VarInt(p.WarningTime)
+		w.VarInt(p.WarningBlocks)
+	case WorldBorderSetWarningTime:
+		w.VarInt(p.WarningTime)
+	case WorldBorderSetWarningBlocks:
+		w.VarInt(p.WarningBlocks)
+	}
+}
+
+func (p *WorldBorder) Decode(r *Reader) {
+	*p = WorldBorder{Action: r.VarInt()}
+	switch p.Action {
+	case WorldBorderSetSize:
+		p.NewDiameter = r.Double()
+	case WorldBorderLerpSize:
+		p.OldDiameter = r.Double()
+		p.NewDiameter = r.Double()
+		p.Speed = r.VarLong()
+	case WorldBorderSetCenter:
+		p.X = r.Double()
+		p.Z = r.Double()
+	case WorldBorderInitialize:
+		p.X = r.Double()
+		p.Z = r.Double()
+		p.OldDiameter = r.Double()
+		p.NewDiameter = r.Double()
+		p.Speed = r.VarLong()
+		p.PortalTeleportBoundary = r.VarInt()
+		p.WarningTime = r.VarInt()
+		p.WarningBlocks = r.VarInt()
+	case WorldBorderSetWarningTime:
+		p.WarningTime = r.VarInt()
+	case WorldBorderSetWarningBlocks:
+		p.WarningBlocks = r.VarInt()
+	}
+}
+
+type Camera struct {
+	CameraID int32
+}
+
+func (p *Camera) Encode(w *Writer) { w.VarInt(p.CameraID) }
+func (p *Camera) Decode(r *Reader) { p.CameraID = r.VarInt() }
+
+// HeldItemChangeClientbound selects a slot of the hotbar, 0 to 8
+type HeldItemChangeClientbound struct {
+	Slot int8
+}
+
+func (p *HeldItemChangeClientbound) Encode(w *Writer) { w.Byte(p.Slot) }
+func (p *HeldItemChangeClientbound) Decode(r *Reader) { p.Slot = r.Byte() }
+
+type DisplayScoreboard struct {
+	Position  int8
+	ScoreName string
+}
+
+func (p *DisplayScoreboard) Encode(w *Writer) {
+	w.Byte(p.Position)
+	w.String(p.ScoreName)
+}
+
+func (p *DisplayScoreboard) Decode(r *Reader) {
+	p.Position = r.Byte()
+	p.ScoreName = r.String()
+}
+
+// EntityMetadata updates the metadata of an entity
+type EntityMetadata struct {
+	EntityID int32
+	Metadata Metadata
+}
+
+func (p *EntityMetadata) Encode(w *Writer) {
+	w.VarInt(p.EntityID)
+	w.Metadata(p.Metadata)
+}
+
+func (p *EntityMetadata) Decode(r *Reader) {
+	p.EntityID = r.VarInt()
+	p.Metadata = r.Metadata()
+}
+
+// AttachEntity leashes an entity, HoldingEntityID -1 to detach it
+type AttachEntity struct {
+	AttachedEntityID int32
+	HoldingEntityID  int32
+}
+
+func (p *AttachEntity) Encode(w *Writer) {
+	w.Int(p.AttachedEntityID)
+	w.Int(p.HoldingEntityID)
+}
+
+func (p *AttachEntity) Decode(r *Reader) {
+	p.AttachedEntityID = r.Int()
+	p.HoldingEntityID = r.Int()
+}
+
+type EntityVelocity struct {
+	EntityID int32
+	Velocity [3]int16
+}
+
+func (p *EntityVelocity) Encode(w *Writer) {
+	w.VarInt(p.EntityID)
+	writeShorts(w, p.Velocity)
+}
+
+func (p *EntityVelocity) Decode(r *Reader) {
+	p.EntityID = r.VarInt()
+	p.Velocity = readShorts(r)
+}
+
+// EntityEquipment, Slot 0 main hand, 1 off hand, 2 to 5 boots to helmet
+type EntityEquipment struct {
+	EntityID int32
+	Slot     int32
+	Item     Slot
+}
+
+func (p *EntityEquipment) Encode(w *Writer) {
+	w.VarInt(p.EntityID)
+	w.VarInt(p.Slot)
+	w.Slot(p.Item)
+}
+
+func (p *EntityEquipment) Decode(r *Reader) {
+	p.EntityID = r.VarInt()
+	p.Slot = r.VarInt()
+	p.Item = r.Slot()
+}
+
+type SetExperience struct {
+	ExperienceBar   float32
+	Level           int32
+	TotalExperience int32
+}
+
+func (p *SetExperience) Encode(w *Writer) {
+	w.Float(p.ExperienceBar)
+	w.VarInt(p.Level)
+	w.VarInt(p.TotalExperience)
+}
+
+func (p *SetExperience) Decode(r *Reader) {
+	p.ExperienceBar = r.Float()
+	p.Level = r.VarInt()
+	p.TotalExperience = r.VarInt()
+}
+
+type UpdateHealth struct {
+	Health         float32
+	Food           int32
+	FoodSaturation float32
+}
+
+func (p *UpdateHealth) Encode(w *Writer) {
+	w.Float(p.Health)
+	w.VarInt(p.Food)
+	w.Float(p.FoodSaturation)
+}
+
+func (p *UpdateHealth) Decode(r *Reader) {
+	p.Health = r.Float()
+	p.Food = r.VarInt()
+	p.FoodSaturation = r.Float()
+}
+
+// ScoreboardObjective, Mode 0 create, 1 remove, 2 update. Value and Type aren't sent to remove it.
+type ScoreboardObjective struct {
+	ObjectiveName string
+	Mode          int8
+	Value         string
+	Type          string
+}
+
+func (p *ScoreboardObjective) Encode(w *Writer) {
+	w.String(p.ObjectiveName)
+	w.Byte(p.Mode)
+	if p.Mode == 0 || p.Mode == 2 {
+		w.String(p.Value)
+		w.String(p.Type)
+	}
+}
+
+func (p *ScoreboardObjective) Decode(r *Reader) {
+	*p = ScoreboardObjective{ObjectiveName: r.String(), Mode: r.Byte()}
+	if p.Mode == 0 || p.Mode == 2 {
+		p.Value = r.String()
+		p.Type = r.String()
+	}
+}
+
+type SetPassengers struct {
+	EntityID   int32
+	Passengers []int32
+}
+
+func (p *SetPassengers) Encode(w *Writer) {
+	w.VarInt(p.EntityID)
+	writeVarInts(w, p.Passengers)
+}
+
+func (p *SetPassengers) Decode(r *Reader) {
+	p.EntityID = r.VarInt()
+	p.Passengers = readVarInts(r)
+}
+
+// The modes of Teams
+const (
+	TeamCreate int8 = iota
+	TeamRemove
+	TeamUpdate
+	TeamAddEntities
+	TeamRemoveEntities
+)
+
+// Teams creates, removes or updates a team, only the fields of Mode are sent
+type Teams struct {
+	TeamName          string
+	Mode              int8
+	DisplayName       string
+	Prefix            string
+	Suffix            string
+	FriendlyFlags     int8
+	NameTagVisibility string
+	CollisionRule     string
+	Color             int8
+	Entities          []string
+}
+
+func (p *Teams) Encode(w *Writer) {
+	w.String(p.TeamName)
+	w.Byte(p.Mode)
+	if p.Mode == TeamCreate || p.Mode == TeamUpdate {
+		w.String(p.DisplayName)
+		w.String(p.Prefix)
+		w.String(p.Suffix)
+		w.Byte(p.FriendlyFlags)
+		w.String(p.NameTagVisibility)
+		w.String(p.CollisionRule)
+		w.Byte(p.Color)
+	}
+	if p.Mode == TeamCreate || p.Mode == TeamAddEntities || p.Mode == TeamRemoveEntities {
+		writeStrings(w, p.Entities)
+	}
+}
+
+func (p *Teams) Decode(r *Reader) {
+	*p = Teams{TeamName: r.String(), Mode: r.Byte()}
+	if p.Mode == TeamCreate || p.Mode == TeamUpdate {
+		p.DisplayName = r.String()
+		p.Prefix = r.String()
+		p.Suffix = r.String()
+		p.FriendlyFlags = r.Byte()
+		p.NameTagVisibility = r.String()
+		p.CollisionRule = r.String()
+		p.Color = r.Byte()
+	}
+	if p.Mode == TeamCreate || p.Mode == TeamAddEntities || p.Mode == TeamRemoveEntities {
+		p.Entities = readStrings(r)
+	}
+}
+
+// UpdateScore, Action 0 update and 1 remove, without Value
+type UpdateScore struct {
+	EntityName    string
+	Action        int8
+	ObjectiveName string
+	Value         int32
+}
+
+func (p *UpdateScore) Encode(w *Writer) {
+	w.String(p.EntityName)
+	w.Byte(p.Action)
+	w.String(p.ObjectiveName)
+	if p.Action != 1 {
+		w.VarInt(p.Value)
+	}
+}
+
+func (p *UpdateScore) Decode(r *Reader) {
+	*p = UpdateScore{EntityName: r.String(), Action: r.Byte(), ObjectiveName: r.String()}
+	if p.Action != 1 {
+		p.Value = r.VarInt()
+	}
+}
+
+type SpawnPosition struct {
+	Location Vector3
+}
+
+func (p *SpawnPosition) Encode(w *Writer) { w.Position(p.Location) }
+func (p *SpawnPosition) Decode(r *Reader) { p.Location = r.Position() }
+
+type TimeUpdate struct {
+	WorldAge  int64
+	TimeOfDay int64
+}
+
+func (p *TimeUpdate) Encode(w *Writer) {
+	w.Long(p.WorldAge)
+	w.Long(p.TimeOfDay)
+}
+
+func (p *TimeUpdate) Decode(r *Reader) {
+	p.WorldAge = r.Long()
+	p.TimeOfDay = r.Long()
+}
+
+// The actions of Title
+const (
+	TitleSetTitle int32 = iota
+	TitleSetSubtitle
+	TitleSetActionBar
+	TitleSetTimes
+	TitleHide
+	TitleReset
+)
+
+// Title, Text is the text of the first 3 actions, the times are in ticks
+type Title struct {
+	Action                int32
+	Text                  string
+	FadeIn, Stay, FadeOut int32
+}
+
+func (p *Title) Encode(w *Writer) {
+	w.VarInt(p.Action)
+	switch p.Action {
+	case TitleSetTitle, TitleSetSubtitle, TitleSetActionBar:
+		w.String(p.Text)
+	case TitleSetTimes:
+		w.Int(p.FadeIn)
+		w.Int(p.Stay)
+		w.Int(p.FadeOut)
+	}
+}
+
+func (p *Title) Decode(r *Reader) {
+	*p = Title{Action: r.VarInt()}
+	switch p.Action {
+	case TitleSetTitle, TitleSetSubtitle, TitleSetActionBar:
+		p.Text = r.String()
+	case TitleSetTimes:
+		p.FadeIn = r.Int()
+		p.Stay = r.Int()
+		p.FadeOut = r.Int()
+	}
+}
+
+// SoundEffect plays a sound by its ID, the position is in 1/8 block
+type SoundEffect struct {
+	SoundID        int32
+	SoundCategory  int32
+	EffectPosition [3]int32
+	Volume, Pitch  float32
+}
+
+func (p *SoundEffect) Encode(w *Writer) {
+	w.VarInt(p.SoundID)
+	w.VarInt(p.SoundCategory)
+	writeInts(w, p.EffectPosition)
+	w.Float(p.Volume)
+	w.Float(p.Pitch)
+}
+
+func (p *SoundEffect) Decode(r *Reader) {
+	p.SoundID = r.VarInt()
+	p.SoundCategory = r.VarInt()
+	p.EffectPosition = readInts(r)
+	p.Volume = r.Float()
+	p.Pitch = r.Float()
+}
+
+type PlayerListHeaderAndFooter struct {
+	Header string
+	Footer string
+}
+
+func (p *PlayerListHeaderAndFooter) Encode(w *Writer) {
+	w.String(p.Header)
+	w.String(p.Footer)
+}
+
+func (p *PlayerListHeaderAndFooter) Decode(r *Reader) {
+	p.Header = r.String()
+	p.Footer = r.String()
+}
+
+type CollectItem struct {
+	CollectedEntityID int32
+	CollectorEntityID int32
+	PickupItemCount   int32
+}
+
+func (p *CollectItem) Encode(w *Writer) {
+	w.VarInt(p.CollectedEntityID)
+	w.VarInt(p.CollectorEntityID)
+	w.VarInt(p.PickupItemCount)
+}
+
+func (p *CollectItem) Decode(r *Reader) {
+	p.CollectedEntityID = r.VarInt()
+	p.CollectorEntityID = r.VarInt()
+	p.PickupItemCount = r.VarInt()
+}
+
+type EntityTeleport struct {
+	EntityID   int32
+	Position   Vector3
+	Yaw, Pitch float32
+	OnGround   bool
+}
+
+func (p *EntityTeleport) Encode(w *Writer) {
+	w.VarInt(p.EntityID)
+	writeVector(w, p.Position)
+	w.Angle(p.Yaw)
+	w.Angle(p.Pitch)
+	w.Bool(p.OnGround)
+}
+
+func (p *EntityTeleport) Decode(r *Reader) {
+	p.EntityID = r.VarInt()
+	p.Position = readVector(r)
+	p.Yaw = r.Angle()
+	p.Pitch = r.Angle()
+	p.OnGround = r.Bool()
+}
+
+// AdvancementDisplay is how an advancement is shown, BackgroundTexture is sent with the flag 0x01
+type AdvancementDisplay struct {
+	Title             string
+	Description       string
+	Icon              Slot
+	FrameType         int32
+	Flags             int32
+	BackgroundTexture string
+	X, Y              float32
+}
+
+type Advancement struct {
+	ID           string
+	ParentID     *string
+	Display      *AdvancementDisplay
+	Criteria     []string
+	Requirements [][]string
+}
+
+// CriterionProgress is a criterion of an advancement, Achieved is nil if it's not done
+type CriterionProgress struct {
+	ID       string
+	Achieved *int64
+}
+
+type AdvancementProgress struct {
+	ID       string
+	Criteria []CriterionProgress
+}
+
+type Advancements struct {
+	ResetClear   bool
+	Advancements []Advancement
+	Removed      []string
+	Progress     []AdvancementProgress
+}
+
+func (p *Advancements) Encode(w *Writer) {
+	w.Bool(p.ResetClear)
+	w.VarInt(int32(len(p.Advancements)))
+	for _, a := range p.Advancements {
+		w.String(a.ID)
+		writeOptString(w, a.ParentID)
+		w.Bool(a.Display != nil)
+		if d := a.Display; d != nil {
+			w.String(d.Title)
+			w.String(d.Description)
+			w.Slot(d.Icon)
+			w.VarInt(d.FrameType)
+			w.Int(d.Flags)
+			if d.Flags&0x01 != 0 {
+				w.String(d.BackgroundTexture)
+			}
+			w.Float(d.X)
+			w.Float(d.Y)
+		}
+		writeStrings(w, a.Criteria)
+		w.VarInt(int32(len(a.Requirements)))
+		for _, req := range a.Requirements {
+			writeStrings(w, req)
+		}
+	}
+	writeStrings(w, p.Removed)
+	w.VarInt(int32(len(p.Progress)))
+	for _, pr := range p.Progress {
+		w.String(pr.ID)
+		w.VarInt(int32(len(pr.Criteria)))
+		for _, c := range pr.Criteria {
+			w.String(c.ID)
+			w.Bool(c.Achieved != nil)
+			if c.Achieved != nil {
+				w.Long(*c.Achieved)
+			}
+		}
+	}
+}
+
+func (p *Advancements) Decode(r *Reader) {
+	*p = Advancements{ResetClear: r.Bool()}
+	for i, n := 0, r.Count(); i < n; i++ {
+		a := Advancement{ID: r.String(), ParentID: readOptString(r)}
+		if r.Bool() {
+			d := &AdvancementDisplay{
+				Title:       r.String(),
+				Description: r.String(),
+				Icon:        r.Slot(),
+				FrameType:   r.VarInt(),
+				Flags:       r.Int(),
+			}
+			if d.Flags&0x01 != 0 {
+				d.BackgroundTexture = r.String()
+			}
+			d.X = r.Float()
+			d.Y = r.Float()
+			a.Display = d
+		}
+		a.Criteria = readStrings(r)
+		for j, m := 0, r.Count(); j < m; j++ {
+			a.Requirements = append(a.Requirements, readStrings(r))
+		}
+		p.Advancements = append(p.Advancements, a)
+	}
+	p.Removed = readStrings(r)
+	for i, n := 0, r.Count(); i < n; i++ {
+		pr := AdvancementProgress{ID: r.String()}
+		for j, m := 0, r.Count(); j < m; j++ {
+			c := CriterionProgress{ID: r.String()}
+			if r.Bool() {
+				date := r.Long()
+				c.Achieved = &date
+			}
+			pr.Criteria = append(pr.Criteria, c)
+		}
+		p.Progress = append(p.Progress, pr)
+	}
+}
+
+// AttributeModifier, Operation 0 add, 1 add a percentage, 2 multiply
+type AttributeModifier struct {
+	UUID      [2]int64
+	Amount    float64
+	Operation int8
+}
+
+type EntityProperty struct {
+	Key       string
+	Value     float64
+	Modifiers []AttributeModifier
+}
+
+type EntityProperties struct {
+	EntityID   int32
+	Properties []EntityProperty
+}
+
+func (p *EntityProperties) Encode(w *Writer) {
+	w.VarInt(p.EntityID)
+	w.Int(int32(len(p.Properties)))
+	for _, prop := range p.Properties {
+		w.String(prop.Key)
+		w.Double(prop.Value)
+		w.VarInt(int32(len(prop.Modifiers)))
+		for _, m := range prop.Modifiers {
+			w.UUID(m.UUID)
+			w.Double(m.Amount)
+			w.Byte(m.Operation)
+		}
+	}
+}
+
+func (p *EntityProperties) Decode(r *Reader) {
+	*p = EntityProperties{EntityID: r.VarInt()}
+	n := int(r.Int())
+	if n < 0 || n > r.Len() {
+		r.fail(errCount(n, r.Len()))
+		n = 0
+	}
+	for i := 0; i < n; i++ {
+		prop := EntityProperty{Key: r.String(), Value: r.Double()}
+		for j, m := 0, r.Count(); j < m; j++ {
+			prop.Modifiers = append(prop.Modifiers, AttributeModifier{UUID: r.UUID(), Amount: r.Double(), Operation: r.Byte()})
+		}
+		p.Properties = append(p.Properties, prop)
+	}
+}
+
+// EntityEffect, Duration in ticks, Flags 0x01 ambient and 0x02 show particles
+type EntityEffect struct {
+	EntityID  int32
+	EffectID  int8
+	Amplifier int8
+	Duration  int32
+	Flags     int8
+}
+
+func (p *EntityEffect) Encode(w *Writer) {
+	w.VarInt(p.EntityID)
+	w.Byte(p.EffectID)
+	w.Byte(p.Amplifier)
+	w.VarInt(p.Duration)
+	w.Byte(p.Flags)
+}
+
+func (p *EntityEffect) Decode(r *Reader) {
+	p.EntityID = r.VarInt()
+	p.EffectID = r.Byte()
+	p.Amplifier = r.Byte()
+	p.Duration = r.VarInt()
+	p.Flags = r.Byte()
+}
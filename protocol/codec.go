@@ -0,0 +1,394 @@
+package protocol
+
+import (
+	"bytes"
+	"fmt"
+	. "github.com/edouard127/mc-go-1.12.2/maths"
+	"github.com/edouard127/mc-go-1.12.2/nbt"
+	pk "github.com/edouard127/mc-go-1.12.2/packet"
+	"io"
+	"math"
+)
+
+// Packet is the data of a packet, without its ID which depends on the protocol version, see Registry
+type Packet interface {
+	Encode(w *Writer)
+	Decode(r *Reader)
+}
+
+// Marshal return the data of p, in the layout of the latest version
+func Marshal(p Packet) []byte {
+	return marshal(p, 0)
+}
+
+// Unmarshal read p from data, in the layout of the latest version. All the data must be read.
+func Unmarshal(data []byte, p Packet) error {
+	return unmarshal(data, p, 0)
+}
+
+func marshal(p Packet, version int32) []byte {
+	w := Writer{Version: version}
+	p.Encode(&w)
+	return w.Bytes()
+}
+
+func unmarshal(data []byte, p Packet, version int32) error {
+	r := NewReader(data)
+	r.Version = version
+	p.Decode(r)
+	if r.err != nil {
+		return fmt.Errorf("read %T fail: %v", p, r.err)
+	}
+	if n := r.r.Len(); n > 0 {
+		return fmt.Errorf("read %T fail: %d bytes left", p, n)
+	}
+	return nil
+}
+
+// Writer writes the fields of a packet
+type Writer struct {
+	Version int32 // the protocol version of the packet, 0 for the latest
+
+	buf []byte
+}
+
+// Bytes return what is written
+func (w *Writer) Bytes() []byte { return w.buf }
+
+func (w *Writer) Bool(b bool)        { w.buf = append(w.buf, pk.PackBoolean(b)) }
+func (w *Writer) Byte(n int8)        { w.buf = append(w.buf, byte(n)) }
+func (w *Writer) UByte(n byte)       { w.buf = append(w.buf, n) }
+func (w *Writer) Short(n int16)      { w.buf = append(w.buf, pk.PackUint16(uint16(n))...) }
+func (w *Writer) UShort(n uint16)    { w.buf = append(w.buf, pk.PackUint16(n)...) }
+func (w *Writer) Int(n int32)        { w.buf = append(w.buf, pk.PackUint32(uint32(n))...) }
+func (w *Writer) Long(n int64)       { w.buf = append(w.buf, pk.PackUint64(uint64(n))...) }
+func (w *Writer) Float(f float32)    { w.buf = append(w.buf, pk.PackFloat(f)...) }
+func (w *Writer) Double(d float64)   { w.buf = append(w.buf, pk.PackDouble(d)...) }
+func (w *Writer) VarInt(n int32)     { w.buf = append(w.buf, pk.PackVarInt(n)...) }
+func (w *Writer) String(s string)    { w.buf = append(w.buf, pk.PackString(s)...) }
+func (w *Writer) Position(v Vector3) { w.buf = append(w.buf, pk.PackBlockPosition(v)...) }
+func (w *Writer) Raw(data []byte)    { w.buf = append(w.buf, data...) }
+
+// VarLong write n in 1 to 10 bytes
+func (w *Writer) VarLong(n int64) {
+	num := uint64(n)
+	for {
+		b := byte(num & 0x7F)
+		num >>= 7
+		if num != 0 {
+			b |= 0x80
+		}
+		w.buf = append(w.buf, b)
+		if num == 0 {
+			return
+		}
+	}
+}
+
+// Angle write an angle in degrees in a byte, 256 steps a turn
+func (w *Writer) Angle(deg float32) {
+	w.buf = append(w.buf, byte(int(math.Round(float64(deg)*256/360))))
+}
+
+func (w *Writer) UUID(id [2]int64) {
+	w.Long(id[0])
+	w.Long(id[1])
+}
+
+// ByteArray write data after its length
+func (w *Writer) ByteArray(data []byte) {
+	w.VarInt(int32(len(data)))
+	w.Raw(data)
+}
+
+// NBT write a named tag, or TAG_End if it's empty
+func (w *Writer) NBT(tag NBT) {
+	if len(tag) == 0 {
+		w.buf = append(w.buf, nbt.TagEnd)
+		return
+	}
+	w.Raw(tag)
+}
+
+func (w *Writer) Slot(s Slot) {
+	w.Short(s.ID)
+	if s.ID == -1 {
+		return
+	}
+	w.Byte(s.Count)
+	w.Short(s.Damage)
+	w.NBT(s.NBT)
+}
+
+// Reader reads the fields of a packet.
+// After an error, the fields read are zero and Err return the first error.
+type Reader struct {
+	Version int32 // the protocol version of the packet, 0 for the latest
+
+	r   *bytes.Reader
+	err error
+}
+
+func NewReader(data []byte) *Reader {
+	return &Reader{r: bytes.NewReader(data)}
+}
+
+// Err return the first error
+func (r *Reader) Err() error { return r.err }
+
+// Len return the number of bytes left
+func (r *Reader) Len() int { return r.r.Len() }
+
+// fail keep err if it's the first one
+func (r *Reader) fail(err error) {
+	if r.err == nil && err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		r.err = err
+	}
+}
+
+func (r *Reader) read(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n < 0 || n > r.r.Len() {
+		r.fail(fmt.Errorf("%d bytes to read, %d left", n, r.r.Len()))
+		return nil
+	}
+	bs := make([]byte, n)
+	_, err := io.ReadFull(r.r, bs)
+	r.fail(err)
+	return bs
+}
+
+func (r *Reader) Bool() bool { return r.UByte() != 0 }
+func (r *Reader) Byte() int8 { return int8(r.UByte()) }
+
+func (r *Reader) UByte() byte {
+	if r.err != nil {
+		return 0
+	}
+	b, err := r.r.ReadByte()
+	r.fail(err)
+	return b
+}
+
+func (r *Reader) Short() int16 { return int16(r.UShort()) }
+
+func (r *Reader) UShort() uint16 {
+	bs := r.read(2)
+	if bs == nil {
+		return 0
+	}
+	return uint16(bs[0])<<8 | uint16(bs[1])
+}
+
+func (r *Reader) Int() int32 {
+	bs := r.read(4)
+	if bs == nil {
+		return 0
+	}
+	return int32(bs[0])<<24 | int32(bs[1])<<16 | int32(bs[2])<<8 | int32(bs[3])
+}
+
+func (r *Reader) Long() int64 {
+	bs := r.read(8)
+	if bs == nil {
+		return 0
+	}
+	var n int64
+	for _, b := range bs {
+		n = n<<8 | int64(b)
+	}
+	return n
+}
+
+func (r *Reader) Float() float32  { return math.Float32frombits(uint32(r.Int())) }
+func (r *Reader) Double() float64 { return math.Float64frombits(uint64(r.Long())) }
+
+func (r *Reader) VarInt() int32 {
+	var n uint32
+	for i := 0; i < 5; i++ {
+		b := r.UByte()
+		n |= uint32(b&0x7F) << (7 * i)
+		if b&0x80 == 0 {
+			return int32(n)
+		}
+	}
+	r.fail(fmt.Errorf("VarInt is too big"))
+	return 0
+}
+
+func (r *Reader) VarLong() int64 {
+	var n uint64
+	for i := 0; i < 10; i++ {
+		b := r.UByte()
+		n |= uint64(b&0x7F) << (7 * i)
+		if b&0x80 == 0 {
+			return int64(n)
+		}
+	}
+	r.fail(fmt.Errorf("VarLong is too big"))
+	return 0
+}
+
+// Count read the length of an array, each element must take at least a byte
+func (r *Reader) Count() int {
+	n := int(r.VarInt())
+	if n < 0 || n > r.r.Len() {
+		r.fail(errCount(n, r.r.Len()))
+		return 0
+	}
+	return n
+}
+
+func (r *Reader) String() string {
+	return string(r.read(r.Count()))
+}
+
+func (r *Reader) Position() Vector3 {
+	v, err := pk.UnpackPosition(bytes.NewReader(r.read(8)))
+	r.fail(err)
+	return v
+}
+
+func (r *Reader) Angle() float32 { return float32(r.UByte()) * 360 / 256 }
+
+func (r *Reader) UUID() [2]int64 { return [2]int64{r.Long(), r.Long()} }
+
+// ByteArray read bytes after their length
+func (r *Reader) ByteArray() []byte {
+	n := r.Count()
+	if n == 0 {
+		return nil
+	}
+	return r.read(n)
+}
+
+// Rest read what is left in the packet
+func (r *Reader) Rest() []byte {
+	if r.r.Len() == 0 {
+		return nil
+	}
+	return r.read(r.r.Len())
+}
+
+// NBT read a named tag, nil for TAG_End
+func (r *Reader) NBT() NBT {
+	if r.UByte() == nbt.TagEnd || r.err != nil {
+		return nil
+	}
+	r.fail(r.r.UnreadByte())
+	start := r.r.Size() - int64(r.r.Len())
+	var msg nbt.RawMessage
+	if _, err := nbt.NewDecoder(r.r).Decode(&msg); err != nil {
+		r.fail(err)
+		return nil
+	}
+	tag := make(NBT, r.r.Size()-int64(r.r.Len())-start)
+	_, err := r.r.ReadAt(tag, start)
+	r.fail(err)
+	return tag
+}
+
+func (r *Reader) Slot() (s Slot) {
+	if s.ID = r.Short(); s.ID == -1 {
+		return
+	}
+	s.Count = r.Byte()
+	s.Damage = r.Short()
+	s.NBT = r.NBT()
+	return
+}
+
+// NBT is a named NBT tag, as sent in the packets. It's empty for TAG_End.
+type NBT []byte
+
+// Slot is an item stack, in a window or held by an entity.
+// ID is -1 for an empty slot.
+type Slot struct {
+	ID     int16
+	Count  int8
+	Damage int16
+	NBT    NBT
+}
+
+func errCount(n, left int) error {
+	return fmt.Errorf("array of %d elements with %d bytes left", n, left)
+}
+
+func writeVector(w *Writer, v Vector3) {
+	w.Double(v.X)
+	w.Double(v.Y)
+	w.Double(v.Z)
+}
+
+func readVector(r *Reader) Vector3 {
+	return Vector3{X: r.Double(), Y: r.Double(), Z: r.Double()}
+}
+
+func writeShorts(w *Writer, s [3]int16) {
+	for _, n := range s {
+		w.Short(n)
+	}
+}
+
+func readShorts(r *Reader) [3]int16 {
+	return [3]int16{r.Short(), r.Short(), r.Short()}
+}
+
+func writeInts(w *Writer, s [3]int32) {
+	for _, n := range s {
+		w.Int(n)
+	}
+}
+
+func readInts(r *Reader) [3]int32 {
+	return [3]int32{r.Int(), r.Int(), r.Int()}
+}
+
+func writeVarInts(w *Writer, s []int32) {
+	w.VarInt(int32(len(s)))
+	for _, n := range s {
+		w.VarInt(n)
+	}
+}
+
+func readVarInts(r *Reader) (s []int32) {
+	for i, n := 0, r.Count(); i < n; i++ {
+		s = append(s, r.VarInt())
+	}
+	return
+}
+
+func writeStrings(w *Writer, s []string) {
+	w.VarInt(int32(len(s)))
+	for _, str := range s {
+		w.String(str)
+	}
+}
+
+func readStrings(r *Reader) (s []string) {
+	for i, n := 0, r.Count(); i < n; i++ {
+		s = append(s, r.String())
+	}
+	return
+}
+
+// writeOptString write a boolean, then the string if it's not nil
+func writeOptString(w *Writer, s *string) {
+	w.Bool(s != nil)
+	if s != nil {
+		w.String(*s)
+	}
+}
+
+func readOptString(r *Reader) *string {
+	if !r.Bool() {
+		return nil
+	}
+	s := r.String()
+	return &s
+}
@@ -0,0 +1,122 @@
+package protocol
+
+// Handshake is the first packet, NextState is 1 for Status and 2 for Login
+type Handshake struct {
+	ProtocolVersion int32
+	ServerAddress   string
+	ServerPort      uint16
+	NextState       int32
+}
+
+func (p *Handshake) Encode(w *Writer) {
+	w.VarInt(p.ProtocolVersion)
+	w.String(p.ServerAddress)
+	w.UShort(p.ServerPort)
+	w.VarInt(p.NextState)
+}
+
+func (p *Handshake) Decode(r *Reader) {
+	p.ProtocolVersion = r.VarInt()
+	p.ServerAddress = r.String()
+	p.ServerPort = r.UShort()
+	p.NextState = r.VarInt()
+}
+
+type StatusRequest struct{}
+
+func (p *StatusRequest) Encode(*Writer) {}
+func (p *StatusRequest) Decode(*Reader) {}
+
+// StatusResponse is the status of the server in JSON
+type StatusResponse struct {
+	JSON string
+}
+
+func (p *StatusResponse) Encode(w *Writer) { w.String(p.JSON) }
+func (p *StatusResponse) Decode(r *Reader) { p.JSON = r.String() }
+
+type StatusPing struct {
+	Payload int64
+}
+
+func (p *StatusPing) Encode(w *Writer) { w.Long(p.Payload) }
+func (p *StatusPing) Decode(r *Reader) { p.Payload = r.Long() }
+
+type StatusPong struct {
+	Payload int64
+}
+
+func (p *StatusPong) Encode(w *Writer) { w.Long(p.Payload) }
+func (p *StatusPong) Decode(r *Reader) { p.Payload = r.Long() }
+
+type LoginStart struct {
+	Name string
+}
+
+func (p *LoginStart) Encode(w *Writer) { w.String(p.Name) }
+func (p *LoginStart) Decode(r *Reader) { p.Name = r.String() }
+
+// LoginDisconnect is the reason of the kick in JSON
+type LoginDisconnect struct {
+	Reason string
+}
+
+func (p *LoginDisconnect) Encode(w *Writer) { w.String(p.Reason) }
+func (p *LoginDisconnect) Decode(r *Reader) { p.Reason = r.String() }
+
+type EncryptionRequest struct {
+	ServerID    string
+	PublicKey   []byte
+	VerifyToken []byte
+}
+
+func (p *EncryptionRequest) Encode(w *Writer) {
+	w.String(p.ServerID)
+	w.ByteArray(p.PublicKey)
+	w.ByteArray(p.VerifyToken)
+}
+
+func (p *EncryptionRequest) Decode(r *Reader) {
+	p.ServerID = r.String()
+	p.PublicKey = r.ByteArray()
+	p.VerifyToken = r.ByteArray()
+}
+
+type EncryptionResponse struct {
+	SharedSecret []byte
+	VerifyToken  []byte
+}
+
+func (p *EncryptionResponse) Encode(w *Writer) {
+	w.ByteArray(p.SharedSecret)
+	w.ByteArray(p.VerifyToken)
+}
+
+func (p *EncryptionResponse) Decode(r *Reader) {
+	p.SharedSecret = r.ByteArray()
+	p.VerifyToken = r.ByteArray()
+}
+
+// LoginSuccess ends the login, UUID is in its text form with hyphens
+type LoginSuccess struct {
+	UUID     string
+	Username string
+}
+
+func (p *LoginSuccess) Encode(w *Writer) {
+	w.String(p.UUID)
+	w.String(p.Username)
+}
+
+func (p *LoginSuccess) Decode(r *Reader) {
+	p.UUID = r.String()
+	p.Username = r.String()
+}
+
+// SetCompression enables the compression of the packets of at least Threshold bytes
+type SetCompression struct {
+	Threshold int32
+}
+
+func (p *SetCompression) Encode(w *Writer) { w.VarInt(p.Threshold) }
+func (p *SetCompression) Decode(r *Reader) { p.Threshold = r.VarInt() }
@@ -0,0 +1,143 @@
+package protocol
+
+import (
+	"fmt"
+	. "github.com/edouard127/mc-go-1.12.2/maths"
+)
+
+// The types of the entity metadata values, with the Go type of MetadataEntry.Value
+const (
+	MetaByte        = iota // byte
+	MetaVarInt             // int32
+	MetaFloat              // float32
+	MetaString             // string
+	MetaChat               // string, the JSON of a chat message
+	MetaSlot               // Slot
+	MetaBoolean            // bool
+	MetaRotation           // [3]float32
+	MetaPosition           // Vector3
+	MetaOptPosition        // *Vector3, nil if absent
+	MetaDirection          // int32, like the faces of PlayerDigging
+	MetaOptUUID            // *[2]int64, nil if absent
+	MetaBlockID            // int32, the ID and the data of a block state, 0 if absent
+	MetaNBT                // NBT
+)
+
+// metadataEnd ends the entity metadata in the packets
+const metadataEnd = 0xFF
+
+// MetadataEntry is a value of the entity metadata. Index depends on the class of the entity.
+type MetadataEntry struct {
+	Index byte
+	Type  int32
+	Value interface{}
+}
+
+// Metadata is the metadata of an entity sent by the server, only the entries which changed
+type Metadata []MetadataEntry
+
+// Get return the value at the index if it has the type
+func (m Metadata) Get(index byte, typ int32) (interface{}, bool) {
+	for _, e := range m {
+		if e.Index == index && e.Type == typ {
+			return e.Value, true
+		}
+	}
+	return nil, false
+}
+
+// Metadata write the entries and the end of the metadata.
+// It panics if a value doesn't have the Go type of its Type.
+func (w *Writer) Metadata(m Metadata) {
+	for _, e := range m {
+		w.UByte(e.Index)
+		w.VarInt(e.Type)
+		switch e.Type {
+		case MetaByte:
+			w.UByte(e.Value.(byte))
+		case MetaVarInt, MetaDirection, MetaBlockID:
+			w.VarInt(e.Value.(int32))
+		case MetaFloat:
+			w.Float(e.Value.(float32))
+		case MetaString, MetaChat:
+			w.String(e.Value.(string))
+		case MetaSlot:
+			w.Slot(e.Value.(Slot))
+		case MetaBoolean:
+			w.Bool(e.Value.(bool))
+		case MetaRotation:
+			for _, f := range e.Value.([3]float32) {
+				w.Float(f)
+			}
+		case MetaPosition:
+			w.Position(e.Value.(Vector3))
+		case MetaOptPosition:
+			v := e.Value.(*Vector3)
+			if w.Bool(v != nil); v != nil {
+				w.Position(*v)
+			}
+		case MetaOptUUID:
+			id := e.Value.(*[2]int64)
+			if w.Bool(id != nil); id != nil {
+				w.UUID(*id)
+			}
+		case MetaNBT:
+			w.NBT(e.Value.(NBT))
+		default:
+			panic(fmt.Sprintf("protocol: unknown metadata type %d", e.Type))
+		}
+	}
+	w.UByte(metadataEnd)
+}
+
+// Metadata read the entries until the end of the metadata
+func (r *Reader) Metadata() (m Metadata) {
+	for {
+		index := r.UByte()
+		if r.err != nil || index == metadataEnd {
+			break
+		}
+		e := MetadataEntry{Index: index, Type: r.VarInt()}
+		switch e.Type {
+		case MetaByte:
+			e.Value = r.UByte()
+		case MetaVarInt, MetaDirection, MetaBlockID:
+			e.Value = r.VarInt()
+		case MetaFloat:
+			e.Value = r.Float()
+		case MetaString, MetaChat:
+			e.Value = r.String()
+		case MetaSlot:
+			e.Value = r.Slot()
+		case MetaBoolean:
+			e.Value = r.Bool()
+		case MetaRotation:
+			e.Value = [3]float32{r.Float(), r.Float(), r.Float()}
+		case MetaPosition:
+			e.Value = r.Position()
+		case MetaOptPosition:
+			var v *Vector3
+			if r.Bool() {
+				p := r.Position()
+				v = &p
+			}
+			e.Value = v
+		case MetaOptUUID:
+			var id *[2]int64
+			if r.Bool() {
+				u := r.UUID()
+				id = &u
+			}
+			e.Value = id
+		case MetaNBT:
+			e.Value = r.NBT()
+		default:
+			r.fail(fmt.Errorf("unknown metadata type %d at index %d", e.Type, index))
+		}
+		m = append(m, e)
+	}
+	if r.err != nil {
+		return nil
+	}
+	return m
+}
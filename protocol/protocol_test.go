@@ -0,0 +1,271 @@
+package protocol
+
+import (
+	"bytes"
+	. "github.com/edouard127/mc-go-1.12.2/maths"
+	pk "github.com/edouard127/mc-go-1.12.2/packet"
+	"reflect"
+	"testing"
+)
+
+func TestProtocol340(t *testing.T) {
+	count := map[key]int{}
+	for k, typ := range Protocol340.types {
+		count[key{state: k.state, dir: k.dir}]++
+		p := Protocol340.New(k.state, k.dir, k.id)
+		if reflect.TypeOf(p) != typ {
+			t.Errorf("New(%v, %v, 0x%02X) = %T, want %v", k.state, k.dir, k.id, p, typ)
+		}
+		if state, dir, id, ok := Protocol340.ID(p); !ok || state != k.state || dir != k.dir || id != k.id {
+			t.Errorf("ID(%T) = %v, %v, 0x%02X, %v", p, state, dir, id, ok)
+		}
+	}
+	for k, n := range map[key]int{
+		{Handshaking, Serverbound, 0}: 1,
+		{Status, Clientbound, 0}:      2,
+		{Status, Serverbound, 0}:      2,
+		{Login, Clientbound, 0}:       4,
+		{Login, Serverbound, 0}:       2,
+		{Play, Clientbound, 0}:        0x50,
+		{Play, Serverbound, 0}:        0x21,
+	} {
+		if count[k] != n {
+			t.Errorf("%d %s %s packets, want %d", count[k], k.state, k.dir, n)
+		}
+	}
+	for _, test := range []struct {
+		p  Packet
+		id int32
+	}{
+		{&WindowItems{}, 0x14},
+		{&SetSlot{}, 0x16},
+		{&Disconnect{}, 0x1A},
+		{&ChunkData{}, 0x20},
+		{&EntityEffect{}, 0x4F},
+		{&PlayerDigging{}, 0x14},
+		{&UseItem{}, 0x20},
+	} {
+		if _, _, id, _ := Protocol340.ID(test.p); id != test.id {
+			t.Errorf("%T has the ID 0x%02X, want 0x%02X", test.p, id, test.id)
+		}
+	}
+	if p := Protocol340.New(Play, Clientbound, 0x50); p != nil {
+		t.Errorf("New returns %T for an unknown ID", p)
+	}
+}
+
+func TestVersions(t *testing.T) {
+	for _, test := range []struct {
+		r  *Registry
+		p  Packet
+		id int32
+		ok bool
+	}{
+		{Protocol340, &CraftRecipeResponse{}, 0x2B, true},
+		{Protocol338, &CraftRecipeResponse{}, 0x2B, true},
+		{Protocol335, &CraftRecipeResponse{}, 0, false},
+		{Protocol340, &PlayerAbilitiesClientbound{}, 0x2C, true},
+		{Protocol335, &PlayerAbilitiesClientbound{}, 0x2B, true},
+		{Protocol335, &EntityEffect{}, 0x4E, true},
+		{Protocol340, &PrepareCraftingGrid{}, 0, false},
+		{Protocol335, &PrepareCraftingGrid{}, 0x01, true},
+		{Protocol335, &TabCompleteServerbound{}, 0x02, true},
+		{Protocol335, &KeepAliveServerbound{}, 0x0C, true},
+		{Protocol335, &CraftRecipeRequest{}, 0, false},
+		{Protocol335, &PlayerDigging{}, 0x14, true},
+		{Protocol335, &UseItem{}, 0x20, true},
+	} {
+		if _, _, id, ok := test.r.ID(test.p); id != test.id || ok != test.ok {
+			t.Errorf("%T in protocol %d: 0x%02X, %v, want 0x%02X, %v", test.p, test.r.Version, id, ok, test.id, test.ok)
+		}
+	}
+	if r, ok := RegistryOf(338); !ok || r != Protocol338 {
+		t.Errorf("RegistryOf(338) = %v, %v", r, ok)
+	}
+	if _, ok := RegistryOf(47); ok {
+		t.Error("protocol 47 is supported")
+	}
+	if v := Versions(); len(v) != 3 || v[0] != 335 || v[2] != 340 {
+		t.Errorf("versions %v", v)
+	}
+
+	// The keep alive IDs are VarInts before 1.12.2
+	for r, want := range map[*Registry][]byte{
+		Protocol335: {0x0C, 0xAC, 0x02},
+		Protocol338: {0x0B, 0xAC, 0x02},
+		Protocol340: append([]byte{0x0B}, pk.PackUint64(300)...),
+	} {
+		p, err := r.Pack(&KeepAliveServerbound{KeepAliveID: 300})
+		if err != nil || !bytes.Equal(append([]byte{p.ID}, p.Data...), want) {
+			t.Errorf("keep alive of protocol %d: %v, %v", r.Version, p, err)
+			continue
+		}
+		if got, err := r.Decode(Play, Serverbound, int32(p.ID), p.Data); err != nil || got.(*KeepAliveServerbound).KeepAliveID != 300 {
+			t.Errorf("keep alive of protocol %d read %v, %v", r.Version, got, err)
+		}
+	}
+}
+
+// a named compound with an int
+var tag = NBT{0x0A, 0, 0, 0x03, 0, 1, 'a', 0, 0, 0, 5, 0x00}
+
+func str(s string) *string { return &s }
+
+func TestRoundTrip(t *testing.T) {
+	// every packet without data
+	var packets []Packet
+	for _, typ := range Protocol340.types {
+		packets = append(packets, reflect.New(typ.Elem()).Interface().(Packet))
+	}
+	date := int64(1600000000)
+	packets = append(packets,
+		&Handshake{ProtocolVersion: 340, ServerAddress: "localhost", ServerPort: 25565, NextState: 2},
+		&EncryptionRequest{ServerID: "id", PublicKey: []byte{1, 2, 3}, VerifyToken: []byte{4}},
+		&SpawnObject{EntityID: 3, ObjectUUID: [2]int64{1, -1}, Type: 2, Position: Vector3{X: 1.5, Y: -2, Z: 3}, Pitch: 90, Yaw: 180, Data: 1, Velocity: [3]int16{1, -2, 3}},
+		&SpawnMob{EntityID: 1, Type: 50, Metadata: Metadata{{Index: 0, Type: MetaByte, Value: byte(0x20)}, {Index: 7, Type: MetaFloat, Value: float32(20)}}},
+		&EntityMetadata{EntityID: 2, Metadata: Metadata{
+			{0, MetaByte, byte(0x02)},
+			{1, MetaVarInt, int32(300)},
+			{2, MetaString, "Bob"},
+			{3, MetaChat, `{"text":"Bob"}`},
+			{6, MetaSlot, Slot{ID: 276, Count: 1, Damage: 3, NBT: tag}},
+			{7, MetaBoolean, true},
+			{8, MetaRotation, [3]float32{1, 2, 3}},
+			{9, MetaPosition, Vector3{X: -1, Y: 64, Z: 5}},
+			{10, MetaOptPosition, &Vector3{X: 1, Y: 2, Z: 3}},
+			{11, MetaOptPosition, (*Vector3)(nil)},
+			{12, MetaDirection, int32(3)},
+			{13, MetaOptUUID, &[2]int64{1, 2}},
+			{14, MetaOptUUID, (*[2]int64)(nil)},
+			{15, MetaBlockID, int32(1 << 4)},
+			{16, MetaNBT, tag},
+		}},
+		&BossBar{Action: BossBarAdd, Title: `{"text":"boss"}`, Health: 0.5, Color: 1, Division: 2, Flags: 1},
+		&BossBar{Action: BossBarRemove},
+		&BossBar{Action: BossBarUpdateStyle, Color: 3, Division: 4},
+		&MultiBlockChange{ChunkX: -1, ChunkZ: 2, Records: []BlockRecord{{X: 15, Z: 1, Y: 64, BlockID: 1 << 4}, {X: 0, Z: 15, Y: 255, BlockID: 2}}},
+		&OpenWindow{WindowID: 1, WindowType: "EntityHorse", WindowTitle: `""`, NumberOfSlots: 2, EntityID: 7},
+		&WindowItems{WindowID: 0, SlotData: []Slot{{ID: -1}, {ID: 1, Count: 64}, {ID: 276, Count: 1, Damage: 10, NBT: tag}}},
+		&SetSlot{WindowID: -1, Slot: -1, SlotData: Slot{ID: -1}},
+		&Explosion{X: 1, Radius: 3, Records: [][3]int8{{1, -1, 0}}, PlayerMotion: [3]float32{0.5, 0, 0}},
+		&ChunkData{ChunkX: 1, ChunkZ: -1, GroundUpContinuous: true, PrimaryBitMask: 1, Data: []byte{1, 2, 3}, BlockEntities: []NBT{tag, tag}},
+		&Particle{ParticleID: 36, Data: []int32{1, 2}},
+		&Particle{ParticleID: 37, Data: []int32{1}},
+		&Map{Icons: []MapIcon{{1, 2, 3}}, Columns: 2, Rows: 1, X: 3, Z: 4, Data: []byte{5, 6}},
+		&CombatEvent{Event: CombatEnd, Duration: 20, EntityID: 3},
+		&CombatEvent{Event: CombatEntityDead, PlayerID: 1, EntityID: 2, Message: `"dead"`},
+		&PlayerListItem{Action: PlayerListAdd, Players: []PlayerListEntry{
+			{UUID: [2]int64{1, 2}, Name: "Steve", Properties: []PlayerProperty{{Name: "textures", Value: "v", Signature: str("s")}, {Name: "n", Value: "v"}}, Gamemode: 1, Ping: 20, DisplayName: str(`"Steve"`)},
+			{UUID: [2]int64{3, 4}, Name: "Alex"},
+		}},
+		&PlayerListItem{Action: PlayerListUpdateLatency, Players: []PlayerListEntry{{UUID: [2]int64{1, 2}, Ping: 100}}},
+		&UnlockRecipes{Action: 0, RecipeIDs: []int32{1, 2}, InitRecipeIDs: []int32{3}},
+		&UnlockRecipes{Action: 1, RecipeIDs: []int32{1}},
+		&SelectAdvancementTab{Identifier: str("minecraft:story/root")},
+		&WorldBorder{Action: WorldBorderInitialize, X: 1, Z: 2, OldDiameter: 3, NewDiameter: 4, Speed: 1 << 40, PortalTeleportBoundary: 29999984, WarningTime: 15, WarningBlocks: 5},
+		&WorldBorder{Action: WorldBorderLerpSize, OldDiameter: 3, NewDiameter: 4, Speed: -1},
+		&ScoreboardObjective{ObjectiveName: "o", Mode: 1},
+		&Teams{TeamName: "t", Mode: TeamCreate, DisplayName: "d", NameTagVisibility: "always", CollisionRule: "never", Color: -1, Entities: []string{"Steve", "Alex"}},
+		&Teams{TeamName: "t", Mode: TeamRemoveEntities, Entities: []string{"Alex"}},
+		&UpdateScore{EntityName: "Steve", Action: 1, ObjectiveName: "o"},
+		&Title{Action: TitleSetTimes, FadeIn: 10, Stay: 70, FadeOut: 20},
+		&Advancements{ResetClear: true,
+			Advancements: []Advancement{{
+				ID:           "minecraft:story/root",
+				Display:      &AdvancementDisplay{Title: `"Minecraft"`, Icon: Slot{ID: 2, Count: 1}, Flags: 1, BackgroundTexture: "minecraft:textures/gui/advancements/backgrounds/stone.png", X: 1},
+				Criteria:     []string{"crafting_table"},
+				Requirements: [][]string{{"crafting_table"}},
+			}, {ID: "minecraft:story/mine_stone", ParentID: str("minecraft:story/root")}},
+			Removed:  []string{"old"},
+			Progress: []AdvancementProgress{{ID: "minecraft:story/root", Criteria: []CriterionProgress{{ID: "crafting_table", Achieved: &date}, {ID: "other"}}}},
+		},
+		&EntityProperties{EntityID: 1, Properties: []EntityProperty{{Key: "generic.movementSpeed", Value: 0.1, Modifiers: []AttributeModifier{{UUID: [2]int64{5, 6}, Amount: 0.3, Operation: 2}}}}},
+		&TabCompleteServerbound{Text: "/tp", LookedAtBlock: &Vector3{X: 1, Y: 2, Z: 3}},
+		&UseEntity{Target: 1, Type: UseEntityInteractAt, TargetX: 0.5, TargetY: 1, TargetZ: 0.5, Hand: 1},
+		&UseEntity{Target: 1, Type: UseEntityAttack},
+		&CraftingBookData{Type: 1, CraftingBookOpen: true},
+		&AdvancementTab{Action: 1},
+		&UpdateSign{Location: Vector3{X: -1, Y: 64, Z: 1}, Lines: [4]string{"a", "b", "", "d"}},
+		&PlayerBlockPlacement{Location: Vector3{X: -30000000, Y: 0, Z: 29999999}, Face: 1, CursorX: 0.5},
+		&PrepareCraftingGrid{},
+		&PrepareCraftingGrid{WindowID: 1, ActionNumber: 2, PrepareEntries: []CraftingGridEntry{{Item: Slot{ID: 5, Count: 1}, CraftingSlot: 1, PlayerSlot: 36}, {Item: Slot{ID: -1}}}},
+	)
+
+	for _, p := range packets {
+		data := Marshal(p)
+		got := reflect.New(reflect.TypeOf(p).Elem()).Interface().(Packet)
+		if err := Unmarshal(data, got); err != nil {
+			t.Errorf("%#v: %v", p, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, p) {
+			t.Errorf("%T: got %+v, want %+v", p, got, p)
+		}
+	}
+}
+
+// TestCompatible check that the packets are encoded like the helpers of the packet package
+func TestCompatible(t *testing.T) {
+	v3 := Vector3{X: 10, Y: 64, Z: -3}
+	digging := pk.PackVarInt(2)
+	digging = append(digging, pk.PackBlockPosition(v3)...)
+	digging = append(digging, 1)
+	position := append(pk.PackPosition(v3), pk.PackBoolean(true))
+	handshake := pk.PackVarInt(340)
+	handshake = append(handshake, pk.PackString("localhost")...)
+	handshake = append(handshake, pk.PackUint16(25565)...)
+	handshake = append(handshake, 2)
+
+	for _, test := range []struct {
+		p    Packet
+		want []byte
+	}{
+		{&PlayerDigging{Status: DiggingFinished, Location: v3, Face: 1}, digging},
+		{&PlayerPosition{Position: v3, OnGround: true}, position},
+		{&Handshake{ProtocolVersion: 340, ServerAddress: "localhost", ServerPort: 25565, NextState: 2}, handshake},
+		{&KeepAliveServerbound{KeepAliveID: -2}, pk.PackUint64(^uint64(1))},
+	} {
+		if got := Marshal(test.p); !bytes.Equal(got, test.want) {
+			t.Errorf("%T: % X, want % X", test.p, got, test.want)
+		}
+	}
+}
+
+func TestUnmarshal_Error(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		data []byte
+		p    Packet
+	}{
+		{"truncated", []byte{0, 0, 0}, &TimeUpdate{}},
+		{"bytes left", []byte{1, 0}, &ServerDifficulty{}},
+		{"too many elements", []byte{0xFF, 0xFF, 0xFF, 0xFF, 0x07, 1}, &DestroyEntities{}},
+		{"string too long", []byte{10, 'a'}, &Disconnect{}},
+		{"VarInt too big", []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01}, &Camera{}},
+		{"bad NBT", []byte{0, 1, 0x0A, 0, 5}, &SetSlot{}},
+		{"too many slots", []byte{0, 0x7F, 0xFF, 0xFF, 0xFF}, &WindowItems{}},
+		{"unknown metadata type", []byte{1, 0, 14, 0, 0xFF}, &EntityMetadata{}},
+		{"metadata without end", []byte{1, 0, 0, 0x20}, &EntityMetadata{}},
+	} {
+		if err := Unmarshal(test.data, test.p); err == nil {
+			t.Errorf("%s: no error, read %+v", test.name, test.p)
+		}
+	}
+}
+
+func TestReader_NBT(t *testing.T) {
+	var w Writer
+	w.Slot(Slot{ID: 1, Count: 2, Damage: 3, NBT: tag})
+	w.Slot(Slot{ID: 4, Count: 1})
+	w.Byte(-1)
+	r := NewReader(w.Bytes())
+	if s := r.Slot(); s.ID != 1 || !bytes.Equal(s.NBT, tag) {
+		t.Errorf("first slot %+v", s)
+	}
+	if s := r.Slot(); s.ID != 4 || s.NBT != nil {
+		t.Errorf("second slot %+v", s)
+	}
+	if b := r.Byte(); b != -1 || r.Err() != nil || r.Len() != 0 {
+		t.Errorf("after the slots: %d, %v, %d bytes left", b, r.Err(), r.Len())
+	}
+}
@@ -0,0 +1,154 @@
+package protocol
+
+import (
+	"fmt"
+	pk "github.com/edouard127/mc-go-1.12.2/packet"
+	"reflect"
+)
+
+// State is the state of the connection, it selects the set of packets
+type State byte
+
+const (
+	Handshaking State = iota
+	Status
+	Login
+	Play
+)
+
+func (s State) String() string {
+	switch s {
+	case Handshaking:
+		return "handshaking"
+	case Status:
+		return "status"
+	case Login:
+		return "login"
+	case Play:
+		return "play"
+	}
+	return fmt.Sprintf("state %d", byte(s))
+}
+
+// Direction is who sends the packet
+type Direction byte
+
+const (
+	Clientbound Direction = iota // from the server to the client
+	Serverbound                  // from the client to the server
+)
+
+func (d Direction) String() string {
+	if d == Clientbound {
+		return "clientbound"
+	}
+	return "serverbound"
+}
+
+type key struct {
+	state State
+	dir   Direction
+	id    int32
+}
+
+// Registry maps the IDs of a protocol version to the packet types
+type Registry struct {
+	Version int32 // the protocol version, sent in the handshake
+
+	types map[key]reflect.Type
+	keys  map[reflect.Type]key
+}
+
+// NewRegistry return an empty registry of a protocol version
+func NewRegistry(version int32) *Registry {
+	return &Registry{
+		Version: version,
+		types:   make(map[key]reflect.Type),
+		keys:    make(map[reflect.Type]key),
+	}
+}
+
+// Register map the packet ID to the type of p, which must be a pointer to a struct
+func (r *Registry) Register(state State, dir Direction, id int32, p Packet) {
+	t := reflect.TypeOf(p)
+	if t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		panic(fmt.Sprintf("protocol: %T isn't a pointer to a struct", p))
+	}
+	k := key{state, dir, id}
+	if old, ok := r.types[k]; ok {
+		panic(fmt.Sprintf("protocol: %s %s packet 0x%02X registered as %v and %v", state, dir, id, old, t))
+	}
+	if _, ok := r.keys[t]; ok {
+		panic(fmt.Sprintf("protocol: %v registered twice", t))
+	}
+	r.types[k] = t
+	r.keys[t] = k
+}
+
+// New return a new packet of the type registered for the ID, or nil
+func (r *Registry) New(state State, dir Direction, id int32) Packet {
+	t, ok := r.types[key{state, dir, id}]
+	if !ok {
+		return nil
+	}
+	return reflect.New(t.Elem()).Interface().(Packet)
+}
+
+// ID return the state, direction and ID of the type of p
+func (r *Registry) ID(p Packet) (state State, dir Direction, id int32, ok bool) {
+	k, ok := r.keys[reflect.TypeOf(p)]
+	return k.state, k.dir, k.id, ok
+}
+
+// Decode read the packet with this ID from data.
+// The packet is nil without error if the ID isn't registered.
+func (r *Registry) Decode(state State, dir Direction, id int32, data []byte) (Packet, error) {
+	p := r.New(state, dir, id)
+	if p == nil {
+		return nil, nil
+	}
+	if err := unmarshal(data, p, r.Version); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Pack return the packet of p with its ID, ready to be sent
+func (r *Registry) Pack(p Packet) (*pk.Packet, error) {
+	k, ok := r.keys[reflect.TypeOf(p)]
+	if !ok {
+		return nil, fmt.Errorf("%T isn't a packet of protocol %d", p, r.Version)
+	}
+	return &pk.Packet{ID: byte(k.id), Data: marshal(p, r.Version)}, nil
+}
+
+// Len return the number of packets registered
+func (r *Registry) Len() int {
+	return len(r.types)
+}
+
+// register add the packets of the handshaking, status and login states, which are the same in every version,
+// then the play packets, whose IDs are their indexes
+func (r *Registry) register(clientbound, serverbound []Packet) *Registry {
+	r.Register(Handshaking, Serverbound, 0x00, &Handshake{})
+
+	r.Register(Status, Clientbound, 0x00, &StatusResponse{})
+	r.Register(Status, Clientbound, 0x01, &StatusPong{})
+	r.Register(Status, Serverbound, 0x00, &StatusRequest{})
+	r.Register(Status, Serverbound, 0x01, &StatusPing{})
+
+	r.Register(Login, Clientbound, 0x00, &LoginDisconnect{})
+	r.Register(Login, Clientbound, 0x01, &EncryptionRequest{})
+	r.Register(Login, Clientbound, 0x02, &LoginSuccess{})
+	r.Register(Login, Clientbound, 0x03, &SetCompression{})
+	r.Register(Login, Serverbound, 0x00, &LoginStart{})
+	r.Register(Login, Serverbound, 0x01, &EncryptionResponse{})
+
+	for id, p := range clientbound {
+		r.Register(Play, Clientbound, int32(id), p)
+	}
+	for id, p := range serverbound {
+		r.Register(Play, Serverbound, int32(id), p)
+	}
+	return r
+}
@@ -0,0 +1,618 @@
+package protocol
+
+import (
+	. "github.com/edouard127/mc-go-1.12.2/maths"
+)
+
+// The serverbound packets of the play state
+
+// TeleportConfirm confirms PlayerPositionAndLookClientbound
+type TeleportConfirm struct {
+	TeleportID int32
+}
+
+func (p *TeleportConfirm) Encode(w *Writer) { w.VarInt(p.TeleportID) }
+func (p *TeleportConfirm) Decode(r *Reader) { p.TeleportID = r.VarInt() }
+
+type TabCompleteServerbound struct {
+	Text          string
+	AssumeCommand bool
+	LookedAtBlock *Vector3
+}
+
+func (p *TabCompleteServerbound) Encode(w *Writer) {
+	w.String(p.Text)
+	w.Bool(p.AssumeCommand)
+	w.Bool(p.LookedAtBlock != nil)
+	if p.LookedAtBlock != nil {
+		w.Position(*p.LookedAtBlock)
+	}
+}
+
+func (p *TabCompleteServerbound) Decode(r *Reader) {
+	*p = TabCompleteServerbound{Text: r.String(), AssumeCommand: r.Bool()}
+	if r.Bool() {
+		v := r.Position()
+		p.LookedAtBlock = &v
+	}
+}
+
+type ChatMessageServerbound struct {
+	Message string
+}
+
+func (p *ChatMessageServerbound) Encode(w *Writer) { w.String(p.Message) }
+func (p *ChatMessageServerbound) Decode(r *Reader) { p.Message = r.String() }
+
+// ClientStatus, ActionID 0 respawn and 1 request the statistics
+type ClientStatus struct {
+	ActionID int32
+}
+
+func (p *ClientStatus) Encode(w *Writer) { w.VarInt(p.ActionID) }
+func (p *ClientStatus) Decode(r *Reader) { p.ActionID = r.VarInt() }
+
+type ClientSettings struct {
+	Locale             string
+	ViewDistance       int8
+	ChatMode           int32
+	ChatColors         bool
+	DisplayedSkinParts byte
+	MainHand           int32
+}
+
+func (p *ClientSettings) Encode(w *Writer) {
+	w.String(p.Locale)
+	w.Byte(p.ViewDistance)
+	w.VarInt(p.ChatMode)
+	w.Bool(p.ChatColors)
+	w.UByte(p.DisplayedSkinParts)
+	w.VarInt(p.MainHand)
+}
+
+func (p *ClientSettings) Decode(r *Reader) {
+	p.Locale = r.String()
+	p.ViewDistance = r.Byte()
+	p.ChatMode = r.VarInt()
+	p.ChatColors = r.Bool()
+	p.DisplayedSkinParts = r.UByte()
+	p.MainHand = r.VarInt()
+}
+
+type ConfirmTransactionServerbound struct {
+	WindowID     int8
+	ActionNumber int16
+	Accepted     bool
+}
+
+func (p *ConfirmTransactionServerbound) Encode(w *Writer) {
+	w.Byte(p.WindowID)
+	w.Short(p.ActionNumber)
+	w.Bool(p.Accepted)
+}
+
+func (p *ConfirmTransactionServerbound) Decode(r *Reader) {
+	p.WindowID = r.Byte()
+	p.ActionNumber = r.Short()
+	p.Accepted = r.Bool()
+}
+
+type EnchantItem struct {
+	WindowID    int8
+	Enchantment int8
+}
+
+func (p *EnchantItem) Encode(w *Writer) {
+	w.Byte(p.WindowID)
+	w.Byte(p.Enchantment)
+}
+
+func (p *EnchantItem) Decode(r *Reader) {
+	p.WindowID = r.Byte()
+	p.Enchantment = r.Byte()
+}
+
+type ClickWindow struct {
+	WindowID     byte
+	Slot         int16
+	Button       int8
+	ActionNumber int16
+	Mode         int32
+	ClickedItem  Slot
+}
+
+func (p *ClickWindow) Encode(w *Writer) {
+	w.UByte(p.WindowID)
+	w.Short(p.Slot)
+	w.Byte(p.Button)
+	w.Short(p.ActionNumber)
+	w.VarInt(p.Mode)
+	w.Slot(p.ClickedItem)
+}
+
+func (p *ClickWindow) Decode(r *Reader) {
+	p.WindowID = r.UByte()
+	p.Slot = r.Short()
+	p.Button = r.Byte()
+	p.ActionNumber = r.Short()
+	p.Mode = r.VarInt()
+	p.ClickedItem = r.Slot()
+}
+
+type CloseWindowServerbound struct {
+	WindowID byte
+}
+
+func (p *CloseWindowServerbound) Encode(w *Writer) { w.UByte(p.WindowID) }
+func (p *CloseWindowServerbound) Decode(r *Reader) { p.WindowID = r.UByte() }
+
+type PluginMessageServerbound struct {
+	Channel string
+	Data    []byte
+}
+
+func (p *PluginMessageServerbound) Encode(w *Writer) {
+	w.String(p.Channel)
+	w.Raw(p.Data)
+}
+
+func (p *PluginMessageServerbound) Decode(r *Reader) {
+	p.Channel = r.String()
+	p.Data = r.Rest()
+}
+
+// The types of UseEntity
+const (
+	UseEntityInteract int32 = iota
+	UseEntityAttack
+	UseEntityInteractAt
+)
+
+// UseEntity, Target is only sent to interact at a point and Hand to interact
+type UseEntity struct {
+	Target                    int32
+	Type                      int32
+	TargetX, TargetY, TargetZ float32
+	Hand                      int32
+}
+
+func (p *UseEntity) Encode(w *Writer) {
+	w.VarInt(p.Target)
+	w.VarInt(p.Type)
+	if p.Type == UseEntityInteractAt {
+		w.Float(p.TargetX)
+		w.Float(p.TargetY)
+		w.Float(p.TargetZ)
+	}
+	if p.Type == UseEntityInteract || p.Type == UseEntityInteractAt {
+		w.VarInt(p.Hand)
+	}
+}
+
+func (p *UseEntity) Decode(r *Reader) {
+	*p = UseEntity{Target: r.VarInt(), Type: r.VarInt()}
+	if p.Type == UseEntityInteractAt {
+		p.TargetX = r.Float()
+		p.TargetY = r.Float()
+		p.TargetZ = r.Float()
+	}
+	if p.Type == UseEntityInteract || p.Type == UseEntityInteractAt {
+		p.Hand = r.VarInt()
+	}
+}
+
+type KeepAliveServerbound struct {
+	KeepAliveID int64
+}
+
+func (p *KeepAliveServerbound) Encode(w *Writer) { writeKeepAliveID(w, p.KeepAliveID) }
+func (p *KeepAliveServerbound) Decode(r *Reader) { p.KeepAliveID = readKeepAliveID(r) }
+
+// Player is sent when the player doesn't move nor look around
+type Player struct {
+	OnGround bool
+}
+
+func (p *Player) Encode(w *Writer) { w.Bool(p.OnGround) }
+func (p *Player) Decode(r *Reader) { p.OnGround = r.Bool() }
+
+// PlayerPosition, Y is the feet of the player
+type PlayerPosition struct {
+	Position Vector3
+	OnGround bool
+}
+
+func (p *PlayerPosition) Encode(w *Writer) {
+	writeVector(w, p.Position)
+	w.Bool(p.OnGround)
+}
+
+func (p *PlayerPosition) Decode(r *Reader) {
+	p.Position = readVector(r)
+	p.OnGround = r.Bool()
+}
+
+type PlayerPositionAndLookServerbound struct {
+	Position   Vector3
+	Yaw, Pitch float32
+	OnGround   bool
+}
+
+func (p *PlayerPositionAndLookServerbound) Encode(w *Writer) {
+	writeVector(w, p.Position)
+	w.Float(p.Yaw)
+	w.Float(p.Pitch)
+	w.Bool(p.OnGround)
+}
+
+func (p *PlayerPositionAndLookServerbound) Decode(r *Reader) {
+	p.Position = readVector(r)
+	p.Yaw = r.Float()
+	p.Pitch = r.Float()
+	p.OnGround = r.Bool()
+}
+
+type PlayerLook struct {
+	Yaw, Pitch float32
+	OnGround   bool
+}
+
+func (p *PlayerLook) Encode(w *Writer) {
+	w.Float(p.Yaw)
+	w.Float(p.Pitch)
+	w.Bool(p.OnGround)
+}
+
+func (p *PlayerLook) Decode(r *Reader) {
+	p.Yaw = r.Float()
+	p.Pitch = r.Float()
+	p.OnGround = r.Bool()
+}
+
+type VehicleMoveServerbound struct {
+	Position   Vector3
+	Yaw, Pitch float32
+}
+
+func (p *VehicleMoveServerbound) Encode(w *Writer) {
+	writeVector(w, p.Position)
+	w.Float(p.Yaw)
+	w.Float(p.Pitch)
+}
+
+func (p *VehicleMoveServerbound) Decode(r *Reader) {
+	p.Position = readVector(r)
+	p.Yaw = r.Float()
+	p.Pitch = r.Float()
+}
+
+type SteerBoat struct {
+	RightPaddleTurning bool
+	LeftPaddleTurning  bool
+}
+
+func (p *SteerBoat) Encode(w *Writer) {
+	w.Bool(p.RightPaddleTurning)
+	w.Bool(p.LeftPaddleTurning)
+}
+
+func (p *SteerBoat) Decode(r *Reader) {
+	p.RightPaddleTurning = r.Bool()
+	p.LeftPaddleTurning = r.Bool()
+}
+
+type CraftRecipeRequest struct {
+	WindowID int8
+	Recipe   int32
+	MakeAll  bool
+}
+
+func (p *CraftRecipeRequest) Encode(w *Writer) {
+	w.Byte(p.WindowID)
+	w.VarInt(p.Recipe)
+	w.Bool(p.MakeAll)
+}
+
+func (p *CraftRecipeRequest) Decode(r *Reader) {
+	p.WindowID = r.Byte()
+	p.Recipe = r.VarInt()
+	p.MakeAll = r.Bool()
+}
+
+// PrepareCraftingGrid moves the items of a recipe in the crafting grid, it's only in 1.12 (335)
+type PrepareCraftingGrid struct {
+	WindowID       byte
+	ActionNumber   int16
+	ReturnEntries  []CraftingGridEntry // the items moved from the grid to the inventory
+	PrepareEntries []CraftingGridEntry // the items moved from the inventory to the grid
+}
+
+type CraftingGridEntry struct {
+	Item         Slot
+	CraftingSlot byte
+	PlayerSlot   byte
+}
+
+func (p *PrepareCraftingGrid) Encode(w *Writer) {
+	w.UByte(p.WindowID)
+	w.Short(p.ActionNumber)
+	writeCraftingGridEntries(w, p.ReturnEntries)
+	writeCraftingGridEntries(w, p.PrepareEntries)
+}
+
+func (p *PrepareCraftingGrid) Decode(r *Reader) {
+	p.WindowID = r.UByte()
+	p.ActionNumber = r.Short()
+	p.ReturnEntries = readCraftingGridEntries(r)
+	p.PrepareEntries = readCraftingGridEntries(r)
+}
+
+// the entries are after their number in a short
+func writeCraftingGridEntries(w *Writer, entries []CraftingGridEntry) {
+	w.Short(int16(len(entries)))
+	for _, e := range entries {
+		w.Slot(e.Item)
+		w.UByte(e.CraftingSlot)
+		w.UByte(e.PlayerSlot)
+	}
+}
+
+func readCraftingGridEntries(r *Reader) []CraftingGridEntry {
+	n := int(r.Short())
+	if n < 0 || n > r.Len() {
+		r.fail(errCount(n, r.Len()))
+	}
+	if n <= 0 || r.err != nil {
+		return nil
+	}
+	entries := make([]CraftingGridEntry, n)
+	for i := range entries {
+		entries[i] = CraftingGridEntry{Item: r.Slot(), CraftingSlot: r.UByte(), PlayerSlot: r.UByte()}
+	}
+	return entries
+}
+
+type PlayerAbilitiesServerbound struct {
+	Flags        int8
+	FlyingSpeed  float32
+	WalkingSpeed float32
+}
+
+func (p *PlayerAbilitiesServerbound) Encode(w *Writer) {
+	w.Byte(p.Flags)
+	w.Float(p.FlyingSpeed)
+	w.Float(p.WalkingSpeed)
+}
+
+func (p *PlayerAbilitiesServerbound) Decode(r *Reader) {
+	p.Flags = r.Byte()
+	p.FlyingSpeed = r.Float()
+	p.WalkingSpeed = r.Float()
+}
+
+// The status of PlayerDigging
+const (
+	DiggingStarted int32 = iota
+	DiggingCancelled
+	DiggingFinished
+	DropItemStack
+	DropItem
+	ShootArrowFinishEating
+	SwapItemInHand
+)
+
+type PlayerDigging struct {
+	Status   int32
+	Location Vector3
+	Face     int8
+}
+
+func (p *PlayerDigging) Encode(w *Writer) {
+	w.VarInt(p.Status)
+	w.Position(p.Location)
+	w.Byte(p.Face)
+}
+
+func (p *PlayerDigging) Decode(r *Reader) {
+	p.Status = r.VarInt()
+	p.Location = r.Position()
+	p.Face = r.Byte()
+}
+
+// The actions of EntityAction
+const (
+	ActionStartSneaking int32 = iota
+	ActionStopSneaking
+	ActionLeaveBed
+	ActionStartSprinting
+	ActionStopSprinting
+	ActionStartHorseJump
+	ActionStopHorseJump
+	ActionOpenHorseInventory
+	ActionStartElytraFlying
+)
+
+// EntityAction, JumpBoost is only used when jumping with a horse
+type EntityAction struct {
+	EntityID  int32
+	ActionID  int32
+	JumpBoost int32
+}
+
+func (p *EntityAction) Encode(w *Writer) {
+	w.VarInt(p.EntityID)
+	w.VarInt(p.ActionID)
+	w.VarInt(p.JumpBoost)
+}
+
+func (p *EntityAction) Decode(r *Reader) {
+	p.EntityID = r.VarInt()
+	p.ActionID = r.VarInt()
+	p.JumpBoost = r.VarInt()
+}
+
+// SteerVehicle flags: 0x01 jump, 0x02 unmount
+type SteerVehicle struct {
+	Sideways float32
+	Forward  float32
+	Flags    byte
+}
+
+func (p *SteerVehicle) Encode(w *Writer) {
+	w.Float(p.Sideways)
+	w.Float(p.Forward)
+	w.UByte(p.Flags)
+}
+
+func (p *SteerVehicle) Decode(r *Reader) {
+	p.Sideways = r.Float()
+	p.Forward = r.Float()
+	p.Flags = r.UByte()
+}
+
+// CraftingBookData, Type 0 sends DisplayedRecipe and 1 the status of the book
+type CraftingBookData struct {
+	Type             int32
+	DisplayedRecipe  int32
+	CraftingBookOpen bool
+	CraftingFilter   bool
+}
+
+func (p *CraftingBookData) Encode(w *Writer) {
+	w.VarInt(p.Type)
+	switch p.Type {
+	case 0:
+		w.Int(p.DisplayedRecipe)
+	case 1:
+		w.Bool(p.CraftingBookOpen)
+		w.Bool(p.CraftingFilter)
+	}
+}
+
+func (p *CraftingBookData) Decode(r *Reader) {
+	*p = CraftingBookData{Type: r.VarInt()}
+	switch p.Type {
+	case 0:
+		p.DisplayedRecipe = r.Int()
+	case 1:
+		p.CraftingBookOpen = r.Bool()
+		p.CraftingFilter = r.Bool()
+	}
+}
+
+type ResourcePackStatus struct {
+	Result int32
+}
+
+func (p *ResourcePackStatus) Encode(w *Writer) { w.VarInt(p.Result) }
+func (p *ResourcePackStatus) Decode(r *Reader) { p.Result = r.VarInt() }
+
+// AdvancementTab, Action 0 opens TabID and 1 closes the screen
+type AdvancementTab struct {
+	Action int32
+	TabID  string
+}
+
+func (p *AdvancementTab) Encode(w *Writer) {
+	w.VarInt(p.Action)
+	if p.Action == 0 {
+		w.String(p.TabID)
+	}
+}
+
+func (p *AdvancementTab) Decode(r *Reader) {
+	*p = AdvancementTab{Action: r.VarInt()}
+	if p.Action == 0 {
+		p.TabID = r.String()
+	}
+}
+
+// HeldItemChangeServerbound selects a slot of the hotbar, 0 to 8
+type HeldItemChangeServerbound struct {
+	Slot int16
+}
+
+func (p *HeldItemChangeServerbound) Encode(w *Writer) { w.Short(p.Slot) }
+func (p *HeldItemChangeServerbound) Decode(r *Reader) { p.Slot = r.Short() }
+
+type CreativeInventoryAction struct {
+	Slot        int16
+	ClickedItem Slot
+}
+
+func (p *CreativeInventoryAction) Encode(w *Writer) {
+	w.Short(p.Slot)
+	w.Slot(p.ClickedItem)
+}
+
+func (p *CreativeInventoryAction) Decode(r *Reader) {
+	p.Slot = r.Short()
+	p.ClickedItem = r.Slot()
+}
+
+type UpdateSign struct {
+	Location Vector3
+	Lines    [4]string
+}
+
+func (p *UpdateSign) Encode(w *Writer) {
+	w.Position(p.Location)
+	for _, line := range p.Lines {
+		w.String(line)
+	}
+}
+
+func (p *UpdateSign) Decode(r *Reader) {
+	p.Location = r.Position()
+	for i := range p.Lines {
+		p.Lines[i] = r.String()
+	}
+}
+
+// AnimationServerbound swings the arm of Hand, 0 main hand and 1 off hand
+type AnimationServerbound struct {
+	Hand int32
+}
+
+func (p *AnimationServerbound) Encode(w *Writer) { w.VarInt(p.Hand) }
+func (p *AnimationServerbound) Decode(r *Reader) { p.Hand = r.VarInt() }
+
+type Spectate struct {
+	TargetPlayer [2]int64
+}
+
+func (p *Spectate) Encode(w *Writer) { w.UUID(p.TargetPlayer) }
+func (p *Spectate) Decode(r *Reader) { p.TargetPlayer = r.UUID() }
+
+// PlayerBlockPlacement places a block against the Face of Location, the cursor is in the block, 0 to 1
+type PlayerBlockPlacement struct {
+	Location                  Vector3
+	Face                      int32
+	Hand                      int32
+	CursorX, CursorY, CursorZ float32
+}
+
+func (p *PlayerBlockPlacement) Encode(w *Writer) {
+	w.Position(p.Location)
+	w.VarInt(p.Face)
+	w.VarInt(p.Hand)
+	w.Float(p.CursorX)
+	w.Float(p.CursorY)
+	w.Float(p.CursorZ)
+}
+
+func (p *PlayerBlockPlacement) Decode(r *Reader) {
+	p.Location = r.Position()
+	p.Face = r.VarInt()
+	p.Hand = r.VarInt()
+	p.CursorX = r.Float()
+	p.CursorY = r.Float()
+	p.CursorZ = r.Float()
+}
+
+type UseItem struct {
+	Hand int32
+}
+
+func (p *UseItem) Encode(w *Writer) { w.VarInt(p.Hand) }
+func (p *UseItem) Decode(r *Reader) { p.Hand = r.VarInt() }
@@ -0,0 +1,123 @@
+package protocol
+
+import (
+	"reflect"
+	"sort"
+)
+
+// The protocol versions supported
+const (
+	Version1_12   = 335
+	Version1_12_1 = 338
+	Version1_12_2 = 340
+)
+
+// The play packets of 1.12.2, by ID
+var (
+	clientbound340 = []Packet{
+		&SpawnObject{}, &SpawnExperienceOrb{}, &SpawnGlobalEntity{}, &SpawnMob{},
+		&SpawnPainting{}, &SpawnPlayer{}, &AnimationClientbound{}, &Statistics{},
+		&BlockBreakAnimation{}, &UpdateBlockEntity{}, &BlockAction{}, &BlockChange{},
+		&BossBar{}, &ServerDifficulty{}, &TabCompleteClientbound{}, &ChatMessageClientbound{},
+		&MultiBlockChange{}, &ConfirmTransactionClientbound{}, &CloseWindowClientbound{}, &OpenWindow{},
+		&WindowItems{}, &WindowProperty{}, &SetSlot{}, &SetCooldown{},
+		&PluginMessageClientbound{}, &NamedSoundEffect{}, &Disconnect{}, &EntityStatus{},
+		&Explosion{}, &UnloadChunk{}, &ChangeGameState{}, &KeepAliveClientbound{},
+		&ChunkData{}, &Effect{}, &Particle{}, &JoinGame{},
+		&Map{}, &Entity{}, &EntityRelativeMove{}, &EntityLookAndRelativeMove{},
+		&EntityLook{}, &VehicleMoveClientbound{}, &OpenSignEditor{}, &CraftRecipeResponse{},
+		&PlayerAbilitiesClientbound{}, &CombatEvent{}, &PlayerListItem{}, &PlayerPositionAndLookClientbound{},
+		&UseBed{}, &UnlockRecipes{}, &DestroyEntities{}, &RemoveEntityEffect{},
+		&ResourcePackSend{}, &Respawn{}, &EntityHeadLook{}, &SelectAdvancementTab{},
+		&WorldBorder{}, &Camera{}, &HeldItemChangeClientbound{}, &DisplayScoreboard{},
+		&EntityMetadata{}, &AttachEntity{}, &EntityVelocity{}, &EntityEquipment{},
+		&SetExperience{}, &UpdateHealth{}, &ScoreboardObjective{}, &SetPassengers{},
+		&Teams{}, &UpdateScore{}, &SpawnPosition{}, &TimeUpdate{},
+		&Title{}, &SoundEffect{}, &PlayerListHeaderAndFooter{}, &CollectItem{},
+		&EntityTeleport{}, &Advancements{}, &EntityProperties{}, &EntityEffect{},
+	}
+	serverbound340 = []Packet{
+		&TeleportConfirm{}, &TabCompleteServerbound{}, &ChatMessageServerbound{}, &ClientStatus{},
+		&ClientSettings{}, &ConfirmTransactionServerbound{}, &EnchantItem{}, &ClickWindow{},
+		&CloseWindowServerbound{}, &PluginMessageServerbound{}, &UseEntity{}, &KeepAliveServerbound{},
+		&Player{}, &PlayerPosition{}, &PlayerPositionAndLookServerbound{}, &PlayerLook{},
+		&VehicleMoveServerbound{}, &SteerBoat{}, &CraftRecipeRequest{}, &PlayerAbilitiesServerbound{},
+		&PlayerDigging{}, &EntityAction{}, &SteerVehicle{}, &CraftingBookData{},
+		&ResourcePackStatus{}, &AdvancementTab{}, &HeldItemChangeServerbound{}, &CreativeInventoryAction{},
+		&UpdateSign{}, &AnimationServerbound{}, &Spectate{}, &PlayerBlockPlacement{},
+		&UseItem{},
+	}
+)
+
+var (
+	// Protocol340 is the registry of Minecraft 1.12.2
+	Protocol340 = NewRegistry(Version1_12_2).register(clientbound340, serverbound340)
+	// Protocol338 is the registry of Minecraft 1.12.1, it has the IDs of 1.12.2 but the keep alive IDs are VarInts
+	Protocol338 = NewRegistry(Version1_12_1).register(clientbound340, serverbound340)
+	// Protocol335 is the registry of Minecraft 1.12, without the recipe book packets of 1.12.1
+	// and with Prepare Crafting Grid instead
+	Protocol335 = NewRegistry(Version1_12).register(
+		without(clientbound340, &CraftRecipeResponse{}),
+		insert(without(serverbound340, &CraftRecipeRequest{}), 0x01, &PrepareCraftingGrid{}),
+	)
+)
+
+// registries are the registries of the supported versions
+var registries = map[int32]*Registry{
+	Version1_12:   Protocol335,
+	Version1_12_1: Protocol338,
+	Version1_12_2: Protocol340,
+}
+
+// Latest is the registry of the latest version supported
+var Latest = Protocol340
+
+// RegistryOf return the registry of a protocol version, ok is false if it isn't supported
+func RegistryOf(version int32) (r *Registry, ok bool) {
+	r, ok = registries[version]
+	return
+}
+
+// Versions return the protocol versions supported, from the oldest
+func Versions() []int32 {
+	versions := make([]int32, 0, len(registries))
+	for v := range registries {
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	return versions
+}
+
+// without return the packets without the one of the type of p, the next ones take the ID before theirs
+func without(packets []Packet, p Packet) []Packet {
+	var ps []Packet
+	for _, q := range packets {
+		if reflect.TypeOf(q) != reflect.TypeOf(p) {
+			ps = append(ps, q)
+		}
+	}
+	return ps
+}
+
+// insert return the packets with p at the ID, the next ones take the ID after theirs
+func insert(packets []Packet, id int, p Packet) []Packet {
+	ps := append([]Packet(nil), packets[:id]...)
+	ps = append(ps, p)
+	return append(ps, packets[id:]...)
+}
+
+// The keep alive IDs are Longs since 1.12.2, VarInts before
+func writeKeepAliveID(w *Writer, id int64) {
+	if w.Version != 0 && w.Version < Version1_12_2 {
+		w.VarInt(int32(id))
+		return
+	}
+	w.Long(id)
+}
+
+func readKeepAliveID(r *Reader) int64 {
+	if r.Version != 0 && r.Version < Version1_12_2 {
+		return int64(r.VarInt())
+	}
+	return r.Long()
+}
@@ -15,10 +15,12 @@ import (
 	. "github.com/edouard127/mc-go-1.12.2/data/World"
 	. "github.com/edouard127/mc-go-1.12.2/data/entities"
 	pk "github.com/edouard127/mc-go-1.12.2/packet"
+	"github.com/edouard127/mc-go-1.12.2/protocol"
 	"io/ioutil"
 	"net"
 	"net/http"
 	"strings"
+	"time"
 )
 
 // Auth includes a account
@@ -28,74 +30,68 @@ type Auth struct {
 	AsTk string
 }
 
-func (p *Auth) PingServer(addr string, port int) (err error) {
-
-	// Connection
-	g := new(Game)
-	g.Conn, err = net.Dial("tcp", fmt.Sprintf("%s:%d", addr, port))
+// PingServer ask the status of a server, with its protocol version
+func (p *Auth) PingServer(addr string, port int) (*ServerStatus, error) {
+	conn, err := net.DialTimeout("tcp", fmt.Sprintf("%s:%d", addr, port), pingTimeout)
 	if err != nil {
-		err = fmt.Errorf("cannot connect the server %q: %v", addr, err)
-		return
+		return nil, fmt.Errorf("cannot connect the server %q: %v", addr, err)
 	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(pingTimeout))
+	g := &Game{Conn: conn, Receiver: bufio.NewReader(conn), Sender: conn}
 
-	//init Game
-	g.Receiver = bufio.NewReader(g.Conn)
-	g.Sender = g.Conn
-
-	// Handshake
-	hsPacket := NewHandshakePacket(340, addr, port, 1) // Constructing handshake packets
-	err = g.SendPacket(hsPacket)
+	// Any version can ask the status
+	err = g.SendPacket(NewHandshakePacket(int(protocol.Latest.Version), addr, port, 1))
 	if err != nil {
-		err = fmt.Errorf("send handshake packect fail: %v", err)
-		return
+		return nil, fmt.Errorf("send handshake packect fail: %v", err)
 	}
-
-	// Login
-	lsPacket := newLoginStartPacket(p.Name)
-	err = g.SendPacket(lsPacket) //LoginStart
+	if err = g.SendPacket(loginPacket(&protocol.StatusRequest{})); err != nil {
+		return nil, fmt.Errorf("send status request packet fail: %v", err)
+	}
+	pack, err := g.recvPacket()
 	if err != nil {
-		err = fmt.Errorf("send login start packect fail: %v", err)
-		return
+		return nil, fmt.Errorf("recv packet at state Status fail: %v", err)
 	}
-	for {
-		//Receive Packet
-		var pack *pk.Packet
-		pack, err = g.recvPacket()
-		if err != nil {
-			err = fmt.Errorf("recv packet at state Login fail: %v\nWe are going to ignore this error\n", err)
-			return
-		}
-
-		//Handle Packet
-		switch pack.ID {
-		case 0x00: // Status reponse
-			fmt.Printf("Status reponse: %s", string(pack.Data))
-			return
-		default:
-			err = fmt.Errorf("unknown packet ID %d at state Login\n", pack.ID)
-			return
-		}
-		return
+	if pack.ID != 0x00 {
+		return nil, fmt.Errorf("unknown packet ID %d at state Status", pack.ID)
+	}
+	var resp protocol.StatusResponse
+	if err := protocol.Unmarshal(pack.Data, &resp); err != nil {
+		return nil, err
+	}
+	status := new(ServerStatus)
+	if err := json.Unmarshal([]byte(resp.JSON), status); err != nil {
+		return nil, fmt.Errorf("read status response fail: %v", err)
 	}
+	return status, nil
 }
 
+// pingTimeout is the time given to a server to answer a status ping
+var pingTimeout = 5 * time.Second
+
 // JoinServer connect a Minecraft server.
-// Return a JSON string about server status.
-// see JSON format at https://wiki.vg/Server_List_Ping#Response
+// The protocol version is the one of the server if it's supported, else the latest one.
 func (p *Auth) JoinServer(addr string, port int) (g *Game, err error) {
 	var ras string
+	var status *ServerStatus
 
 	_, records, _ := net.LookupSRV("minecraft", "tcp", addr)
 	for _, srv := range records {
-		e := p.PingServer(srv.Target, int(srv.Port))
-		if e == nil {
-			ras = fmt.Sprintf("%s:%d", srv.Target, srv.Port)
+		if s, e := p.PingServer(srv.Target, int(srv.Port)); e == nil {
+			ras, status = fmt.Sprintf("%s:%d", srv.Target, srv.Port), s
 			break
 		}
 	}
 	// Fallback If SRV record not found
 	if ras == "" {
 		ras = fmt.Sprintf("%s:%d", addr, port)
+		status, _ = p.PingServer(addr, port) // some servers don't answer, try the latest version
+	}
+	version := protocol.Latest
+	if status != nil {
+		if r, ok := protocol.RegistryOf(status.Version.Protocol); ok {
+			version = r
+		}
 	}
 
 	// Connection
@@ -113,13 +109,12 @@ func (p *Auth) JoinServer(addr string, port int) (g *Game, err error) {
 	g.Sender = g.Conn
 	g.World.Entities = make(map[int32]*Entity)
 	g.World.Columns = make(map[ChunkPos]*Chunk)
-	g.Events = make(chan Event)
-	g.Motion = make(chan func())
+	g.Events = NewEventBus()
 	g.Server = Server{Addr: addr, Port: port}
-	BuildBlockData()
+	g.protocol = version
 
 	// Handshake
-	hsPacket := NewHandshakePacket(340, addr, port, 2) // Constructing handshake packets
+	hsPacket := NewHandshakePacket(int(version.Version), addr, port, 2) // Constructing handshake packets
 	err = g.SendPacket(hsPacket)
 	if err != nil {
 		err = fmt.Errorf("send handshake packect fail: %v", err)
@@ -150,8 +145,9 @@ func (p *Auth) JoinServer(addr string, port int) (g *Game, err error) {
 		//Handle Packet
 		switch pack.ID {
 		case 0x00: //Disconnect
-			s, _ := pk.UnpackString(bytes.NewReader(pack.Data))
-			err = fmt.Errorf("connect disconnected by server because: %s", s)
+			var d protocol.LoginDisconnect
+			protocol.Unmarshal(pack.Data, &d)
+			err = fmt.Errorf("connect disconnected by server because: %s", d.Reason)
 			return
 		case 0x01: //Encryption Request
 			HandleEncryptionRequest(g, pack, p)
@@ -160,8 +156,11 @@ func (p *Auth) JoinServer(addr string, port int) (g *Game, err error) {
 			// name, _ := unpackString(pack.Data[l:])
 			return //switches the connection state to PLAY.
 		case 0x03: //Set Compression
-			threshold, _ := pk.UnpackVarInt(bytes.NewReader(pack.Data))
-			g.threshold = int(threshold)
+			var sc protocol.SetCompression
+			if err = protocol.Unmarshal(pack.Data, &sc); err != nil {
+				return
+			}
+			g.threshold = int(sc.Threshold)
 		case 0x04: //Login Plugin Request
 			fmt.Println("Waring Login Plugin Request")
 		default:
@@ -177,34 +176,15 @@ type encryptionRequest struct {
 }
 
 func UnpackEncryptionRequest(p pk.Packet) (*encryptionRequest, error) {
-	r := bytes.NewReader(p.Data)
-	serverID, err := pk.UnpackString(r)
-	if err != nil {
-		return nil, err
-	}
-	publicKeyLength, err := pk.UnpackVarInt(r)
-	if err != nil {
-		return nil, err
-	}
-	publicKey, err := pk.ReadNBytes(r, int(publicKeyLength))
-	if err != nil {
-		return nil, err
-	}
-	verifyTokenLength, err := pk.UnpackVarInt(r)
-	if err != nil {
+	var er protocol.EncryptionRequest
+	if err := protocol.Unmarshal(p.Data, &er); err != nil {
 		return nil, err
 	}
-	verifyToken, err := pk.ReadNBytes(r, int(verifyTokenLength))
-	if err != nil {
-		return nil, err
-	}
-
-	er := encryptionRequest{
-		ServerID:    serverID,
-		PublicKey:   publicKey,
-		VerifyToken: verifyToken,
-	}
-	return &er, nil
+	return &encryptionRequest{
+		ServerID:    er.ServerID,
+		PublicKey:   er.PublicKey,
+		VerifyToken: er.VerifyToken,
+	}, nil
 }
 
 // authDigest computes a special SHA-1 digest required for Minecraft web
@@ -329,15 +309,7 @@ func GenEncryptionKeyResponse(shareSecret, publicKey, verifyToken []byte) (erp *
 		err = fmt.Errorf("encryption verfy tokenfail: %v", err)
 		return
 	}
-	var data []byte
-	data = append(data, pk.PackVarInt(int32(len(cryptPK)))...)
-	data = append(data, cryptPK...)
-	data = append(data, pk.PackVarInt(int32(len(verifyT)))...)
-	data = append(data, verifyT...)
-	erp = &pk.Packet{
-		ID:   0x01,
-		Data: data,
-	}
+	erp = loginPacket(&protocol.EncryptionResponse{SharedSecret: cryptPK, VerifyToken: verifyT})
 	return
 }
 
@@ -351,28 +323,28 @@ func PingPacket() (Ping *pk.Packet) {
 
 // NewHandshakePacket 构造了一个Handshake包
 func NewHandshakePacket(protocolVersion int, addr string, port int, nextState byte) *pk.Packet {
-	var hsData []byte                                                 //Handshake packet data
-	hsData = append(hsData, pk.PackVarInt(int32(protocolVersion))...) //Protocol Version
-	hsData = append(hsData, pk.PackString(addr)...)
-	hsData = append(hsData, pk.PackUint16(uint16(port))...)
-	hsData = append(hsData, nextState)
-	pack := pk.Packet{
-		ID:   0,
-		Data: hsData,
-	}
-	return &pack
+	return loginPacket(&protocol.Handshake{
+		ProtocolVersion: int32(protocolVersion),
+		ServerAddress:   addr,
+		ServerPort:      uint16(port),
+		NextState:       int32(nextState),
+	})
 }
 
 // newLoginStartPakcket 构造一个LoginStart包
 func newLoginStartPacket(userName string) *pk.Packet {
-	var data []byte
-	data = append(data, pk.PackString(userName)...)
-	pack := pk.Packet{
-		ID:   0,
-		Data: data,
+	return loginPacket(&protocol.LoginStart{Name: userName})
+}
+
+// loginPacket return the packet of p, sent before the play state
+func loginPacket(p protocol.Packet) *pk.Packet {
+	pack, err := protocol.Latest.Pack(p)
+	if err != nil {
+		panic(err) // all the packets of the login are registered, the same in every version
 	}
-	return &pack
+	return pack
 }
+
 func HandleEncryptionRequest(g *Game, pack *pk.Packet, auth *Auth) error {
 	//Create AES symmetric encryption key
 	key, encoStream, decoStream := NewSymmetricEncryption()
@@ -0,0 +1,46 @@
+package _struct
+
+import (
+	"net"
+	"testing"
+
+	pk "github.com/edouard127/mc-go-1.12.2/packet"
+	"github.com/edouard127/mc-go-1.12.2/protocol"
+)
+
+func TestAuth_PingServer(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip(err)
+	}
+	defer l.Close()
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := pk.Reader{R: conn}
+		var hs protocol.Handshake
+		if p, err := r.ReadPacket(); err != nil || protocol.Unmarshal(p.Data, &hs) != nil || hs.NextState != 1 {
+			t.Errorf("handshake %+v, %v", hs, err)
+			return
+		}
+		if p, err := r.ReadPacket(); err != nil || p.ID != 0x00 || len(p.Data) != 0 {
+			t.Errorf("status request %v, %v", p, err)
+			return
+		}
+		resp := &protocol.StatusResponse{JSON: `{"version":{"name":"1.12","protocol":335},"players":{"max":20,"online":3},"description":{"text":"hi"}}`}
+		w := pk.Writer{W: conn}
+		w.WritePacket(&pk.Packet{ID: 0x00, Data: protocol.Marshal(resp)})
+	}()
+
+	addr := l.Addr().(*net.TCPAddr)
+	status, err := new(Auth).PingServer(addr.IP.String(), addr.Port)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Version.Protocol != 335 || status.Players.Online != 3 || string(status.Description) != `{"text":"hi"}` {
+		t.Errorf("status %+v", status)
+	}
+}
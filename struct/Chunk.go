@@ -4,81 +4,69 @@ import (
 	"bytes"
 	"fmt"
 	"github.com/edouard127/mc-go-1.12.2/data/World"
+	"github.com/edouard127/mc-go-1.12.2/nbt"
 	pk "github.com/edouard127/mc-go-1.12.2/packet"
-	"io"
+	"github.com/edouard127/mc-go-1.12.2/protocol"
 )
 
-func UnpackChunkDataPacket(p *pk.Packet, hasSkyLight bool) (c *World.Chunk, x, y int, err error) {
-	reader := bytes.NewReader(p.Data)
-	// Block coordinates
-	X, err := pk.UnpackInt32(reader)
-	if err != nil {
-		return nil, 0, 0, err
-	}
-	Y, err := pk.UnpackInt32(reader)
-	if err != nil {
-		return nil, 0, 0, err
-	}
-	// fmt.Println("Chunk: (", X, ", ", Y, ")") //Debug: Show Chunk loc
-	fc, err := reader.ReadByte()
-	if err != nil {
-		return nil, 0, 0, err
-	}
-	FullChunk := fc != 0x00
-
-	//Master Mask
-	PrimaryBitMask, err := pk.UnpackVarInt(reader)
-	if err != nil {
-		return nil, 0, 0, err
+// UnpackChunkDataPacket decode a 1.12.2 Chunk Data packet.
+// Only the sections whose bit is set in mask are present in the returned chunk.
+// If full is false, the chunk should be merged into the column already loaded.
+func UnpackChunkDataPacket(p *pk.Packet, hasSkyLight bool) (c *World.Chunk, pos World.ChunkPos, mask int32, full bool, err error) {
+	var d protocol.ChunkData
+	if err := protocol.Unmarshal(p.Data, &d); err != nil {
+		return nil, pos, 0, false, err
 	}
+	c, err = UnpackChunkData(&d, hasSkyLight)
+	return c, World.ChunkPos{d.ChunkX, d.ChunkZ}, d.PrimaryBitMask, d.GroundUpContinuous, err
+}
 
-	// Block Data
-	Size, err := pk.UnpackVarInt(reader)
+// UnpackChunkData decode the sections and the block entities of a Chunk Data packet
+func UnpackChunkData(d *protocol.ChunkData, hasSkyLight bool) (c *World.Chunk, err error) {
+	c, err = readChunkColumn(d.GroundUpContinuous, d.PrimaryBitMask, bytes.NewReader(d.Data), hasSkyLight)
 	if err != nil {
-		return nil, 0, 0, err
+		return nil, err
 	}
-	Data := make([]byte, Size)
-	_, err = io.ReadAtLeast(reader, Data, int(Size))
+	c.BlockEntity, err = readBlockEntities(d.BlockEntities)
 	if err != nil {
-		return nil, 0, 0, err
+		return nil, err
 	}
-
-	// Entity Information
-	// NumberofBlockEntities, len := pk.UnpackVarInt(p.Data[index:])
-	// index += len
-
-	// Parsing Block Data
-	cc, err := readChunkColumn(FullChunk, PrimaryBitMask, bytes.NewReader(Data), hasSkyLight)
-	if err != nil {
-		panic(err)
-	}
-	return cc, int(X), int(Y), err
+	return c, nil
 }
 
 func readChunkColumn(isFull bool, mask int32, data *bytes.Reader, hasSkyLight bool) (*World.Chunk, error) {
-	var c World.Chunk
-	for sectionY := 0; sectionY < 16; sectionY++ {
+	c := World.EmptyChunk(sectionsPerColumn)
+	for sectionY := 0; sectionY < sectionsPerColumn; sectionY++ {
 		if (mask & (1 << uint(sectionY))) != 0 { // Is the given bit set in the mask?
 			BitsPerBlock, err := data.ReadByte()
 			if err != nil {
 				return nil, fmt.Errorf("read BitsPerBlock fail: %v", err)
 			}
+			bpb := perBits(BitsPerBlock)
 			//Read Palette
-			var palette []uint
-			if BitsPerBlock < 9 {
-				length, err := pk.UnpackVarInt(data)
-				if err != nil {
-					return nil, fmt.Errorf("read palette (id len) fail: %v", err)
+			// The length is always sent, it's 0 when using the global palette
+			length, err := pk.UnpackVarInt(data)
+			if err != nil {
+				return nil, fmt.Errorf("read palette (id len) fail: %v", err)
+			}
+			var palette []World.BlocksState
+			if bpb < 9 {
+				if length < 1 || length > 1<<bpb {
+					return nil, fmt.Errorf("invalid palette length %d for %d bits per block", length, bpb)
 				}
-				palette = make([]uint, length)
-
-				for id := uint(0); id < uint(length); id++ {
+				palette = make([]World.BlocksState, length)
+				for id := range palette {
 					stateID, err := pk.UnpackVarInt(data)
 					if err != nil {
 						return nil, fmt.Errorf("read palette (id) fail: %v", err)
 					}
-
-					palette[id] = uint(stateID)
+					palette[id] = World.BlocksState(stateID)
+				}
+			} else {
+				for i := int32(0); i < length; i++ {
+					if _, err := pk.UnpackVarInt(data); err != nil {
+						return nil, fmt.Errorf("read palette (id) fail: %v", err)
+					}
 				}
 			}
 
@@ -87,26 +75,31 @@ func readChunkColumn(isFull bool, mask int32, data *bytes.Reader, hasSkyLight bo
 			if err != nil {
 				return nil, fmt.Errorf("read DataArrayLength fail: %v", err)
 			}
+			if int(DataArrayLength) != 16*16*16*int(bpb)/64 {
+				return nil, fmt.Errorf("invalid DataArrayLength %d for %d bits per block", DataArrayLength, bpb)
+			}
 
-			DataArray := make([]int64, DataArrayLength)
+			DataArray := make([]uint64, DataArrayLength)
 			for i := 0; i < int(DataArrayLength); i++ {
-				DataArray[i], err = pk.UnpackInt64(data)
+				v, err := pk.UnpackInt64(data)
 				if err != nil {
 					return nil, fmt.Errorf("read DataArray fail: %v", err)
 				}
+				DataArray[i] = uint64(v)
 			}
 			//Populate blocks with data
-			fillSection(&c.Sections[sectionY], perBits(BitsPerBlock), DataArray, palette)
+			s := &c.Sections[sectionY]
+			if err := fillSection(s, bpb, DataArray, palette); err != nil {
+				return nil, fmt.Errorf("section %d: %v", sectionY, err)
+			}
 
-			//throw BlockLight data
-			_, err = pk.ReadNBytes(data, 2048)
+			s.BlockLight, err = pk.ReadNBytes(data, 2048)
 			if err != nil {
 				return nil, fmt.Errorf("read BlockLight fail: %v", err)
 			}
 
 			if hasSkyLight {
-				//throw SkyLight data
-				_, err = pk.ReadNBytes(data, 2048)
+				s.SkyLight, err = pk.ReadNBytes(data, 2048)
 				if err != nil {
 					return nil, fmt.Errorf("read SkyLight fail: %v", err)
 				}
@@ -114,17 +107,46 @@ func readChunkColumn(isFull bool, mask int32, data *bytes.Reader, hasSkyLight bo
 		}
 	}
 	if isFull { // Read Biomes Data
-		_, err := pk.ReadNBytes(data, 256)
+		var err error
+		c.Biomes, err = pk.ReadNBytes(data, 256)
 		if err != nil {
 			return nil, fmt.Errorf("read Biomes fail: %v", err)
 		}
 	}
+	c.Status = World.StatusFull
 
 	// fmt.Println(c)
-	return &c, nil
+	return c, nil
 }
 
-const defaultBitsPerBlock = 14
+func readBlockEntities(tags []protocol.NBT) ([]World.BlockEntity, error) {
+	entities := make([]World.BlockEntity, len(tags))
+	for i, tag := range tags {
+		var raw nbt.RawMessage
+		if _, err := nbt.NewDecoder(bytes.NewReader(tag)).Decode(&raw); err != nil {
+			return nil, fmt.Errorf("read BlockEntity fail: %v", err)
+		}
+		var header struct {
+			X int32 `nbt:"x"`
+			Y int32 `nbt:"y"`
+			Z int32 `nbt:"z"`
+		}
+		if err := raw.Unmarshal(&header); err != nil {
+			return nil, fmt.Errorf("read BlockEntity position fail: %v", err)
+		}
+		entities[i] = World.BlockEntity{
+			XZ:   int8((header.X&0xF)<<4 | header.Z&0xF),
+			Y:    int16(header.Y),
+			Data: raw,
+		}
+	}
+	return entities, nil
+}
+
+// sectionsPerColumn is the number of 16*16*16 sections in a 1.12.2 column
+const sectionsPerColumn = 16
+
+const defaultBitsPerBlock = 13
 
 func perBits(BitsPerBlock byte) uint {
 	switch {
@@ -137,22 +159,37 @@ func perBits(BitsPerBlock byte) uint {
 	}
 }
 
-func fillSection(s *World.Section, bpb uint, DataArray []int64, palette []uint) {
-	mask := uint(1<<bpb - 1)
-	for n := 0; n < 16*16*16; n++ {
-		offset := uint(n * int(bpb))
-		data := uint(DataArray[offset/64])
-		data >>= offset % 64
-		if offset%64 > 64-bpb {
-			l := bpb + offset%64 - 64
-			data &= uint(DataArray[offset/64+1] << l)
+// fillSection unpack the data array of a section. Before 1.16 the values are
+// packed tightly, so one value could be split across two longs.
+// Each value is a palette index, or a global state ID (id<<4 | meta) if palette is nil.
+func fillSection(s *World.Section, bpb uint, DataArray []uint64, palette []World.BlocksState) error {
+	mask := uint64(1)<<bpb - 1
+	indices := make([]int, 16*16*16)
+	var count int16
+	for n := range indices {
+		offset := uint(n) * bpb
+		start, end := offset/64, (offset+bpb-1)/64
+		data := DataArray[start] >> (offset % 64)
+		if start != end {
+			data |= DataArray[end] << (64 - offset%64)
 		}
 		data &= mask
-		// TODO: palette
-		/*if bpb < 9 {
-			s.Blocks[n%16][n/(16*16)][n%(16*16)/16].Id = palette[data]
+
+		var state World.BlocksState
+		if palette != nil {
+			if data >= uint64(len(palette)) {
+				return fmt.Errorf("palette index %d out of bounds", data)
+			}
+			state = palette[data]
 		} else {
-			s.Blocks[n%16][n/(16*16)][n%(16*16)/16].Id = data
-		}*/
+			state = World.BlocksState(data)
+		}
+		if state>>4 != 0 { // not air
+			count++
+		}
+		indices[n] = int(data)
 	}
+	s.BlockCount = count
+	s.States = World.NewStatesPaletteContainerWithIndices(indices, palette)
+	return nil
 }
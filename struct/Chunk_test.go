@@ -0,0 +1,116 @@
+package _struct
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/edouard127/mc-go-1.12.2/data/World"
+	"github.com/edouard127/mc-go-1.12.2/nbt"
+	pk "github.com/edouard127/mc-go-1.12.2/packet"
+)
+
+// packSection encode states the way a 1.12.2 server does, with the values
+// packed tightly across longs.
+func packSection(bpb uint, states []int32, palette []int32) (data []byte) {
+	data = append(data, byte(bpb))
+	data = append(data, pk.PackVarInt(int32(len(palette)))...)
+	for _, v := range palette {
+		data = append(data, pk.PackVarInt(v)...)
+	}
+	longs := make([]uint64, 16*16*16*bpb/64)
+	for n, v := range states {
+		if palette != nil {
+			for i, p := range palette {
+				if p == v {
+					v = int32(i)
+					break
+				}
+			}
+		}
+		offset := uint(n) * bpb
+		longs[offset/64] |= uint64(v) << (offset % 64)
+		if end := (offset + bpb - 1) / 64; end != offset/64 {
+			longs[end] |= uint64(v) >> (64 - offset%64)
+		}
+	}
+	data = append(data, pk.PackVarInt(int32(len(longs)))...)
+	for _, l := range longs {
+		data = append(data, pk.PackUint64(l)...)
+	}
+	data = append(data, make([]byte, 2048)...)               // BlockLight
+	data = append(data, bytes.Repeat([]byte{0xFF}, 2048)...) // SkyLight
+	return
+}
+
+func TestUnpackChunkDataPacket(t *testing.T) {
+	const stone, granite = 1 << 4, 1<<4 | 1
+	paletted := make([]int32, 16*16*16)
+	paletted[0] = stone
+	paletted[16*16*16-1] = granite
+	global := make([]int32, 16*16*16)
+	for i := range global {
+		global[i] = int32(i % 4096)
+	}
+
+	var sections []byte
+	sections = append(sections, packSection(4, paletted, []int32{0, stone, granite})...)
+	sections = append(sections, packSection(13, global, nil)...)
+	sections = append(sections, bytes.Repeat([]byte{7}, 256)...) // Biomes
+
+	var blockEntity bytes.Buffer
+	err := nbt.NewEncoder(&blockEntity).Encode(struct {
+		ID string `nbt:"id"`
+		X  int32  `nbt:"x"`
+		Y  int32  `nbt:"y"`
+		Z  int32  `nbt:"z"`
+	}{ID: "minecraft:chest", X: -5, Y: 70, Z: 33}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var data []byte
+	data = append(data, pk.PackUint32(uint32(0xFFFFFFFF))...) // X = -1
+	data = append(data, pk.PackUint32(2)...)
+	data = append(data, pk.PackBoolean(true))
+	data = append(data, pk.PackVarInt(1<<1|1<<3)...)
+	data = append(data, pk.PackVarInt(int32(len(sections)))...)
+	data = append(data, sections...)
+	data = append(data, pk.PackVarInt(1)...)
+	data = append(data, blockEntity.Bytes()...)
+
+	c, pos, mask, full, err := UnpackChunkDataPacket(&pk.Packet{ID: 0x20, Data: data}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pos != (World.ChunkPos{-1, 2}) || mask != 1<<1|1<<3 || !full {
+		t.Errorf("wrong header: pos=%v mask=%b full=%v", pos, mask, full)
+	}
+	for i, want := range paletted {
+		if got := c.Sections[1].GetBlock(i); got != World.BlocksState(want) {
+			t.Fatalf("paletted section [%d]: got %d, want %d", i, got, want)
+		}
+	}
+	for i, want := range global {
+		if got := c.Sections[3].GetBlock(i); got != World.BlocksState(want) {
+			t.Fatalf("global section [%d]: got %d, want %d", i, got, want)
+		}
+	}
+	if c.Sections[1].BlockCount != 2 {
+		t.Errorf("BlockCount = %d, want 2", c.Sections[1].BlockCount)
+	}
+	if c.Sections[0].GetBlock(0) != 0 || c.Sections[0].SkyLight != nil {
+		t.Error("section not in mask should be empty")
+	}
+	if len(c.Sections[1].SkyLight) != 2048 || c.Sections[1].SkyLight[0] != 0xFF {
+		t.Error("sky light not read")
+	}
+	if len(c.Biomes) != 256 || c.Biomes[0] != 7 {
+		t.Error("biomes not read")
+	}
+	if len(c.BlockEntity) != 1 {
+		t.Fatalf("got %d block entities, want 1", len(c.BlockEntity))
+	}
+	if x, z := c.BlockEntity[0].UnpackXZ(); x != 11 || z != 1 || c.BlockEntity[0].Y != 70 {
+		t.Errorf("wrong block entity position: %d %d %d", x, c.BlockEntity[0].Y, z)
+	}
+}
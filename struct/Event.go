@@ -5,12 +5,12 @@ import (
 	. "github.com/edouard127/mc-go-1.12.2/maths"
 )
 
-// Event happens in game, and you can receive it from what Game.GetEvent() returns
+// Event happens in game, subscribe to its type on Game.Events to receive it
 type Event interface{}
 
 /*
 	Here is all events you will register.
-	When event happens, match signal will be sending to Game.Event
+	When event happens, it's published to Game.Events
 */
 // JoinGameEvent sent when the client joins the game
 type JoinGameEvent struct {
@@ -35,6 +35,12 @@ type InventoryChangeEvent int16
 // BlockChangeEvent sent when a block has been broken or placed
 type BlockChangeEvent struct{}
 
+// ChunkUnloadEvent sent when a column is unloaded by the server
+// or evicted because of Settings.MaxColumns.
+type ChunkUnloadEvent struct {
+	Pos ChunkPos
+}
+
 // ChatMessageEvent sent when chat message was received.
 // When Pos is 0, this message should be displayed at chat box.
 // When it's 1, this is a system message and also at chat box,
@@ -74,7 +80,11 @@ type EntityRelativeMoveEvent struct {
 	Delta    Vector3
 }
 
-type TickEvent struct{}
+// TickEvent sent at the end of each tick of HandleGame, 20 times a second.
+// Tick is the number of ticks run since HandleGame started.
+type TickEvent struct {
+	Tick uint64
+}
 
 type TimeUpdateEvent struct {
 	Time WorldTime
@@ -101,9 +111,9 @@ type DigStopEvent struct {
 	Block
 }
 
-// GetEvents returns an int type channel.
-// When event happens, an event ID will be sent into this chan
-// Note that HandleGame will block if you don't receive from Events
+// GetEvents return a channel receiving every event, closed when HandleGame returns.
+// Note that HandleGame will block if you don't receive from it, see Subscribe for the other policies.
 func (g *Game) GetEvents() <-chan Event {
-	return g.Events
+	ch, _ := SubscribeChan[Event](g.Events, 64, PolicyBlock)
+	return ch
 }
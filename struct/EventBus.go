@@ -0,0 +1,176 @@
+package _struct
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// Policy is what Publish does when a subscriber has no room for an event
+type Policy byte
+
+const (
+	// PolicyBlock makes Publish wait until the subscriber receives the event, or unsubscribes
+	PolicyBlock Policy = iota
+	// PolicyDrop discards the event for this subscriber, see Subscription.Dropped
+	PolicyDrop
+)
+
+// EventBus sends the events of the game to the subscribers of their type.
+// Each subscriber has its own buffer, so a slow one only delays or loses its own events.
+// A nil *EventBus discards all the events.
+type EventBus struct {
+	mu     sync.RWMutex
+	subs   map[reflect.Type][]*Subscription // by the type of event
+	ifaces []*Subscription                  // subscribed to an interface, like Event for all of them
+	closed bool
+}
+
+// NewEventBus return an event bus without subscribers
+func NewEventBus() *EventBus {
+	return &EventBus{subs: make(map[reflect.Type][]*Subscription)}
+}
+
+// Subscription is a subscriber of an EventBus
+type Subscription struct {
+	bus     *EventBus
+	typ     reflect.Type
+	send    func(e Event)
+	close   func()
+	done    chan struct{} // closed to unblock Publish when unsubscribing
+	sending sync.RWMutex  // held by Publish while it sends, so the channel isn't closed under it
+	once    sync.Once
+	dropped uint64
+}
+
+// Subscribe call handler with each event of type T, in order, from its own goroutine.
+// T can be an interface, Event subscribes to every event.
+// size is the number of events waiting for handler before policy applies.
+func Subscribe[T any](b *EventBus, size int, policy Policy, handler func(T)) *Subscription {
+	ch, s := subscribe[T](b, size, policy)
+	go func() {
+		for e := range ch {
+			handler(e)
+		}
+	}()
+	return s
+}
+
+// SubscribeChan return a channel receiving each event of type T, with a buffer of size events.
+// The channel is closed when unsubscribing or when the bus is closed.
+func SubscribeChan[T any](b *EventBus, size int, policy Policy) (<-chan T, *Subscription) {
+	return subscribe[T](b, size, policy)
+}
+
+func subscribe[T any](b *EventBus, size int, policy Policy) (chan T, *Subscription) {
+	ch := make(chan T, size)
+	s := &Subscription{bus: b, typ: reflect.TypeOf((*T)(nil)).Elem(), done: make(chan struct{})}
+	s.send = func(e Event) {
+		s.sending.RLock()
+		defer s.sending.RUnlock()
+		select {
+		case <-s.done: // unsubscribed after Publish copied the subscribers
+			return
+		default:
+		}
+		if policy == PolicyDrop {
+			select {
+			case ch <- e.(T):
+			default:
+				atomic.AddUint64(&s.dropped, 1)
+			}
+			return
+		}
+		select {
+		case ch <- e.(T):
+		case <-s.done:
+		}
+	}
+	s.close = func() { close(ch) }
+
+	if b == nil {
+		s.Unsubscribe()
+		return ch, s
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		close(s.done)
+		s.once.Do(s.close)
+		return ch, s
+	}
+	if s.typ.Kind() == reflect.Interface {
+		b.ifaces = append(b.ifaces, s)
+	} else {
+		b.subs[s.typ] = append(b.subs[s.typ], s)
+	}
+	return ch, s
+}
+
+// Unsubscribe stop sending events to s and close its channel. It can be called more than once.
+func (s *Subscription) Unsubscribe() {
+	s.once.Do(func() {
+		close(s.done)
+		if b := s.bus; b != nil {
+			b.mu.Lock()
+			b.subs[s.typ] = remove(b.subs[s.typ], s)
+			b.ifaces = remove(b.ifaces, s)
+			b.mu.Unlock()
+		}
+		s.sending.Lock() // wait for the sends unblocked by done
+		s.close()
+		s.sending.Unlock()
+	})
+}
+
+// Dropped return the number of events lost because the subscriber was too slow
+func (s *Subscription) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+func remove(subs []*Subscription, s *Subscription) []*Subscription {
+	for i := range subs {
+		if subs[i] == s {
+			return append(subs[:i:i], subs[i+1:]...)
+		}
+	}
+	return subs
+}
+
+// Publish send e to the subscribers of its type and of the interfaces it implements.
+// The subscribers are copied first, so their handlers can subscribe or unsubscribe while Publish waits for them.
+func (b *EventBus) Publish(e Event) {
+	if b == nil || e == nil {
+		return
+	}
+	t := reflect.TypeOf(e)
+	b.mu.RLock()
+	subs := append([]*Subscription(nil), b.subs[t]...)
+	for _, s := range b.ifaces {
+		if t.Implements(s.typ) {
+			subs = append(subs, s)
+		}
+	}
+	b.mu.RUnlock()
+	for _, s := range subs {
+		s.send(e)
+	}
+}
+
+// Close unsubscribe everyone, the events published after are discarded
+func (b *EventBus) Close() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	b.closed = true
+	var subs []*Subscription
+	for _, list := range b.subs {
+		subs = append(subs, list...)
+	}
+	subs = append(subs, b.ifaces...)
+	b.mu.Unlock()
+	for _, s := range subs {
+		s.Unsubscribe()
+	}
+}
@@ -0,0 +1,99 @@
+package _struct
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestEventBus(t *testing.T) {
+	b := NewEventBus()
+	chats, chatSub := SubscribeChan[ChatMessageEvent](b, 4, PolicyBlock)
+	all, allSub := SubscribeChan[Event](b, 1, PolicyDrop)
+	handled := make(chan TimeUpdateEvent, 4)
+	Subscribe(b, 4, PolicyBlock, func(e TimeUpdateEvent) { handled <- e })
+
+	b.Publish(ChatMessageEvent{Content: "hello"})
+	b.Publish(TimeUpdateEvent{})
+	b.Publish(PlayerSpawnEvent{})
+
+	if e := <-chats; e.Content != "hello" {
+		t.Errorf("chat %v", e)
+	}
+	select {
+	case <-handled:
+	case <-time.After(time.Second):
+		t.Error("the handler isn't called")
+	}
+	// only the first event fits in the buffer of all
+	if e := <-all; e != (ChatMessageEvent{Content: "hello"}) {
+		t.Errorf("all: first event %v", e)
+	}
+	if d := allSub.Dropped(); d != 2 {
+		t.Errorf("all: %d events dropped, want 2", d)
+	}
+
+	// Unsubscribing unblocks Publish and closes the channel
+	for i := 0; i < 4; i++ {
+		b.Publish(ChatMessageEvent{})
+	}
+	published := make(chan struct{})
+	go func() {
+		b.Publish(ChatMessageEvent{}) // blocked, the buffer is full
+		close(published)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	chatSub.Unsubscribe()
+	select {
+	case <-published:
+	case <-time.After(time.Second):
+		t.Fatal("Publish still blocked after Unsubscribe")
+	}
+	n := 0
+	for range chats {
+		n++
+	}
+	if n != 4 {
+		t.Errorf("%d events left in the channel, want 4", n)
+	}
+
+	// Closing the bus closes every subscription
+	b.Close()
+	<-all // the last event kept in the buffer
+	if _, ok := <-all; ok {
+		t.Error("the channel isn't closed with the bus")
+	}
+	if _, ok := <-func() <-chan Event { ch, _ := SubscribeChan[Event](b, 1, PolicyBlock); return ch }(); ok {
+		t.Error("subscribed to a closed bus")
+	}
+	b.Publish(ChatMessageEvent{})
+
+	var nilBus *EventBus
+	nilBus.Publish(ChatMessageEvent{})
+}
+
+func TestEventBus_SubscribeFromHandler(t *testing.T) {
+	b := NewEventBus()
+	subscribed := make(chan struct{})
+	var once sync.Once
+	Subscribe(b, 0, PolicyBlock, func(e ChatMessageEvent) {
+		// Publish waits for this handler with the next event while it subscribes
+		once.Do(func() {
+			time.Sleep(10 * time.Millisecond)
+			SubscribeChan[TimeUpdateEvent](b, 1, PolicyDrop)
+			close(subscribed)
+		})
+	})
+	done := make(chan struct{})
+	go func() {
+		b.Publish(ChatMessageEvent{})
+		b.Publish(ChatMessageEvent{})
+		close(done)
+	}()
+	select {
+	case <-subscribed:
+	case <-time.After(time.Second):
+		t.Fatal("Subscribe blocked by Publish")
+	}
+	<-done
+}
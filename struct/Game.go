@@ -2,22 +2,32 @@ package _struct
 
 import (
 	"bufio"
-	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	. "github.com/edouard127/mc-go-1.12.2/data"
 	. "github.com/edouard127/mc-go-1.12.2/data/World"
 	. "github.com/edouard127/mc-go-1.12.2/data/entities"
 	. "github.com/edouard127/mc-go-1.12.2/maths"
 	pk "github.com/edouard127/mc-go-1.12.2/packet"
-	. "github.com/edouard127/mc-go-1.12.2/util"
+	"github.com/edouard127/mc-go-1.12.2/protocol"
 	"io"
 	"math"
 	"math/rand"
 	"net"
+	"reflect"
+	"sync"
 	"time"
 )
 
-// Game is the Object used to access Minecraft server
+// Game is the Object used to access Minecraft server.
+//
+// The state of the game, World, Player, Physics and the others, belongs to the goroutine of HandleGame:
+// it's changed there by the packets received and by the ticks.
+// Other goroutines must read or change it with Do, or register a function with OnTick.
+// The methods waiting for the server or for ticks, like DigContext or WalkToContext, do so by themselves,
+// they can be called from any goroutine but the one of HandleGame.
+// The other methods, like GetBlock or SetControls, must be called from the goroutine of HandleGame.
 type Game struct {
 	addr string
 	port int
@@ -27,6 +37,9 @@ type Game struct {
 	Sender   io.Writer
 
 	threshold int
+	reader    pk.Reader // the framing of Receiver, kept for its buffers
+	writer    pk.Writer // the framing of Sender, guarded by sendMu
+	sendMu    sync.Mutex
 	Info      PlayerInfo
 	Abilities PlayerAbilities
 	Settings  Settings
@@ -34,324 +47,860 @@ type Game struct {
 	World     World //the map data
 	Server    Server
 
+	protocol *protocol.Registry // the version spoken with the server, chosen by JoinServer
+
 	SendChan chan pk.Packet  //be used when HandleGame
+	sendDone chan struct{}   // closed when the writer of HandleGame stops
+	sendErr  error           // why the writer stopped, set before sendDone is closed
 	recvChan chan *pk.Packet //be used when HandleGame
-	Events   chan Event
-	Motion   chan func() //used to submit a function and HandleGame do
-}
-
-// HandleGame receive server packet and response them correctly.
-// Note that HandleGame will block if you don't receive from Events.
-func (g *Game) HandleGame() error {
-	defer func() {
-		close(g.Events)
-	}()
-
-	errChan := make(chan error)
+	Events   *EventBus
+	handlers map[reflect.Type][]func(protocol.Packet) error // registered with OnPacket
+
+	Physics PlayerPhysics // the keys held by the player and the state of its movement
+
+	spawned      bool // the server sent the position of the player
+	sentPosition Vector3
+	sentRotation Vector2
+	sentOnGround bool
+	sentSprint   bool // the last sprinting and sneaking states sent with EntityAction
+	sentSneak    bool
+	moveTicks    int // ticks since the position was sent
+
+	tickMu    sync.Mutex
+	tickFuncs []*tickFunc
+	tickDone  chan struct{} // closed at the end of each tick
+	ticks     uint64        // the ticks run by HandleGame
+	stopped   bool          // HandleGame returned
+	do        chan func()   // the functions of Do
+	done      chan struct{} // closed when HandleGame returns
+}
+
+// tickFunc is called each tick until it returns false
+type tickFunc struct {
+	f    func() bool
+	done bool
+}
+
+// HandleGame receive server packet and response them correctly, until ctx is done or the connection ends.
+// Return a *DisconnectError when the server kicks the player, a *NetworkError when the connection fails,
+// a *ProtocolError when a packet can't be read, and ctx.Err() when ctx is done.
+// The connection is closed in all cases.
+// The subscribers of Events are unsubscribed when it returns.
+func (g *Game) HandleGame(ctx context.Context) error {
+	defer g.Events.Close()
+	defer g.stop()
+	do, _ := g.loop()
+
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	defer g.Conn.Close() // stop the reader
+	defer cancel()       // stop the writer
+
+	errChan := make(chan error, 2)
 
 	g.SendChan = make(chan pk.Packet, 64)
+	g.sendDone = make(chan struct{})
+	wg.Add(1)
 	go func() {
-		for p := range g.SendChan {
-			err := g.SendPacket(&p)
-			if err != nil {
-				errChan <- fmt.Errorf("send packet in game fail: %v", err)
+		defer wg.Done()
+		defer close(g.sendDone)
+		for {
+			select {
+			case <-ctx.Done():
+				g.sendErr = ErrStopped
 				return
+			case p := <-g.SendChan:
+				if err := g.SendPacket(&p); err != nil {
+					g.sendErr = &NetworkError{Op: "send", Err: err}
+					errChan <- g.sendErr
+					return
+				}
 			}
 		}
 	}()
 
 	g.recvChan = make(chan *pk.Packet, 64)
+	wg.Add(1)
 	go func() {
+		defer wg.Done()
 		for {
 			pack, err := g.recvPacket()
 			if err != nil {
-				close(g.recvChan)
-				errChan <- fmt.Errorf("recv packet in game fail: %v", err)
+				errChan <- &NetworkError{Op: "recv", Err: err}
+				return
+			}
+			select {
+			case g.recvChan <- pack:
+			case <-ctx.Done():
 				return
 			}
-
-			g.recvChan <- pack
 		}
 	}()
+
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
 	for {
 		select {
-		case err := <-errChan:
-			panic(fmt.Sprintf("error: %v\n", err))
-		case pack, ok := <-g.recvChan:
-			if !ok {
-				panic(fmt.Sprintf("packet %v is not ok", pack))
+		case <-ticker.C:
+			if err := g.tick(); err != nil {
+				return err
 			}
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-errChan:
+			return err
+		case pack := <-g.recvChan:
 			err := HandlePack(g, pack)
+			pack.Release() // the packets decoded don't share its data
 			if err != nil {
-				panic(fmt.Errorf("handle packet 0x%X error: %v", pack, err))
+				return err
 			}
-		case f := <-g.Motion: // TODO: Fix memory block
-			go f()
+		case f := <-do:
+			f()
 		}
 	}
+}
+
+// DisconnectError is returned by HandleGame when the server disconnects the player
+type DisconnectError struct {
+	Reason ChatMsg
+}
+
+func (e *DisconnectError) Error() string {
+	return fmt.Sprintf("disconnected: %s", e.Reason)
+}
+
+// NetworkError is returned by HandleGame when a packet can't be sent or received
+type NetworkError struct {
+	Op  string // send or recv
+	Err error
+}
+
+func (e *NetworkError) Error() string {
+	return fmt.Sprintf("%s packet in game fail: %v", e.Op, e.Err)
+}
+
+func (e *NetworkError) Unwrap() error { return e.Err }
+
+// ProtocolError is returned by HandleGame when a packet received can't be handled
+type ProtocolError struct {
+	PacketID byte
+	Err      error
+}
+
+func (e *ProtocolError) Error() string {
+	return fmt.Sprintf("handle packet 0x%02X fail: %v", e.PacketID, e.Err)
+}
+
+func (e *ProtocolError) Unwrap() error { return e.Err }
+
+// tick run a tick of the game, 20 times a second like the vanilla client:
+// the functions registered with OnTick, then the physics of the player, then the movement is sent.
+// TickEvent is published at the end. Return the error of sending the movement.
+func (g *Game) tick() (err error) {
+	g.tickMu.Lock()
+	funcs := append([]*tickFunc(nil), g.tickFuncs...)
+	g.tickMu.Unlock()
+	for _, t := range funcs {
+		t.done = !t.f()
+	}
+
+	if g.spawned {
+		g.World.Touch(ChunkPosOf(g.Player.Position))
+		// The server moves flying and spectating players
+		if g.Abilities.Flags&0x02 == 0 && g.Info.Gamemode != 3 {
+			g.Physics.Tick(&g.World, g.GetPlayer())
+		}
+		err = g.sendMovement()
+	}
+
+	g.tickMu.Lock()
+	kept := g.tickFuncs[:0]
+	for _, t := range g.tickFuncs {
+		if !t.done {
+			kept = append(kept, t)
+		}
+	}
+	g.tickFuncs = kept
+	g.ticks++
+	tick := g.ticks
+	if g.tickDone != nil {
+		close(g.tickDone)
+	}
+	g.tickDone = make(chan struct{})
+	g.tickMu.Unlock()
+	g.Events.Publish(TickEvent{Tick: tick})
+	return err
+}
+
+// OnTick call f at the start of each tick, from the goroutine of HandleGame, until it returns false.
+// The functions are called in the order they are registered, before the physics of the player.
+func (g *Game) OnTick(f func() bool) {
+	g.tickMu.Lock()
+	g.tickFuncs = append(g.tickFuncs, &tickFunc{f: f})
+	g.tickMu.Unlock()
+}
+
+// ErrStopped is returned when waiting for a tick after HandleGame returned
+var ErrStopped = errors.New("the game is stopped")
+
+// WaitTick wait the end of the next tick, when the movement of the player is sent.
+// Return ErrStopped if HandleGame returns, and ctx.Err() if ctx is done first.
+func (g *Game) WaitTick(ctx context.Context) error {
+	g.tickMu.Lock()
+	if g.stopped {
+		g.tickMu.Unlock()
+		return ErrStopped
+	}
+	if g.tickDone == nil {
+		g.tickDone = make(chan struct{})
+	}
+	done := g.tickDone
+	g.tickMu.Unlock()
+
+	select {
+	case <-done:
+		g.tickMu.Lock()
+		defer g.tickMu.Unlock()
+		if g.stopped {
+			return ErrStopped
+		}
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// stop release the goroutines waiting for a tick or for Do, when HandleGame returns
+func (g *Game) stop() {
+	g.loop()
+	g.tickMu.Lock()
+	defer g.tickMu.Unlock()
+	g.stopped = true
+	close(g.done)
+	if g.tickDone != nil {
+		close(g.tickDone)
+		g.tickDone = nil
+	}
+}
+
+// loop return the channels of HandleGame, made by the first call
+func (g *Game) loop() (do chan func(), done chan struct{}) {
+	g.tickMu.Lock()
+	defer g.tickMu.Unlock()
+	if g.do == nil {
+		g.do, g.done = make(chan func()), make(chan struct{})
+	}
+	return g.do, g.done
+}
+
+// Done return a channel closed when HandleGame returns
+func (g *Game) Done() <-chan struct{} {
+	_, done := g.loop()
+	return done
+}
+
+// Do run f in the goroutine of HandleGame, between two packets, and wait for it.
+// Return ErrStopped if HandleGame returns, and ctx.Err() if ctx is done before f starts.
+// Do mustn't be called from the goroutine of HandleGame, by a packet handler or by a function of OnTick.
+func (g *Game) Do(ctx context.Context, f func()) error {
+	do, done := g.loop()
+	ran := make(chan struct{})
+	select {
+	case do <- func() { defer close(ran); f() }:
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-done:
+		return ErrStopped
+	}
+	<-ran
 	return nil
 }
+
+// runTicks call step at the start of each tick, from the goroutine of HandleGame, until it returns true or an error.
+// When ctx is done, ctx.Err() is returned and stop is called instead at the next tick, even if the ticks don't run yet.
+func (g *Game) runTicks(ctx context.Context, step func() (bool, error), stop func()) error {
+	result := make(chan error, 1)
+	g.OnTick(func() bool {
+		if err := ctx.Err(); err != nil {
+			stop()
+			result <- err
+			return false
+		}
+		finished, err := step()
+		if finished || err != nil {
+			result <- err
+			return false
+		}
+		return true
+	})
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-g.Done():
+		return ErrStopped
+	}
+}
+
+// PlayerSnapshot return a copy of the player, taken in the goroutine of HandleGame
+func (g *Game) PlayerSnapshot(ctx context.Context) (p Player, err error) {
+	err = g.Do(ctx, func() {
+		p = g.Player
+		p.Inventory = append([]Slot(nil), g.Player.Inventory...)
+		p.Effects = make(map[int8]PotionEffect, len(g.Player.Effects))
+		for id, e := range g.Player.Effects {
+			p.Effects[id] = e
+		}
+	})
+	return
+}
+
+// EntitiesSnapshot return a copy of the entities of the world by ID, taken in the goroutine of HandleGame
+func (g *Game) EntitiesSnapshot(ctx context.Context) (entities map[int32]Entity, err error) {
+	err = g.Do(ctx, func() {
+		entities = make(map[int32]Entity, len(g.World.Entities))
+		for id, e := range g.World.Entities {
+			entities[id] = *e
+		}
+	})
+	return
+}
+
+// sendMovement send the position and the rotation of the player if they changed,
+// and the position at least once a second like the vanilla client.
+// The changes of sprinting and sneaking are sent first.
+func (g *Game) sendMovement() error {
+	if g.Physics.Sprinting != g.sentSprint {
+		action := protocol.ActionStopSprinting
+		if g.Physics.Sprinting {
+			action = protocol.ActionStartSprinting
+		}
+		if err := SendEntityActionPacket(g, action); err != nil {
+			return err
+		}
+		g.sentSprint = g.Physics.Sprinting
+	}
+	if g.Physics.Sneak != g.sentSneak {
+		action := protocol.ActionStopSneaking
+		if g.Physics.Sneak {
+			action = protocol.ActionStartSneaking
+		}
+		if err := SendEntityActionPacket(g, action); err != nil {
+			return err
+		}
+		g.sentSneak = g.Physics.Sneak
+	}
+	p := g.GetPlayer()
+	g.moveTicks++
+	d := p.Position.Sub(g.sentPosition)
+	moved := d.X*d.X+d.Y*d.Y+d.Z*d.Z > 9e-4 || g.moveTicks >= 20
+	rotated := p.Rotation != g.sentRotation
+	switch {
+	case moved && rotated:
+		return SendPlayerPositionAndLookPacket(g)
+	case moved:
+		return SendPlayerPositionPacket(g)
+	case rotated:
+		return SendPlayerLookPacket(g)
+	case p.OnGround != g.sentOnGround:
+		return SendPlayerPacket(g)
+	}
+	return nil
+}
+
+// HandlePack read the packet with the registry of the protocol, handle it,
+// then call the handlers registered for its type with OnPacket
 func HandlePack(g *Game, p *pk.Packet) (err error) {
 	//fmt.Printf("recv packet 0x%X\n", p.ID)
-	reader := bytes.NewReader(p.Data)
-
-	switch p.ID {
-	case 0x00: // Spawn Object
-		HandleSpawnObject(g, reader)
-	case 0x23:
-		err = HandleJoinGamePacket(g, reader)
-		g.Events <- JoinGameEvent{
-			EntityID: g.Player.EntityID(),
+	pack, err := g.registry().Decode(protocol.Play, protocol.Clientbound, int32(p.ID), p.Data)
+	if err == nil && pack != nil {
+		err = handlePacket(g, pack)
+		for _, h := range g.handlers[reflect.TypeOf(pack)] {
+			if err != nil {
+				break
+			}
+			err = h(pack)
 		}
-	case 0x25:
-
-	/*case 0x18:
-	HandlePluginPacket(g, reader)*/
-	case 0x0B: // Block Change
-		err = HandleBlockChange(g, reader)
-	case 0x0D:
-		err = HandleServerDifficultyPacket(g, reader)
-	case 0x46:
-		err = HandleSpawnPositionPacket(g, reader)
-	case 0x2C:
-		err = HandlePlayerAbilitiesPacket(g, reader)
-		g.SendChan <- *g.Settings.Pack()
-	case 0x3A:
-		err = HandleHeldItemPacket(g, reader)
-	case 0x20:
-		err = HandleChunkDataPacket(g, p)
-		g.Events <- BlockChangeEvent{}
-	case 0x2F:
-		err = HandlePlayerPositionAndLookPacket(g, reader)
-	case 0x54:
-		// handleDeclareRecipesPacket(g, reader)
-	case 0x27: // TODO: Handle error
-		err = HandleEntityLookAndRelativeMove(g, reader)
-	case 0x28:
-		HandleEntityLook(g, reader)
-	case 0x1F:
-		err = HandleKeepAlivePacket(g, reader)
-	case 0x26:
-		err = HandleEntityRelativeMove(g, reader)
-	case 0x05:
-		err = HandleSpawnPlayerPacket(g, reader)
-	case 0x15:
-		err = HandleWindowItemsPacket(g, reader)
-	case 0x41:
-		err = HandleUpdateHealthPacket(g, reader)
-	case 0x0F:
-		err = HandleChatMessagePacket(g, reader)
-	case 0x10:
-		err = HandleMultiBlockChangePacket(g, reader)
-		g.Events <- BlockChangeEvent{}
-	case 0x1A:
-		err = HandleDisconnect(g, reader)
-	case 0x17:
-		err = HandleSetSlotPacket(g, reader)
-	case 0x49:
-		err = HandleSoundEffect(g, reader)
-	case 0x3E: // Entity velocity
-		err = HandleEntityVelocity(g, reader)
-	case 0x48: // Title
-		err = HandleTitle(g, reader)
-	case 0x36: // Entity Head Look
-		err = HandleEntityHeadLook(g, reader)
-	case 0x4C: // Entity Teleport
-		err = HandleEntityTeleport(g, reader)
-	case 0x32: // Destroy Entities
-		err = HandleDestroyEntity(g, reader)
-	case 0x47: // Time update
-		err = HandleTimeUpdate(g, reader)
-	case 0x3C: // Entity Metadata
-		err = HandleEntityMetadata(g, reader)
-	default:
-		//fmt.Printf("unhandled packet 0x%X\n", p.ID)
 	}
-	return nil
+	if err != nil && !errors.As(err, new(*DisconnectError)) {
+		err = &ProtocolError{PacketID: p.ID, Err: err}
+	}
+	return err
 }
 
-func HandleEntityLook(g *Game, reader *bytes.Reader) {
-	entityID, _ := pk.UnpackVarInt(reader)
-	yaw, _ := pk.UnpackAngle(reader)
-	pitch, _ := pk.UnpackAngle(reader)
-	onGround, _ := pk.UnpackBoolean(reader)
-	rotation := Vector2{
-		X: yaw,
-		Y: pitch,
+func handlePacket(g *Game, pack protocol.Packet) (err error) {
+	switch p := pack.(type) {
+	case *protocol.SpawnObject:
+		HandleSpawnObject(g, p)
+	case *protocol.JoinGame:
+		err = HandleJoinGamePacket(g, p)
+		g.Events.Publish(JoinGameEvent{
+			EntityID: g.Player.EntityID(),
+		})
+	case *protocol.BlockChange:
+		err = HandleBlockChange(g, p)
+	case *protocol.ServerDifficulty:
+		err = HandleServerDifficultyPacket(g, p)
+	case *protocol.SpawnPosition:
+		err = HandleSpawnPositionPacket(g, p)
+	case *protocol.PlayerAbilitiesClientbound:
+		if err = HandlePlayerAbilitiesPacket(g, p); err == nil {
+			err = g.Send(g.Settings.Packet())
+		}
+	case *protocol.HeldItemChangeClientbound:
+		err = HandleHeldItemPacket(g, p)
+	case *protocol.ChunkData:
+		err = HandleChunkDataPacket(g, p)
+	case *protocol.UnloadChunk:
+		err = HandleUnloadChunkPacket(g, p)
+	case *protocol.Respawn:
+		err = HandleRespawnPacket(g, p)
+		g.spawned = false // until the server sends the position
+	case *protocol.PlayerPositionAndLookClientbound:
+		err = HandlePlayerPositionAndLookPacket(g, p)
+	case *protocol.EntityLookAndRelativeMove:
+		err = HandleEntityLookAndRelativeMove(g, p)
+	case *protocol.EntityLook:
+		HandleEntityLook(g, p)
+	case *protocol.KeepAliveClientbound:
+		err = HandleKeepAlivePacket(g, p)
+	case *protocol.EntityRelativeMove:
+		err = HandleEntityRelativeMove(g, p)
+	case *protocol.SpawnMob:
+		HandleSpawnMob(g, p)
+	case *protocol.SpawnPlayer:
+		err = HandleSpawnPlayerPacket(g, p)
+	case *protocol.WindowItems:
+		err = HandleWindowItemsPacket(g, p)
+	case *protocol.UpdateHealth:
+		err = HandleUpdateHealthPacket(g, p)
+	case *protocol.ChatMessageClientbound:
+		err = HandleChatMessagePacket(g, p)
+	case *protocol.MultiBlockChange:
+		err = HandleMultiBlockChangePacket(g, p)
+	case *protocol.Disconnect:
+		err = HandleDisconnect(g, p)
+	case *protocol.SetSlot:
+		err = HandleSetSlotPacket(g, p)
+	case *protocol.SoundEffect:
+		err = HandleSoundEffect(g, p)
+	case *protocol.EntityVelocity:
+		err = HandleEntityVelocity(g, p)
+	case *protocol.Title:
+		err = HandleTitle(g, p)
+	case *protocol.EntityHeadLook:
+		err = HandleEntityHeadLook(g, p)
+	case *protocol.EntityTeleport:
+		err = HandleEntityTeleport(g, p)
+	case *protocol.DestroyEntities:
+		err = HandleDestroyEntity(g, p)
+	case *protocol.TimeUpdate:
+		err = HandleTimeUpdate(g, p)
+	case *protocol.EntityMetadata:
+		err = HandleEntityMetadata(g, p)
+	case *protocol.EntityEffect:
+		err = HandleEntityEffectPacket(g, p)
+	case *protocol.RemoveEntityEffect:
+		err = HandleRemoveEntityEffectPacket(g, p)
 	}
+	return
+}
 
-	e := g.World.Entities[entityID]
+func HandleEntityLook(g *Game, p *protocol.EntityLook) {
+	e := g.World.Entities[p.EntityID]
 	if e != nil {
-		e.SetRotation(rotation, onGround)
+		e.SetRotation(Vector2{X: p.Yaw, Y: p.Pitch}, p.OnGround)
 	}
 }
 
-func HandleBlockChange(g *Game, reader *bytes.Reader) error {
-	position, _ := pk.UnpackPosition(reader)
-	blockID, _ := pk.UnpackVarInt(reader)
-	g.World.UpdateBlock(position.ToChunkPos(), position, blockID)
+func HandleBlockChange(g *Game, p *protocol.BlockChange) error {
+	if !g.Settings.ReciveMap {
+		return nil
+	}
+	g.World.UpdateBlock(p.Location, p.BlockID)
+	g.Events.Publish(BlockChangeEvent{})
 	return nil
 }
 
-func HandleSpawnObject(g *Game, reader *bytes.Reader) {
-	object := CreateObject{}
-	object.EntityID, _ = pk.UnpackVarInt(reader)
-	object.ObjectID[0], _ = pk.UnpackInt64(reader)
-	object.ObjectID[1], _ = pk.UnpackInt64(reader)
-	object.TypeID, _ = pk.UnpackByte(reader)
-	object.X, _ = pk.UnpackDouble(reader)
-	object.Y, _ = pk.UnpackDouble(reader)
-	object.Z, _ = pk.UnpackDouble(reader)
-	object.Pitch, _ = pk.UnpackDouble(reader)
-	object.Yaw, _ = pk.UnpackDouble(reader)
-	object.Data, _ = pk.UnpackInt8(reader)
-	object.VelX, _ = pk.UnpackDouble(reader)
-	object.VelY, _ = pk.UnpackDouble(reader)
-	object.VelZ, _ = pk.UnpackDouble(reader)
-	g.World.CreateEntity(object)
-}
-
-func HandleDisconnect(g *Game, reader *bytes.Reader) error {
-	reason, err := pk.UnpackString(reader)
+func HandleSpawnObject(g *Game, p *protocol.SpawnObject) {
+	g.World.CreateEntity(CreateObject{
+		EntityID: p.EntityID,
+		ObjectID: p.ObjectUUID,
+		TypeID:   byte(p.Type),
+		X:        p.Position.X,
+		Y:        p.Position.Y,
+		Z:        p.Position.Z,
+		Pitch:    float64(p.Pitch),
+		Yaw:      float64(p.Yaw),
+		Data:     int8(p.Data),
+		VelX:     float64(p.Velocity[0]) / 8000,
+		VelY:     float64(p.Velocity[1]) / 8000,
+		VelZ:     float64(p.Velocity[2]) / 8000,
+	})
+}
+
+// HandleDisconnect send the DisconnectEvent and return a *DisconnectError to stop HandleGame
+func HandleDisconnect(g *Game, p *protocol.Disconnect) error {
+	msg, err := NewChatMsg([]byte(p.Reason))
 	if err != nil {
-		return err
+		msg = ChatMsg{Text: p.Reason}
 	}
-	g.Events <- DisconnectEvent{Text: reason}
-	return nil
+	g.Events.Publish(DisconnectEvent(msg))
+	return &DisconnectError{Reason: msg}
 }
 
 type EntityMetadataEvent struct {
 	EntityID int32
-	Metadata []pk.Metadata
+	Metadata protocol.Metadata
 }
 
-func HandleEntityMetadata(g *Game, reader *bytes.Reader) error {
-	entityID, err := pk.UnpackVarInt(reader)
-	if err != nil {
-		return err
+// HandleEntityMetadata apply the metadata to the entity and send the EntityMetadataEvent
+func HandleEntityMetadata(g *Game, p *protocol.EntityMetadata) error {
+	if p.EntityID == g.Player.ID {
+		applyMetadata(&g.Player.Entity, p.Metadata)
 	}
-	metadata, err := pk.UnpackMetadata(reader)
-	if err != nil {
-		return err
-	}
-	for _, m := range metadata {
-		switch m.Type {
-		case 8: // Position
-			fmt.Printf("Position: %v\n", m.Value)
-		case 9: // OptPosition
-			fmt.Printf("OptPosition: %v\n", m.Value)
-		}
-	}
-	g.Events <- EntityMetadataEvent{
-		EntityID: entityID,
-		Metadata: metadata,
+	if e, ok := g.World.Entities[p.EntityID]; ok {
+		applyMetadata(e, p.Metadata)
 	}
+	g.Events.Publish(EntityMetadataEvent{
+		EntityID: p.EntityID,
+		Metadata: p.Metadata,
+	})
 	return nil
 }
 
-func HandleTimeUpdate(g *Game, reader *bytes.Reader) error {
-	worldAge, _ := pk.UnpackInt64(reader)
-	timeOfDay, _ := pk.UnpackInt64(reader)
-	time := WorldTime{
-		WorldAge:  worldAge,
-		TimeOfDay: timeOfDay,
+// Indexes of the entity metadata of 1.12.2
+const (
+	metaFlags      = 0 // of every entity
+	metaCustomName = 2
+	metaItem       = 6 // of the dropped items and the item frames
+	metaHealth     = 7 // of the living entities
+)
+
+// applyMetadata set the fields of e known from the metadata.
+// The indexes depend on the class of the entity, so the values are checked by their type too.
+func applyMetadata(e *Entity, m protocol.Metadata) {
+	if v, ok := m.Get(metaFlags, protocol.MetaByte); ok {
+		e.Flags = v.(byte)
+	}
+	if v, ok := m.Get(metaCustomName, protocol.MetaString); ok {
+		e.CustomName = v.(string)
 	}
-	t := g.World.SetTime(time)
-	g.Events <- TimeUpdateEvent{Time: t}
+	if v, ok := m.Get(metaItem, protocol.MetaSlot); ok {
+		item := slotOf(v.(protocol.Slot))
+		e.Item = &item
+	}
+	if v, ok := m.Get(metaHealth, protocol.MetaFloat); ok {
+		e.Health = v.(float32)
+	}
+}
+
+func HandleTimeUpdate(g *Game, p *protocol.TimeUpdate) error {
+	t := g.World.SetTime(WorldTime{
+		WorldAge:  p.WorldAge,
+		TimeOfDay: p.TimeOfDay,
+	})
+	g.Events.Publish(TimeUpdateEvent{Time: t})
 	return nil
 }
 
-func HandleDestroyEntity(g *Game, reader *bytes.Reader) error {
-	entityID, err := pk.UnpackVarInt(reader)
-	if err != nil {
-		return err
+func HandleDestroyEntity(g *Game, p *protocol.DestroyEntities) error {
+	for _, id := range p.EntityIDs {
+		g.World.DestroyEntity(id)
 	}
-	g.World.DestroyEntity(entityID)
 	return nil
 }
 
-func HandleEntityTeleport(g *Game, reader *bytes.Reader) error {
-	entityID, _ := pk.UnpackVarInt(reader)
-	x, _ := pk.UnpackDouble(reader)
-	y, _ := pk.UnpackDouble(reader)
-	z, _ := pk.UnpackDouble(reader)
-	yaw, _ := pk.UnpackFloat(reader)
-	pitch, _ := pk.UnpackFloat(reader)
-	onGround, _ := pk.UnpackBoolean(reader)
-	entity, ok := g.World.Entities[entityID]
-	if !ok {
-		return fmt.Errorf("entity not found")
-	}
-	position := Vector3{
-		X: x,
-		Y: y,
-		Z: z,
-	}
-	rotation := Vector2{
-		X: yaw,
-		Y: pitch,
+func HandleEntityTeleport(g *Game, p *protocol.EntityTeleport) error {
+	entity, ok := g.World.Entities[p.EntityID]
+	if !ok { // not spawned by a packet handled here
+		return nil
 	}
-	entity.SetPosition(position, onGround)
-	entity.SetRotation(rotation, onGround)
+	entity.SetPosition(p.Position, p.OnGround)
+	entity.SetRotation(Vector2{X: p.Yaw, Y: p.Pitch}, p.OnGround)
 	return nil
 }
 
 func (g *Game) recvPacket() (*pk.Packet, error) {
-	return pk.RecvPacket(g.Receiver, g.threshold > 0)
+	g.reader.R, g.reader.Threshold = g.Receiver, g.threshold
+	return g.reader.ReadPacket()
 }
 
 // SendPacket send a packet to server
 func (g *Game) SendPacket(p *pk.Packet) error {
-	_, err := g.Sender.Write(p.Pack(g.threshold))
-	return err
+	g.sendMu.Lock()
+	defer g.sendMu.Unlock()
+	g.writer.W, g.writer.Threshold = g.Sender, g.threshold
+	return g.writer.WritePacket(p)
+}
+
+// registry return the packets of the protocol spoken with the server, the latest if it's not chosen
+func (g *Game) registry() *protocol.Registry {
+	if g.protocol == nil {
+		return protocol.Latest
+	}
+	return g.protocol
+}
+
+// ProtocolVersion return the protocol version spoken with the server
+func (g *Game) ProtocolVersion() int32 {
+	return g.registry().Version
+}
+
+// Send encode p with its ID and send it to the server from the goroutine of HandleGame.
+// Return an error if p isn't a serverbound packet of the play state,
+// and if HandleGame isn't running or can't send the packets anymore.
+func (g *Game) Send(p protocol.Packet) error {
+	r := g.registry()
+	if state, dir, _, ok := r.ID(p); ok && (state != protocol.Play || dir != protocol.Serverbound) {
+		return fmt.Errorf("send %T fail: it's a %s packet of the %s state", p, dir, state)
+	}
+	pack, err := r.Pack(p)
+	if err != nil {
+		return fmt.Errorf("send %T fail: %v", p, err)
+	}
+	if g.SendChan == nil {
+		return fmt.Errorf("send %T fail: HandleGame isn't running", p)
+	}
+	// Fail even if there is room in SendChan, nothing sends it anymore
+	select {
+	case <-g.sendDone:
+		return fmt.Errorf("send %T fail: %w", p, g.sendErr)
+	default:
+	}
+	select {
+	case g.SendChan <- *pack:
+		return nil
+	case <-g.sendDone:
+		return fmt.Errorf("send %T fail: %w", p, g.sendErr)
+	}
+}
+
+// OnPacket call handler with each packet of type PT received, after the game handles it.
+// An error returned by handler stops HandleGame with a *ProtocolError.
+// It must be called before HandleGame, or from its goroutine.
+//
+//	OnPacket(g, func(p *protocol.ChatMessageClientbound) error { ... })
+func OnPacket[T any, PT interface {
+	*T
+	protocol.Packet
+}](g *Game, handler func(PT) error) {
+	if g.handlers == nil {
+		g.handlers = make(map[reflect.Type][]func(protocol.Packet) error)
+	}
+	t := reflect.TypeOf(PT(nil))
+	g.handlers[t] = append(g.handlers[t], func(p protocol.Packet) error { return handler(p.(PT)) })
 }
 
-// Dig a block in the position and wait
+// Dig break the block in the position and wait the server to confirm it
 func (g *Game) Dig(v3 Vector3) error {
-	b := g.GetBlock(v3)
-	if b.IsAir() {
-		return fmt.Errorf("block is air")
+	return g.DigContext(context.Background(), v3)
+}
+
+// DigContext break the block in the position, taking as long as the vanilla client would.
+// If ctx is done before the block is broken, the digging is cancelled.
+// Return an error if the server doesn't break the block within Settings.DigTimeout.
+func (g *Game) DigContext(ctx context.Context, v3 Vector3) error {
+	var b Block
+	var face Face
+	ticks, started := 0, false
+	err := g.runTicks(ctx, func() (bool, error) {
+		if !started {
+			if !g.World.IsLoaded(v3) {
+				return true, fmt.Errorf("block at %v is not loaded", v3)
+			}
+			b = g.GetBlock(v3)
+			if b.IsAir() {
+				return true, fmt.Errorf("block is air")
+			}
+			if ticks = b.DigTicks(g.Digger()); ticks < 0 {
+				return true, fmt.Errorf("%v can't be broken", b)
+			}
+			face = FaceToward(v3, g.GetPlayer().EyePosition())
+			if err := g.LookAt(Vector3{X: math.Floor(v3.X) + 0.5, Y: math.Floor(v3.Y) + 0.5, Z: math.Floor(v3.Z) + 0.5}); err != nil {
+				return true, err
+			}
+			//start
+			if err := SendPlayerDiggingPacket(g, 0, v3, face); err != nil {
+				return true, err
+			}
+			g.Events.Publish(DigStartEvent{Block: b})
+			started = true
+			if ticks == 0 { // the block is broken instantly
+				g.Events.Publish(DigStopEvent{Block: b})
+				return true, nil
+			}
+			return false, nil
+		}
+		if err := g.SwingHand(true); err != nil {
+			g.Events.Publish(DigStopEvent{Block: b})
+			return true, err
+		}
+		if ticks--; ticks > 0 {
+			return false, nil
+		}
+		err := SendPlayerDiggingPacket(g, 2, v3, face) //finish
+		g.Events.Publish(DigStopEvent{Block: b})
+		return true, err
+	}, func() {
+		if started {
+			// if it fails, HandleGame is stopping and the server forgets the digging anyway
+			_ = SendPlayerDiggingPacket(g, 1, v3, face) //cancel
+			g.Events.Publish(DigStopEvent{Block: b})
+		}
+	})
+	if err != nil {
+		return err
 	}
-	g.Events <- DigStartEvent{Block: b}
-	SendPlayerDiggingPacket(g, 0, v3, Top) //start
-	time.Sleep(100 * time.Millisecond)
-	g.Events <- DigStopEvent{Block: b}
-	SendPlayerDiggingPacket(g, 2, v3, Top) //finish
 
-	for {
-		if g.GetBlock(v3) != b {
-			break
+	// wait the Block Change packet
+	changed, err := g.waitBlockChange(ctx, v3, b, g.Settings.DigTimeout)
+	if err == nil && !changed {
+		err = fmt.Errorf("%v at %v not broken by the server", b, v3)
+	}
+	return err
+}
+
+// waitBlockChange wait the block at v3 to be something else than old, checking at each tick.
+// Return false if it's still old after timeout, and an error if its column is unloaded first.
+func (g *Game) waitBlockChange(ctx context.Context, v3 Vector3, old Block, timeout time.Duration) (changed bool, err error) {
+	deadline := time.Now().Add(timeout)
+	err = g.runTicks(ctx, func() (bool, error) {
+		if !g.World.IsLoaded(v3) {
+			return true, fmt.Errorf("block at %v unloaded before the server changed it", v3)
 		}
-		g.SwingHand(true)
-		time.Sleep(50 * time.Millisecond)
+		changed = g.GetBlock(v3) != old
+		return changed || time.Now().After(deadline), nil
+	}, func() {})
+	return
+}
+
+// Digger return what changes how fast the player breaks blocks, with the held item
+func (g *Game) Digger() Digger {
+	p := g.GetPlayer()
+	d := Digger{
+		Haste:         p.EffectLevel(EffectHaste),
+		MiningFatigue: p.EffectLevel(EffectMiningFatigue),
+		OnGround:      p.OnGround,
+		InWater:       g.GetBlock(p.EyePosition()).IsWater(),
+		Creative:      g.Info.Gamemode == 1,
 	}
-	return nil
+	if slot := 36 + p.HeldItem; slot < len(p.Inventory) { // the hotbar is slots 36 to 44
+		d.Held = ToolItems[p.Inventory[slot].ID]
+		d.Efficiency = p.Inventory[slot].EnchantmentLevel(EnchEfficiency)
+	}
+	if len(p.Inventory) > 5 { // slot 5 is the helmet
+		d.AquaAffinity = p.Inventory[5].EnchantmentLevel(EnchAquaAffinity) > 0
+	}
+	return d
 }
 
-// PlaceBlock place a block in the position and wait
-func (g *Game) PlaceBlock(v3 Vector3, face Face) error {
-	g.SwingHand(true)
-	SendPlayerBlockPlacementPacket(g, v3, face, 0, 0, 0, 0)
+// PlaceBlock place the held block at v3 and wait the server to confirm it
+func (g *Game) PlaceBlock(v3 Vector3) error {
+	return g.PlaceBlockContext(context.Background(), v3)
+}
 
-	return nil
+// PlaceBlockContext place the block held in the main hand, or in the offhand,
+// at v3 by clicking on the face of a solid block next to it.
+// Return an error if the server doesn't place the block within Settings.PlaceTimeout.
+func (g *Game) PlaceBlockContext(ctx context.Context, v3 Vector3) error {
+	var old Block
+	var err error
+	if doErr := g.Do(ctx, func() { old, err = g.clickToPlace(v3) }); doErr != nil {
+		return doErr
+	}
+	if err != nil {
+		return err
+	}
+
+	// wait the Block Change packet
+	changed, err := g.waitBlockChange(ctx, v3, old, g.Settings.PlaceTimeout)
+	if err == nil && !changed {
+		err = fmt.Errorf("block at %v not placed by the server", v3)
+	}
+	return err
+}
+
+// clickToPlace click on the face of a block next to v3 to place the held block there, return the block replaced
+func (g *Game) clickToPlace(v3 Vector3) (Block, error) {
+	if !g.World.IsLoaded(v3) {
+		return Block{}, fmt.Errorf("block at %v is not loaded", v3)
+	}
+	old := g.GetBlock(v3)
+	if !old.IsReplaceable() {
+		return old, fmt.Errorf("%v at %v can't be replaced", old, v3)
+	}
+	hand, ok := g.blockHand()
+	if !ok {
+		return old, fmt.Errorf("no block in hands")
+	}
+	against, face, ok := g.placeAgainst(v3)
+	if !ok {
+		return old, fmt.Errorf("no solid block in reach next to %v", v3)
+	}
+
+	// click on the center of the face
+	cursor := faceCenter(face)
+	if err := g.LookAt(against.Add(cursor)); err != nil {
+		return old, err
+	}
+	if err := SendPlayerBlockPlacementPacket(g, against, face, hand, cursor); err != nil {
+		return old, err
+	}
+	return old, SendAnimationPacket(g, hand)
+}
+
+// blockHand return the hand holding a block, 0: main hand, 1: offhand
+func (g *Game) blockHand() (int32, bool) {
+	p := g.GetPlayer()
+	if slot := 36 + p.HeldItem; slot < len(p.Inventory) && p.Inventory[slot].IsBlock() {
+		return 0, true
+	}
+	if len(p.Inventory) > 45 && p.Inventory[45].IsBlock() { // slot 45 is the offhand
+		return 1, true
+	}
+	return 0, false
+}
+
+// placeAgainst find a solid block next to v3 that the player can reach and see, and its face turned to v3.
+// The block under v3 is preferred, then the sides, then the block above.
+func (g *Game) placeAgainst(v3 Vector3) (against Vector3, face Face, ok bool) {
+	v3 = Vector3{X: math.Floor(v3.X), Y: math.Floor(v3.Y), Z: math.Floor(v3.Z)}
+	eye := g.GetPlayer().EyePosition()
+	for _, f := range [...]Face{Bottom, North, South, West, East, Top} {
+		against = v3.Add(f.Offset())
+		if b := g.GetBlock(against); !b.IsSolid() || b.IsReplaceable() {
+			continue
+		}
+		// the face must be turned to the player
+		center := against.Add(faceCenter(f.Opposite()))
+		if d := eye.Sub(center).Mul(f.Offset()); d.X+d.Y+d.Z >= 0 {
+			continue
+		}
+		r, ok := g.World.RayTraceDirection(eye, center.Sub(eye), g.blockReach())
+		if ok && r.Position == against {
+			return against, f.Opposite(), true
+		}
+	}
+	return Vector3{}, 0, false
+}
+
+// faceCenter return the center of a face, relative to the block
+func faceCenter(f Face) Vector3 {
+	return Vector3{X: 0.5, Y: 0.5, Z: 0.5}.Add(f.Offset().Mul(Vector3{X: 0.5, Y: 0.5, Z: 0.5}))
 }
 
 // Chat send chat message to server
 // such as player send message in chat box
 // msg can not longger than 256
 func (g *Game) Chat(msg string) error {
-	data := pk.PackString(msg)
-	if len(data) > 256 {
+	if len(msg) > 256 {
 		return fmt.Errorf("message too big")
 	}
-
-	pack := pk.Packet{
-		ID:   0x02,
-		Data: data,
-	}
-
-	g.SendChan <- pack
-	return nil
+	return g.Send(&protocol.ChatMessageServerbound{Message: msg})
 }
 
 // GetBlock return the block at (x, y, z)
@@ -363,251 +912,337 @@ func (g *Game) GetBlock(v3 Vector3) Block {
 func (g *Game) GetPlayer() *Player {
 	return &g.Player
 }
-func (g *Game) Attack(e *Entity) {
-	g.LookAt(e.Position)
-	g.SwingHand(true)
-	SendUseEntityPacket(g, e.ID, 1, e.Position)
+
+// Attack look at the entity and hit it.
+// Return an error if the entity is out of reach or behind a block.
+func (g *Game) Attack(e *Entity) error {
+	if err := g.LookAt(e.BoundingBox().Center()); err != nil {
+		return err
+	}
+	hit, _, ok := g.World.RayTraceEntity(g.GetPlayer().EyePosition(), g.GetPlayer().Rotation, g.entityReach())
+	if !ok || hit.ID != e.ID {
+		return fmt.Errorf("entity %d is out of reach", e.ID)
+	}
+	if err := SendUseEntityPacket(g, e.ID, 1, e.Position); err != nil {
+		return err
+	}
+	return g.SwingHand(true)
+}
+
+// Reach distances of the 1.12.2 client, in survival and in creative mode
+const (
+	BlockReach          = 4.5
+	CreativeBlockReach  = 5
+	EntityReach         = 3
+	CreativeEntityReach = 6
+)
+
+func (g *Game) blockReach() float64 {
+	if g.Info.Gamemode == 1 {
+		return CreativeBlockReach
+	}
+	return BlockReach
 }
 
-func (g *Game) Eat() {
+func (g *Game) entityReach() float64 {
+	if g.Info.Gamemode == 1 {
+		return CreativeEntityReach
+	}
+	return EntityReach
+}
+
+func (g *Game) Eat() error {
 	// TODO: Get food slot
-	SendUseItemPacket(g, 1)
+	return SendUseItemPacket(g, 1)
 }
 
-func (g *Game) SetPosition(v3 Vector3) {
+func (g *Game) SetPosition(v3 Vector3) error {
 	g.GetPlayer().SetPosition(v3)
-	SendPlayerPositionPacket(g) // Update the location to the server
+	return SendPlayerPositionPacket(g) // Update the location to the server
 }
 
-func (g *Game) SetRotation(v2 Vector2) {
+func (g *Game) SetRotation(v2 Vector2) error {
 	g.GetPlayer().SetRotation(v2)
-	SendPlayerLookPacket(g) // Update the rotation to the server
+	return SendPlayerLookPacket(g) // Update the rotation to the server
 }
 
-func (g *Game) SetPositionAndRotation(v3 Vector3, v2 Vector2) {
-	g.SetPosition(v3)
-	g.SetRotation(v2)
-	SendPlayerPositionAndLookPacket(g) // Update the location and rotation to the server
+func (g *Game) SetPositionAndRotation(v3 Vector3, v2 Vector2) error {
+	g.GetPlayer().SetPosition(v3)
+	g.GetPlayer().SetRotation(v2)
+	return SendPlayerPositionAndLookPacket(g) // Update the location and rotation to the server
 }
 
+// SetSpawnPosition record the world spawn, where compasses point to.
+// The player doesn't move.
 func (g *Game) SetSpawnPosition(v3 Vector3) {
 	g.Info.SetSpawnPosition(v3)
-	g.SetPosition(v3)
 }
 
 func (g *Game) ClosestEntity(r float64) *Entity {
 	return g.World.ClosestEntity(g.GetPlayer().Position, r)
 }
 
-func (g *Game) WalkTo(x, y, z float64) {
-	g.Motion <- func() {
-		SendPlayerPositionPacket(g) // Update the location to the server
-	}
+// SetControls hold the keys in c, the player moves with them from the next tick
+func (g *Game) SetControls(c Controls) {
+	g.Physics.Controls = c
 }
 
-func (g *Game) WalkToVector(v3 Vector3) {
-	go func() {
-		dir := g.GetPlayer().GetFacing()
-		dist := g.Player.GetPosition().DistanceTo(v3)
-		// The walk speed is 0.2806 blocks per tick
-		path := GeneratePathFromDirection(dir, int(dist), float32(0.2806))
-		initPos := g.GetPlayer().GetPosition()
-		for {
-			if len(path) == 0 {
-				return
-			}
-			// TODO: Check if the block is walkable
-			initPos = initPos.Add(path[0])
-			g.SetPosition(initPos)
-			path = path[1:]
-			time.Sleep(50 * time.Millisecond)
-		}
-	}()
+// ErrBlocked is returned when the player can't move further toward its destination
+var ErrBlocked = errors.New("the way is blocked")
+
+// WalkTo walk in a straight line to v3, see WalkToContext
+func (g *Game) WalkTo(v3 Vector3) error {
+	return g.WalkToContext(context.Background(), v3)
 }
 
-func (g *Game) WalkStraight(dist int) {
-	go func() {
-		dir := g.GetPlayer().GetFacing()
-		// The walk speed is 0.2806 blocks per tick
-		path := GeneratePathFromDirection(dir, dist, float32(0.2806))
-		for {
-			if len(path) == 0 {
-				return
+// WalkToContext walk in a straight line to v3, turning toward it each tick.
+// The player jumps over the blocks in the way, and keeps sneaking or sprinting if it was.
+// Return nil once the player stands within 0.2 block of v3 at its height,
+// an error wrapping ErrBlocked if the player is stopped for a second or lands at another height,
+// and ctx.Err() if ctx is done first. The player stops in all cases.
+// HandleGame must be running to move the player.
+func (g *Game) WalkToContext(ctx context.Context, v3 Vector3) error {
+	return g.walkTo(ctx, v3, false)
+}
+
+func (g *Game) walkTo(ctx context.Context, v3 Vector3, anyY bool) error {
+	var held Controls // the keys kept after
+	started, stuck := false, 0
+	return g.runTicks(ctx, func() (bool, error) {
+		if !started {
+			held = Controls{Sneak: g.Physics.Sneak, Sprint: g.Physics.Sprint}
+			started = true
+		}
+		p := g.GetPlayer()
+		dx, dz := v3.X-p.Position.X, v3.Z-p.Position.Z
+		dist := math.Sqrt(dx*dx + dz*dz)
+		c := held
+		if dist < 0.2 {
+			if p.OnGround || g.Physics.InWater || g.Physics.OnLadder {
+				g.SetControls(held) // release the other keys
+				if anyY || math.Floor(p.Position.Y) == math.Floor(v3.Y) {
+					return true, nil
+				}
+				return true, fmt.Errorf("walk to %v: stopped at %v: %w", v3, p.Position, ErrBlocked)
+			}
+		} else {
+			if g.Physics.CollidedHorizontally && !g.Physics.OnLadder {
+				if stuck++; stuck >= 20 {
+					g.SetControls(held)
+					return true, fmt.Errorf("walk to %v: stopped at %v: %w", v3, p.Position, ErrBlocked)
+				}
+				c.Jump = true
+			} else {
+				stuck = 0
 			}
-			// TODO: Check if the block is walkable
-			g.SetPosition(g.GetPlayer().GetPosition().Add(path[0]))
-			path = path[1:]
-			time.Sleep(50 * time.Millisecond)
+			// The rotation is sent at the end of the tick
+			p.SetYaw(float32(-math.Atan2(dx, dz) / math.Pi * 180))
+			c.Forward = math.Min(1, dist)
 		}
-	}()
+		c.Jump = c.Jump || g.Physics.InWater // don't sink
+		g.SetControls(c)
+		return false, nil
+	}, func() {
+		if started {
+			g.SetControls(held)
+		}
+	})
 }
 
-func GeneratePathFromDirection(dir Direction, length int, speed float32) []Vector3 {
-	var path []Vector3
-	for i := 0; i < length; i++ {
-		switch dir {
-		case DNorth:
-			path = append(path, Vector3{X: 0, Y: 0, Z: float64(-speed)})
-		case DSouth:
-			path = append(path, Vector3{X: 0, Y: 0, Z: float64(speed)})
-		case DWest:
-			path = append(path, Vector3{X: float64(-speed), Y: 0, Z: 0})
-		case DEast:
-			path = append(path, Vector3{X: float64(speed), Y: 0, Z: 0})
-		}
+// WalkStraight walk dist blocks in the direction the player is facing.
+// The returned channel receives the result of walkTo once the player arrives or stops.
+func (g *Game) WalkStraight(dist int) <-chan error {
+	v3 := g.GetPlayer().GetPosition()
+	d := float64(dist)
+	switch g.GetPlayer().GetFacing() {
+	case DNorth:
+		v3.Z -= d
+	case DSouth:
+		v3.Z += d
+	case DWest:
+		v3.X -= d
+	case DEast:
+		v3.X += d
 	}
-	return path
+	result := make(chan error, 1)
+	go func() { result <- g.walkTo(context.Background(), v3, true) }()
+	return result
 }
 
 // LookAt method turn player's hand and make it look at a point.
-func (g *Game) LookAt(v3 Vector3) {
-	dx := v3.X - g.GetPlayer().Position.X
-	dy := v3.Y - g.GetPlayer().Position.Y
-	dz := v3.Z - g.GetPlayer().Position.Z
+func (g *Game) LookAt(v3 Vector3) error {
+	eye := g.GetPlayer().EyePosition()
+	dx := v3.X - eye.X
+	dy := v3.Y - eye.Y
+	dz := v3.Z - eye.Z
 	r := math.Sqrt(dx*dx + dy*dy + dz*dz)
 	yaw := -math.Atan2(dx, dz) / math.Pi * 180
 	if yaw < 0 {
 		yaw = 360 + yaw
 	}
 	pitch := -math.Asin(dy/r) / math.Pi * 180
-	g.SetRotation(Vector2{X: float32(yaw), Y: float32(pitch)})
+	return g.SetRotation(Vector2{X: float32(yaw), Y: float32(pitch)})
 }
 
 // LookYawPitch set player's hand to the direct by yaw and pitch.
 // yaw can be [0, 360) and pitch can be (-180, 180).
 // if |pitch|>90 the player's hand will be very strange.
-func (g *Game) LookYawPitch(yaw, pitch float32, onGround bool) {
+func (g *Game) LookYawPitch(yaw, pitch float32, onGround bool) error {
 	rotation := Vector2{
 		X: yaw,
 		Y: pitch,
 	}
-	g.SetRotation(rotation)
+	return g.SetRotation(rotation)
 }
 
 // SwingHand sent when the player's arm swings.
 // if hand is true, swing the main hand
-func (g *Game) SwingHand(hand bool) {
+func (g *Game) SwingHand(hand bool) error {
 	if hand {
-		SendAnimationPacket(g, 0)
-	} else {
-		SendAnimationPacket(g, 1)
+		return SendAnimationPacket(g, 0)
 	}
+	return SendAnimationPacket(g, 1)
 }
 
-// SendAnimationPacket hand could be 0: main hand, 1: offhand
-func SendAnimationPacket(g *Game, hand int32) {
-	data := pk.PackVarInt(hand)
-	g.SendChan <- pk.Packet{
-		ID:   0x1D,
-		Data: data,
+// SelectHotbar hold the item in the slot of the hotbar, from 0 to 8
+func (g *Game) SelectHotbar(slot int) error {
+	if slot == g.Player.HeldItem {
+		return nil
+	}
+	if err := SendHeldItemChangePacket(g, int16(slot)); err != nil {
+		return err
 	}
+	g.Player.HeldItem = slot
+	return nil
 }
 
-func SendClientStatusPacket(g *Game, status int32) {
-	data := pk.PackVarInt(status)
-	g.SendChan <- pk.Packet{
-		ID:   0x03,
-		Data: data,
-	}
+// SendAnimationPacket hand could be 0: main hand, 1: offhand
+func SendAnimationPacket(g *Game, hand int32) error {
+	return g.Send(&protocol.AnimationServerbound{Hand: hand})
 }
 
-func SendKeepAlivePacket(g *Game, KeepAliveID int64) {
-	g.SendChan <- pk.Packet{
-		ID:   0x0B,
-		Data: pk.PackUint64(uint64(KeepAliveID)),
-	}
+func SendHeldItemChangePacket(g *Game, slot int16) error {
+	return g.Send(&protocol.HeldItemChangeServerbound{Slot: slot})
 }
 
-func SendPlayerBlockPlacementPacket(g *Game, v3 Vector3, face Face, i int, i2, i3, i4 float32) {
-	var data []byte
-	data = append(data, pk.PackPosition(v3)...)
-	data = append(data, pk.PackVarInt(int32(face))...)
-	data = append(data, pk.PackVarInt(int32(i))...)
-	data = append(data, pk.PackFloat(i2)...)
-	data = append(data, pk.PackFloat(i3)...)
-	data = append(data, pk.PackFloat(i4)...)
-	g.SendChan <- pk.Packet{
-		ID:   0x1F,
-		Data: data,
-	}
+func SendClientStatusPacket(g *Game, status int32) error {
+	return g.Send(&protocol.ClientStatus{ActionID: status})
+}
+
+func SendKeepAlivePacket(g *Game, KeepAliveID int64) error {
+	return g.Send(&protocol.KeepAliveServerbound{KeepAliveID: KeepAliveID})
 }
 
-func SendPlayerDiggingPacket(g *Game, status int32, v3 Vector3, face Face) {
-	data := pk.PackVarInt(status)
-	data = append(data, pk.PackPosition(v3)...)
-	data = append(data, byte(face))
+// SendPlayerBlockPlacementPacket click on a face of the block at v3.
+// hand could be 0: main hand, 1: offhand and cursor is the position clicked on the face, relative to the block
+func SendPlayerBlockPlacementPacket(g *Game, v3 Vector3, face Face, hand int32, cursor Vector3) error {
+	return g.Send(&protocol.PlayerBlockPlacement{
+		Location: v3,
+		Face:     int32(face),
+		Hand:     hand,
+		CursorX:  float32(cursor.X),
+		CursorY:  float32(cursor.Y),
+		CursorZ:  float32(cursor.Z),
+	})
+}
+
+func SendPlayerDiggingPacket(g *Game, status int32, v3 Vector3, face Face) error {
+	return g.Send(&protocol.PlayerDigging{Status: status, Location: v3, Face: int8(face)})
+}
 
-	g.SendChan <- pk.Packet{
-		ID:   0x14,
-		Data: data,
+// SendClickWindowPacket click a slot of a window, clicked is the item in the slot before the click.
+// The action number is confirmed by the server with a Confirm Transaction packet.
+func SendClickWindowPacket(g *Game, windowID byte, slot int16, button int8, action int16, mode int32, clicked Slot) error {
+	item, err := slotData(clicked)
+	if err != nil {
+		return err
 	}
+	return g.Send(&protocol.ClickWindow{
+		WindowID:     windowID,
+		Slot:         slot,
+		Button:       button,
+		ActionNumber: action,
+		Mode:         mode,
+		ClickedItem:  item,
+	})
 }
 
-func SendPlayerPositionAndLookPacket(g *Game) {
-	var data []byte
-	data = append(data, pk.PackPosition(g.GetPlayer().Position)...)
-	data = append(data, pk.PackRotation(g.GetPlayer().Rotation)...)
-	data = append(data, pk.PackBoolean(g.GetPlayer().OnGround))
-	g.SendChan <- pk.Packet{
-		ID:   0x0E,
-		Data: data,
+// SendCreativeInventoryActionPacket set a slot of the inventory in creative mode, an empty item clears it
+func SendCreativeInventoryActionPacket(g *Game, slot int16, item Slot) error {
+	data, err := slotData(item)
+	if err != nil {
+		return err
 	}
+	return g.Send(&protocol.CreativeInventoryAction{Slot: slot, ClickedItem: data})
+}
+
+// SendEntityActionPacket send an action of the player, like protocol.ActionStartSprinting
+func SendEntityActionPacket(g *Game, action int32) error {
+	return g.Send(&protocol.EntityAction{EntityID: g.Player.ID, ActionID: action})
 }
-func SendPlayerLookPacket(g *Game) {
-	var data []byte
-	data = append(data, pk.PackRotation(g.GetPlayer().Rotation)...)
-	data = append(data, pk.PackBoolean(g.GetPlayer().OnGround))
-	g.SendChan <- pk.Packet{
-		ID:   0x0F,
-		Data: data,
+
+func SendPlayerPositionAndLookPacket(g *Game) error {
+	p := g.GetPlayer()
+	if err := g.Send(&protocol.PlayerPositionAndLookServerbound{Position: p.Position, Yaw: p.Rotation.X, Pitch: p.Rotation.Y, OnGround: p.OnGround}); err != nil {
+		return err
 	}
+	g.sentPosition, g.sentRotation, g.sentOnGround, g.moveTicks = p.Position, p.Rotation, p.OnGround, 0
+	return nil
 }
 
-func SendPlayerPositionPacket(g *Game) {
-	var data []byte
-	data = append(data, pk.PackPosition(g.Player.Position)...)
-	data = append(data, pk.PackBoolean(g.Player.OnGround))
+func SendPlayerLookPacket(g *Game) error {
+	p := g.GetPlayer()
+	if err := g.Send(&protocol.PlayerLook{Yaw: p.Rotation.X, Pitch: p.Rotation.Y, OnGround: p.OnGround}); err != nil {
+		return err
+	}
+	g.sentRotation, g.sentOnGround = p.Rotation, p.OnGround
+	return nil
+}
 
-	g.SendChan <- pk.Packet{
-		ID:   0x0D,
-		Data: data,
+func SendPlayerPositionPacket(g *Game) error {
+	p := g.GetPlayer()
+	if err := g.Send(&protocol.PlayerPosition{Position: p.Position, OnGround: p.OnGround}); err != nil {
+		return err
 	}
+	g.sentPosition, g.sentOnGround, g.moveTicks = p.Position, p.OnGround, 0
+	return nil
 }
 
-func SendTeleportConfirmPacket(g *Game, TeleportID int32) {
-	g.SendChan <- pk.Packet{
-		ID:   0x00,
-		Data: pk.PackVarInt(TeleportID),
+// SendPlayerPacket only send if the player is on ground
+func SendPlayerPacket(g *Game) error {
+	if err := g.Send(&protocol.Player{OnGround: g.Player.OnGround}); err != nil {
+		return err
 	}
+	g.sentOnGround = g.Player.OnGround
+	return nil
+}
+
+func SendTeleportConfirmPacket(g *Game, TeleportID int32) error {
+	return g.Send(&protocol.TeleportConfirm{TeleportID: TeleportID})
 }
 
-func UpdateVelocity(g *Game, entityID int32, velocity Vector3) {
+func UpdateVelocity(g *Game, entityID int32, velocity Vector3) error {
 	e := g.World.Entities[entityID]
 	if e != nil {
-		fmt.Println("UpdateVelocity", entityID, velocity)
 		e.SetPosition(e.Position.Add(velocity), true)
-		SendPlayerPositionPacket(g)
+		return SendPlayerPositionPacket(g)
 	}
+	return nil
 }
 
-func SendUseEntityPacket(g *Game, TargetEntityID int32, Type int32, Pos Vector3) {
-	data := pk.PackVarInt(TargetEntityID)
-	data = append(data, pk.PackVarInt(Type)...)
-	if Type == 2 {
-		data = append(data, pk.PackPosition(Pos)...)
-	}
-	g.SendChan <- pk.Packet{
-		ID:   0x0A,
-		Data: data,
-	}
+// SendUseEntityPacket interact with (Type 0), attack (1) or interact at Pos on (2) the entity, with the main hand
+func SendUseEntityPacket(g *Game, TargetEntityID int32, Type int32, Pos Vector3) error {
+	return g.Send(&protocol.UseEntity{
+		Target:  TargetEntityID,
+		Type:    Type,
+		TargetX: float32(Pos.X),
+		TargetY: float32(Pos.Y),
+		TargetZ: float32(Pos.Z),
+	})
 }
 
-func SendUseItemPacket(g *Game, hand int32) {
-	data := pk.PackVarInt(hand)
-	g.SendChan <- pk.Packet{
-		ID:   0x20,
-		Data: data,
-	}
+func SendUseItemPacket(g *Game, hand int32) error {
+	return g.Send(&protocol.UseItem{Hand: hand})
 }
 
 // ********** Handler ********** //
@@ -621,432 +1256,342 @@ func SendUseItemPacket(g *Game, hand int32) {
 //								 //
 // ***************************** //
 
-func HandleChatMessagePacket(g *Game, r *bytes.Reader) error {
-
-	s, err := pk.UnpackString(r)
-	if err != nil {
-		return err
-	}
-	pos, err := r.ReadByte()
-	if err != nil {
-		return err
-	}
-	cm, err := NewChatMsg([]byte(s))
+func HandleChatMessagePacket(g *Game, p *protocol.ChatMessageClientbound) error {
+	cm, err := NewChatMsg([]byte(p.JSONData))
 	if err != nil {
 		return err
 	}
 	sender, content := ExtractContent(cm.String())
 	raw := fmt.Sprintf("%s%s", sender, content)
 	timestamp := time.Now().UnixMilli()
-	g.Events <- ChatMessageEvent{Content: content, Sender: sender, RawString: RawString(raw), Timestamp: timestamp, Position: pos}
+	g.Events.Publish(ChatMessageEvent{Content: content, Sender: sender, RawString: RawString(raw), Timestamp: timestamp, Position: byte(p.Position)})
 	return nil
 }
 
-func HandleChunkDataPacket(g *Game, p *pk.Packet) error {
+func HandleChunkDataPacket(g *Game, p *protocol.ChunkData) error {
 	if !g.Settings.ReciveMap {
 		return nil
 	}
-	// TODO
-	/*c, x, y, err := UnpackChunkDataPacket(p, g.Info.Dimension == 0)
-	g.World.Chunks[ChunkLoc{X: x, Y: y}] = c*/
+	// Only the overworld sends sky light
+	c, err := UnpackChunkData(p, g.Info.Dimension == 0)
+	if err != nil {
+		return fmt.Errorf("unpack chunk data fail: %v", err)
+	}
+	g.World.LoadChunk(ChunkPos{p.ChunkX, p.ChunkZ}, c, p.PrimaryBitMask, p.GroundUpContinuous)
+	g.Events.Publish(BlockChangeEvent{})
+
+	if g.Settings.MaxColumns > 0 {
+		center := ChunkPosOf(g.Player.Position)
+		for _, pos := range g.World.Evict(center, g.Settings.ViewDistance, g.Settings.MaxColumns) {
+			g.Events.Publish(ChunkUnloadEvent{Pos: pos})
+		}
+	}
 	return nil
 }
 
-func handleDeclareRecipesPacket(g *Game, r *bytes.Reader) {
-	/*
-		NumRecipes, index := pk.UnpackVarInt(r.D)
-		for i := 0; i < int(NumRecipes); i++ {
-			RecipeID, len := pk.UnpackString(p.Data[index:])
-		 	index += len
-		 	Type, len := pk.UnpackString(p.Data[index:])
-		 	index += len
-		 	switch Type {
-		 	case "crafting_shapeless":
-		 	}
-		 }*/
+func HandleUnloadChunkPacket(g *Game, p *protocol.UnloadChunk) error {
+	pos := ChunkPos{p.ChunkX, p.ChunkZ}
+	_, loaded := g.World.Columns[pos]
+	g.World.UnloadChunk(pos) // the entities in it are forgotten even if the column wasn't loaded
+	if loaded {
+		g.Events.Publish(ChunkUnloadEvent{Pos: pos})
+	}
+	return nil
 }
 
-func HandleEntityHeadLook(g *Game, reader *bytes.Reader) error {
-	entityID, err := pk.UnpackVarInt(reader)
-	if err != nil {
-		return err
-	}
-	yaw, err := reader.ReadByte()
-	if err != nil {
-		return err
-	}
-	e := g.World.Entities[entityID]
-	if e != nil {
-		e.SetYaw(float32(yaw) * 360 / 256)
+func HandleRespawnPacket(g *Game, p *protocol.Respawn) error {
+	if int(p.Dimension) != g.Info.Dimension {
+		// The server will send the chunks of the new dimension
+		g.World.UnloadAll()
 	}
+	g.Info.Dimension = int(p.Dimension)
+	g.Info.Difficulty = int(p.Difficulty)
+	g.Info.Gamemode = int(p.Gamemode & 0x7)
+	g.Info.LevelType = p.LevelType
 	return nil
 }
 
-func HandleEntityVelocity(g *Game, reader *bytes.Reader) error {
-	/*entityID, err := pk.UnpackVarInt(reader)
-	if err != nil {
-		return err
-	}
-	velX, err := pk.UnpackInt16(reader)
-	if err != nil {
-		return err
-	}
-	velY, err := pk.UnpackInt16(reader)
-	if err != nil {
-		return err
+func HandleEntityHeadLook(g *Game, p *protocol.EntityHeadLook) error {
+	e := g.World.Entities[p.EntityID]
+	if e != nil {
+		e.SetYaw(p.HeadYaw)
 	}
-	velZ, err := pk.UnpackInt16(reader)
-	if err != nil {
-		return err
-	}*/
-	// TODO: Check if entity is player
-
-	// To update player velocity, we need to a loop and wait 1 tick each time and update the velocity both on the client and server
-	// If we don't wait for the server to send the packet to the client, the player will be teleported back to the original position or the player will rubberband
-
 	return nil
 }
 
-func HandleEntityRelativeMove(g *Game, reader *bytes.Reader) error {
-	entityID, err := pk.UnpackVarInt(reader)
-	if err != nil {
-		fmt.Printf("Error: %v\n", err)
-		return err
-	}
-	entity := g.World.Entities[entityID]
-	if entity != nil {
-		previous := entity.Position
-		deltaX, err := pk.UnpackInt16(reader)
-		if err != nil {
-			return err
-		}
-		deltaY, err := pk.UnpackInt16(reader)
-		if err != nil {
-			return err
-		}
-		deltaZ, err := pk.UnpackInt16(reader)
-		if err != nil {
-			return err
-		}
-		onGround, err := pk.UnpackBoolean(reader)
-		var delta = Vector3{
-			X: float64(deltaX) / 4096,
-			Y: float64(deltaY) / 4096,
-			Z: float64(deltaZ) / 4096,
-		}
-		entity.SetPosition(previous.Add(delta), onGround) // TODO: Fix this cause the position is 1.5 blocks different than the actual position
-		g.Events <- EntityRelativeMoveEvent{
-			EntityID: entityID,
-			Delta:    delta,
-		}
+// HandleEntityVelocity set the velocity of an entity, in 1/8000 of block per tick.
+// The velocity of the player, like a knockback, is then applied by its physics.
+func HandleEntityVelocity(g *Game, p *protocol.EntityVelocity) error {
+	v := Vector3{X: float64(p.Velocity[0]) / 8000, Y: float64(p.Velocity[1]) / 8000, Z: float64(p.Velocity[2]) / 8000}
+	if p.EntityID == g.Player.ID {
+		g.GetPlayer().SetVelocity(v)
+	} else if e := g.World.Entities[p.EntityID]; e != nil {
+		e.SetVelocity(v)
 	}
 	return nil
 }
 
-func HandleHeldItemPacket(g *Game, r *bytes.Reader) error {
-	hi, err := r.ReadByte()
-	if err != nil {
-		return err
-	}
-	g.Player.HeldItem = int(hi)
+func HandleEntityRelativeMove(g *Game, p *protocol.EntityRelativeMove) error {
+	moveEntity(g, p.EntityID, p.Delta, p.OnGround)
 	return nil
 }
 
-func HandleJoinGamePacket(g *Game, r *bytes.Reader) error {
-	eid, err := pk.UnpackInt32(r)
-	if err != nil {
-		return fmt.Errorf("read EntityID fail: %v", err)
-	}
-	g.Info.EntityID = int(eid)
-	gamemode, err := r.ReadByte()
-	if err != nil {
-		return fmt.Errorf("read gamemode fail: %v", err)
+// moveEntity move the entity by delta/4096 blocks
+func moveEntity(g *Game, entityID int32, delta [3]int16, onGround bool) {
+	entity := g.World.Entities[entityID]
+	if entity == nil {
+		return
 	}
-	g.Info.Gamemode = int(gamemode & 0x7)
-	g.Info.Hardcore = gamemode&0x8 != 0
-	dimension, err := pk.UnpackInt32(r)
-	if err != nil {
-		return fmt.Errorf("read dimension fail: %v", err)
+	d := Vector3{
+		X: float64(delta[0]) / 4096,
+		Y: float64(delta[1]) / 4096,
+		Z: float64(delta[2]) / 4096,
 	}
-	g.Info.Dimension = int(dimension)
-	difficulty, err := r.ReadByte()
-	if err != nil {
-		return fmt.Errorf("read difficulty fail: %v", err)
+	entity.SetPosition(entity.Position.Add(d), onGround)
+	g.Events.Publish(EntityRelativeMoveEvent{
+		EntityID: entityID,
+		Delta:    d,
+	})
+}
+
+func HandleEntityEffectPacket(g *Game, p *protocol.EntityEffect) error {
+	if p.EntityID != g.Player.EntityID() { // only the effects of the player are kept
+		return nil
 	}
-	g.Info.Difficulty = int(difficulty)
-	// ignore Max Players
-	_, err = r.ReadByte()
-	if err != nil {
-		return fmt.Errorf("read MaxPlayers fail: %v", err)
+	if g.Player.Effects == nil {
+		g.Player.Effects = make(map[int8]PotionEffect)
 	}
+	g.Player.Effects[p.EffectID] = PotionEffect{ID: p.EffectID, Amplifier: p.Amplifier, Duration: p.Duration}
+	return nil
+}
 
-	g.Info.LevelType, err = pk.UnpackString(r)
-	if err != nil {
-		return fmt.Errorf("read LevelType fail: %v", err)
+func HandleRemoveEntityEffectPacket(g *Game, p *protocol.RemoveEntityEffect) error {
+	if p.EntityID == g.Player.EntityID() {
+		delete(g.Player.Effects, p.EffectID)
 	}
-	rdi, err := r.ReadByte()
-	if err != nil {
-		return fmt.Errorf("read ReducedDebugInfo fail: %v", err)
-	}
-	g.Info.ReducedDebugInfo = rdi != 0x00
 	return nil
 }
 
-func HandleKeepAlivePacket(g *Game, r *bytes.Reader) (err error) {
-	KeepAliveID, err := pk.UnpackInt64(r)
-	SendKeepAlivePacket(g, KeepAliveID)
+func HandleHeldItemPacket(g *Game, p *protocol.HeldItemChangeClientbound) error {
+	g.Player.HeldItem = int(p.Slot)
+	return nil
+}
+
+func HandleJoinGamePacket(g *Game, p *protocol.JoinGame) error {
+	g.Info.EntityID = int(p.EntityID)
+	g.Info.Gamemode = int(p.Gamemode & 0x7)
+	g.Info.Hardcore = p.Gamemode&0x8 != 0
+	g.Info.Dimension = int(p.Dimension)
+	g.Info.Difficulty = int(p.Difficulty)
+	g.Info.LevelType = p.LevelType
+	g.Info.ReducedDebugInfo = p.ReducedDebugInfo
 	return nil
 }
 
-func HandleMultiBlockChangePacket(g *Game, r *bytes.Reader) error {
+func HandleKeepAlivePacket(g *Game, p *protocol.KeepAliveClientbound) error {
+	return SendKeepAlivePacket(g, p.KeepAliveID)
+}
+
+func HandleMultiBlockChangePacket(g *Game, p *protocol.MultiBlockChange) error {
 	if !g.Settings.ReciveMap {
 		return nil
 	}
-	cX, _ := pk.UnpackInt32(r)
-	cY, _ := pk.UnpackInt32(r)
-	v2 := Vector2{
-		X: float32(cX),
-		Y: float32(cY),
-	}
-	rCount, _ := pk.UnpackVarInt(r)
-	for i := 0; i < int(rCount); i++ {
-		relX, _ := r.ReadByte()
-		relY, _ := r.ReadByte()
-		relZ, _ := r.ReadByte()
+	for _, r := range p.Records {
 		v3 := Vector3{
-			X: float64(relX),
-			Y: float64(relY),
-			Z: float64(relZ),
-		}
-		blockID, _ := pk.UnpackVarInt(r)
-		g.World.UpdateBlock(v2, v3, blockID)
-	}
-	// TODO
-	/*c := g.World.Chunks[ChunkLoc{X: int(cX), Y: int(cY)}]
-	if c != nil {
-		RecordCount, err := pk.UnpackVarInt(r)
-		if err != nil {
-			return err
+			X: float64(p.ChunkX<<4 | int32(r.X)),
+			Y: float64(r.Y),
+			Z: float64(p.ChunkZ<<4 | int32(r.Z)),
 		}
+		g.World.UpdateBlock(v3, r.BlockID)
+	}
+	g.Events.Publish(BlockChangeEvent{})
+	return nil
+}
 
-		for i := int32(0); i < RecordCount; i++ {
-			xz, err := r.ReadByte()
-			if err != nil {
-				return err
-			}
-			y, err := r.ReadByte()
-			if err != nil {
-				return err
-			}
-			BlockID, err := pk.UnpackVarInt(r)
-			if err != nil {
-				return err
-			}
-			x, z := xz>>4, xz&0x0F
-
-			c.Sections[y/16].Blocks[x][y%16][z] = Block{Id: uint(BlockID)}
-		}
-	}*/
-
+func HandlePlayerAbilitiesPacket(g *Game, p *protocol.PlayerAbilitiesClientbound) error {
+	g.Abilities.Flags = p.Flags
+	g.Abilities.FlyingSpeed = p.FlyingSpeed
+	g.Abilities.FieldOfViewModifier = p.FieldOfViewModifier
 	return nil
 }
 
-func HandlePlayerAbilitiesPacket(g *Game, r *bytes.Reader) error {
-	f, err := r.ReadByte()
-	if err != nil {
+func HandlePlayerPositionAndLookPacket(g *Game, pack *protocol.PlayerPositionAndLookClientbound) error {
+	// Each bit of flags makes a field relative to the current value
+	p := g.GetPlayer()
+	pos := pack.Position
+	rot := Vector2{X: pack.Yaw, Y: pack.Pitch}
+	flags := pack.Flags
+	v := p.Velocity
+	if flags&0x01 != 0 {
+		pos.X += p.Position.X
+	} else {
+		v.X = 0
+	}
+	if flags&0x02 != 0 {
+		pos.Y += p.Position.Y
+	} else {
+		v.Y = 0
+	}
+	if flags&0x04 != 0 {
+		pos.Z += p.Position.Z
+	} else {
+		v.Z = 0
+	}
+	if flags&0x08 != 0 { // yaw
+		rot.X += p.Rotation.X
+	}
+	if flags&0x10 != 0 { // pitch
+		rot.Y += p.Rotation.Y
+	}
+	p.Entity.SetPosition(pos, false)
+	p.Entity.SetRotation(rot, false)
+	p.SetVelocity(v)
+	if err := SendTeleportConfirmPacket(g, pack.TeleportID); err != nil {
 		return err
 	}
-	g.Abilities.Flags = int8(f)
-	g.Abilities.FlyingSpeed, err = pk.UnpackFloat(r)
-	if err != nil {
+	if err := SendPlayerPositionAndLookPacket(g); err != nil {
 		return err
 	}
-	g.Abilities.FieldOfViewModifier, err = pk.UnpackFloat(r)
-	return err
-}
-
-func HandlePlayerPositionAndLookPacket(g *Game, r *bytes.Reader) error {
-	x, _ := pk.UnpackDouble(r)
-	y, _ := pk.UnpackDouble(r)
-	z, _ := pk.UnpackDouble(r)
-	yaw, _ := pk.UnpackFloat(r)
-	pitch, _ := pk.UnpackFloat(r)
-	_, _ = r.ReadByte() // TODO: Flags
-	TeleportID, _ := pk.UnpackVarInt(r)
-	pos := Vector3{X: x, Y: y, Z: z}
-	rot := Vector2{X: yaw, Y: pitch}
-	g.SetPosition(pos)
-	g.SetRotation(rot)
-	SendTeleportConfirmPacket(g, TeleportID)
+	g.spawned = true
 	return nil
 }
 
-func HandleEntityLookAndRelativeMove(g *Game, r *bytes.Reader) error {
-	ID, err := pk.UnpackVarInt(r)
-	if err != nil {
-		return err
-	}
-	e := g.World.Entities[ID]
+func HandleEntityLookAndRelativeMove(g *Game, p *protocol.EntityLookAndRelativeMove) error {
+	e := g.World.Entities[p.EntityID]
 	if e != nil {
-		yaw, err := r.ReadByte()
-		pitch, err := r.ReadByte()
-		onGround, err := pk.UnpackBoolean(r)
-		v2 := Vector2{
-			X: float32(yaw) / 256 * 360,
-			Y: float32(pitch) / 256 * 360,
-		}
-		e.SetRotation(v2, onGround)
-
-		err = HandleEntityRelativeMove(g, r)
-		if err != nil {
-			return err
-		}
-	} else {
-		return err
+		e.SetRotation(Vector2{X: p.Yaw, Y: p.Pitch}, p.OnGround)
 	}
+	moveEntity(g, p.EntityID, p.Delta, p.OnGround)
 	return nil
 }
 
-/*func HandleEntityHeadLookPacket(g *Game, r *bytes.Reader) {
-	ID, _ := pk.UnpackVarInt(r)
-	yaw, _ := r.ReadByte()
-	e := g.World.Entities[ID]
-	if e != nil {
-
+// slotOf return the item of a slot sent by the server
+func slotOf(s protocol.Slot) Slot {
+	if s.ID == -1 {
+		return Slot{}
 	}
-}*/
+	// a tag which can't be read is kept raw in the item, it doesn't stop the game
+	item, _ := NewItemStack(int(s.ID), byte(s.Count), s.Damage, s.NBT)
+	return item
+}
 
-func HandleSetSlotPacket(g *Game, r *bytes.Reader) error {
-	windowID, err := r.ReadByte()
-	if err != nil {
-		return err
+// slotData return the slot sent to the server for an item, the reverse of slotOf
+func slotData(s Slot) (protocol.Slot, error) {
+	if s.ID == 0 || s.Count == 0 {
+		return protocol.Slot{ID: -1}, nil
 	}
-	slot, err := pk.UnpackInt16(r)
+	tag, err := s.Tag()
 	if err != nil {
-		return err
-	}
-	slotData, err := pk.UnpackSlot(r)
-	if err != nil {
-		return err
+		return protocol.Slot{}, err
 	}
+	return protocol.Slot{ID: int16(s.ID), Count: int8(s.Count), Damage: s.Damage, NBT: tag}, nil
+}
 
-	switch int8(windowID) {
-	case 0:
-		if slot < 32 || slot > 44 {
-			return fmt.Errorf("slot out of range")
+func HandleSetSlotPacket(g *Game, p *protocol.SetSlot) error {
+	switch p.WindowID {
+	case 0, -2: // the inventory of the player
+		if p.Slot < 0 || int(p.Slot) >= len(g.Player.Inventory) {
+			return nil // the inventory isn't received yet
 		}
-		fallthrough
-	case -2:
-		g.Player.Inventory[slot] = slotData
-		g.Events <- InventoryChangeEvent(slot)
+		g.Player.Inventory[p.Slot] = slotOf(p.SlotData)
+		g.Events.Publish(InventoryChangeEvent(p.Slot))
 	}
 	return nil
 }
 
-func HandleSoundEffect(g *Game, r *bytes.Reader) error {
-	SoundID, _ := pk.UnpackVarInt(r)
-	SoundCategory, _ := pk.UnpackVarInt(r)
-	x, _ := pk.UnpackInt32(r)
-	y, _ := pk.UnpackInt32(r)
-	z, _ := pk.UnpackInt32(r)
-	Volume, _ := pk.UnpackFloat(r)
-	Pitch, _ := pk.UnpackFloat(r)
-	g.Events <- SoundEffectEvent{Sound: SoundID, Category: SoundCategory, X: float64(x) / 8, Y: float64(y) / 8, Z: float64(z) / 8, Volume: Volume, Pitch: Pitch}
-
+func HandleSoundEffect(g *Game, p *protocol.SoundEffect) error {
+	pos := p.EffectPosition
+	g.Events.Publish(SoundEffectEvent{Sound: p.SoundID, Category: p.SoundCategory, X: float64(pos[0]) / 8, Y: float64(pos[1]) / 8, Z: float64(pos[2]) / 8, Volume: p.Volume, Pitch: p.Pitch})
 	return nil
 }
 
-func HandleSpawnPlayerPacket(g *Game, r *bytes.Reader) (err error) {
+func HandleSpawnPlayerPacket(g *Game, p *protocol.SpawnPlayer) error {
 	np := new(Player)
-	np.ID, err = pk.UnpackVarInt(r)
-	np.UUID[0], err = pk.UnpackInt64(r)
-	np.UUID[1], err = pk.UnpackInt64(r)
-	x, err := pk.UnpackDouble(r)
-	y, err := pk.UnpackDouble(r)
-	z, err := pk.UnpackDouble(r)
-	np.SetPosition(Vector3{
-		X: x,
-		Y: y,
-		Z: z,
-	})
-	yaw, err := pk.UnpackAngle(r)
-	pitch, err := pk.UnpackAngle(r)
-	np.SetRotation(Vector2{
-		X: yaw,
-		Y: pitch,
-	})
-
+	np.ID = p.EntityID
+	np.UUID = p.PlayerUUID
+	np.SetPosition(p.Position)
+	np.SetRotation(Vector2{X: p.Yaw, Y: p.Pitch})
+	np.Width, np.Height = 0.6, 1.8
+	applyMetadata(&np.Entity, p.Metadata)
 	g.World.Entities[np.ID] = &np.Entity // Add the player to the world entities
 	return nil
 }
 
-func HandleSpawnPositionPacket(g *Game, r *bytes.Reader) error {
-	v3, err := pk.UnpackPosition(r)
-	if err != nil {
-		return err
+func HandleSpawnMob(g *Game, p *protocol.SpawnMob) {
+	e := &Entity{
+		ID:       p.EntityID,
+		UUID:     p.EntityUUID,
+		Type:     byte(p.Type),
+		Position: p.Position,
+		Rotation: Vector2{X: p.Yaw, Y: p.Pitch},
+		Velocity: Vector3{X: float64(p.Velocity[0]) / 8000, Y: float64(p.Velocity[1]) / 8000, Z: float64(p.Velocity[2]) / 8000},
+	}
+	if size, ok := MobSizes[e.Type]; ok {
+		e.Width, e.Height = size[0], size[1]
 	}
-	g.SetSpawnPosition(v3)
-	SendPlayerPositionPacket(g)
+	applyMetadata(e, p.Metadata)
+	g.World.Entities[e.ID] = e
+}
+
+func HandleSpawnPositionPacket(g *Game, p *protocol.SpawnPosition) error {
+	g.SetSpawnPosition(p.Location)
 	return nil
 }
 
-func HandleTitle(g *Game, reader *bytes.Reader) error {
-	action, err := pk.UnpackVarInt(reader)
-	if err != nil {
-		return err
-	}
-	switch action {
-	case 0:
-		title, err := pk.UnpackString(reader)
-		if err != nil {
-			return err
-		}
-		g.Events <- TitleEvent{Action: action, Text: title}
+func HandleTitle(g *Game, p *protocol.Title) error {
+	switch p.Action {
+	case protocol.TitleSetTitle:
+		g.Events.Publish(TitleEvent{Action: p.Action, Text: p.Text})
 	}
 	return nil
 }
 
-func HandleUpdateHealthPacket(g *Game, r *bytes.Reader) (err error) {
-	g.Player.Health, err = pk.UnpackFloat(r)
-	g.Player.Food, err = pk.UnpackVarInt(r)
-	g.Player.FoodSaturation, err = pk.UnpackFloat(r)
+func HandleUpdateHealthPacket(g *Game, p *protocol.UpdateHealth) error {
+	g.Player.Health = p.Health
+	g.Player.Food = p.Food
+	g.Player.FoodSaturation = p.FoodSaturation
 	if g.Player.Health < 1 { // Player is dead
-		g.Events <- PlayerDeadEvent{} // Dead event
-		g.SetPositionAndRotation(g.Info.SpawnPosition, g.GetPlayer().Rotation)
-		time.Sleep(time.Duration(rand.Intn(2000)+1000) * time.Millisecond)
-		SendClientStatusPacket(g, 0) // Status 0 means perform respawn
+		g.Events.Publish(PlayerDeadEvent{}) // Dead event
+		if err := g.SetPositionAndRotation(g.Info.SpawnPosition, g.GetPlayer().Rotation); err != nil {
+			return err
+		}
+		// respawn after 1 to 3 seconds
+		wait := rand.Intn(40) + 20
+		g.OnTick(func() bool {
+			if wait--; wait > 0 {
+				return true
+			}
+			// Status 0 means perform respawn, it fails only when HandleGame is stopping
+			return SendClientStatusPacket(g, 0) != nil
+		})
 	}
-	return
+	return nil
 }
 
-func HandleWindowItemsPacket(g *Game, r *bytes.Reader) (err error) {
-	WindowID, err := r.ReadByte()
-	Count, err := pk.UnpackInt16(r)
-	slots := make([]Slot, Count)
-	for i := int16(0); i < Count; i++ {
-		slots[i], err = pk.UnpackSlot(r)
-	}
-	switch WindowID {
+func HandleWindowItemsPacket(g *Game, p *protocol.WindowItems) error {
+	switch p.WindowID {
 	case 0: //is player inventory
+		slots := make([]Slot, len(p.SlotData))
+		for i, s := range p.SlotData {
+			slots[i] = slotOf(s)
+		}
 		g.Player.Inventory = slots
-		g.Events <- InventoryChangeEvent(-2)
+		g.Events.Publish(InventoryChangeEvent(-2))
 	}
 	return nil
 }
 
-func HandleServerDifficultyPacket(g *Game, r *bytes.Reader) error {
-	diff, err := r.ReadByte()
-	if err != nil {
-		return err
-	}
-	g.Info.Difficulty = int(diff)
+func HandleServerDifficultyPacket(g *Game, p *protocol.ServerDifficulty) error {
+	g.Info.Difficulty = int(p.Difficulty)
 	return nil
 }
 
 // TweenLookAt is the Tween version of LookAt
 func TweenLookAt(g *Game, x, y, z float64, t time.Duration) {
-	p := g.GetPlayer()
-	v3 := p.GetPosition()
+	var v3 Vector3
+	if g.Do(context.Background(), func() { v3 = g.GetPlayer().GetPosition() }) != nil {
+		return
+	}
 	x, y, z = x-v3.X, y-v3.Y, z-v3.Z
 
 	r := math.Sqrt(x*x + y*y + z*z)
@@ -1062,111 +1607,59 @@ func TweenLookAt(g *Game, x, y, z float64, t time.Duration) {
 // TweenLook do tween animation at player's head.
 func TweenLook(g *Game, yaw, pitch float32, t time.Duration) {
 	p := g.GetPlayer()
+	var yaw0, pitch0 float32
+	if g.Do(context.Background(), func() { yaw0, pitch0 = p.Rotation.X, p.Rotation.Y }) != nil {
+		return
+	}
 	start := time.Now()
-	yaw0, pitch0 := p.Rotation.X, p.Rotation.Y
 	for {
 		elapsed := time.Since(start)
 		if elapsed > t {
 			break
 		}
-		p.SetRotation(Vector2{
+		rotation := Vector2{
 			X: yaw0 + (yaw-yaw0)*float32(elapsed)/float32(t),
 			Y: pitch0 + (pitch-pitch0)*float32(elapsed)/float32(t),
-		})
-		time.Sleep(50 * time.Millisecond)
-	}
-}
-
-// TweenLineMove allows you smoothly move on plane. You can't move in Y axis
-func TweenLineMove(g *Game, x, z float64) error {
-	p := g.GetPlayer()
-	start := time.Now()
-	v3 := p.GetPosition()
-
-	if similar(v3.X, x) && similar(v3.Z, z) {
-		return nil
-	}
-
-	v3.Y = math.Floor(v3.Y) + 0.5
-	ofstX, ofstZ := x-v3.X, z-v3.Z
-	t := time.Duration(float64(time.Second) * (math.Sqrt(ofstX*ofstX+ofstZ*ofstZ) / 4.2))
-	for {
-		elapsed := time.Since(start)
-		if elapsed > t {
-			break
 		}
-		p.SetPosition(Vector3{
-			X: v3.X + ofstX*float64(elapsed)/float64(t),
-			Y: v3.Y,
-			Z: v3.Z + ofstZ*float64(elapsed)/float64(t),
-		})
-		time.Sleep(50 * time.Millisecond)
+		if g.Do(context.Background(), func() { p.SetRotation(rotation) }) != nil || g.WaitTick(context.Background()) != nil {
+			return
+		}
 	}
-	return nil
 }
 
-func similar(a, b float64) bool {
-	return a-b < 1 && b-a < 1
+// TweenLineMove walk to x, z. You can't move in Y axis
+func TweenLineMove(g *Game, x, z float64) error {
+	return g.walkTo(context.Background(), Vector3{X: x, Z: z}, true)
 }
 
 // TweenJump simulate player jump make no headway
 func (g *Game) TweenJump() {
-	go func() {
-		v3 := g.GetPlayer().GetPosition()
-		start := time.Now()
-		for {
-			elapsed := time.Since(start)
-			if elapsed > 500*time.Millisecond {
-				break
-			}
-			g.SetPosition(Vector3{
-				X: v3.X,
-				Y: v3.Y + 1.25*math.Sin(float64(elapsed)/float64(500*time.Millisecond)*math.Pi),
-				Z: v3.Z,
-			})
-			time.Sleep(50 * time.Millisecond)
-		}
-		g.SetPosition(v3)
-	}()
+	held := 2 // hold the key for two ticks
+	g.OnTick(func() bool {
+		held--
+		g.Physics.Jump = held >= 0
+		return held >= 0
+	})
 }
 
-// TweenJumpTo simulate player jump up a block
-func (g *Game) TweenJumpTo(x, z int) {
-	go func() {
-		p := g.GetPlayer()
-		v3 := p.GetPosition()
-		v3.Y = math.Floor(v3.Y) + 0.5
-		p.SetPosition(v3)
-		start := time.Now()
-		for {
-			elapsed := time.Since(start)
-			if elapsed > 500*time.Millisecond {
-				break
-			}
-			p.SetPosition(Vector3{
-				X: v3.X,
-				Y: v3.Y + 0.5*math.Sin(float64(elapsed)/float64(500*time.Millisecond)*math.Pi),
-				Z: v3.Z,
-			})
-			SendPlayerPositionPacket(g)
-			time.Sleep(10 * time.Millisecond)
-		}
-		v3.X, v3.Z = float64(x)+0.5, float64(z)+0.5
-		p.SetPosition(v3)
-		SendPlayerPositionPacket(g)
-	}()
+// TweenJumpTo simulate player jump up a block.
+// The returned channel receives the result of WalkTo once the player arrives or stops.
+func (g *Game) TweenJumpTo(x, z int) <-chan error {
+	v3 := g.GetPlayer().GetBlockPos().Add(Vector3{Y: 1})
+	result := make(chan error, 1)
+	go func() { result <- g.WalkTo(Vector3{X: float64(x) + 0.5, Y: v3.Y, Z: float64(z) + 0.5}) }()
+	return result
 }
 
+// CalibratePos wait for the player to fall on the ground
 func (g *Game) CalibratePos() {
-	p := g.GetPlayer()
-	v3 := p.GetBlockPos()
-	v3under := p.GetBlockPosUnder()
-	for NonSolid(g.GetBlock(v3under).String()) {
-		v3under.Y--
-		g.SetPosition(v3.Add(Vector3{X: 0.5, Y: 0.5, Z: 0.5}))
-		SendPlayerPositionPacket(g)
-		time.Sleep(time.Millisecond * 50)
-	}
-	g.Player.SetPosition(v3.Add(Vector3{X: 0.5, Y: 1, Z: 0.5}))
-	SendPlayerPositionPacket(g)
+	for i := 0; i < 200; i++ {
+		var onGround bool
+		if g.Do(context.Background(), func() { onGround = g.GetPlayer().OnGround }) != nil || onGround {
+			return
+		}
+		if g.WaitTick(context.Background()) != nil {
+			return
+		}
+	}
 }
@@ -0,0 +1,461 @@
+package _struct
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	. "github.com/edouard127/mc-go-1.12.2/data"
+	. "github.com/edouard127/mc-go-1.12.2/data/World"
+	. "github.com/edouard127/mc-go-1.12.2/data/entities"
+	. "github.com/edouard127/mc-go-1.12.2/maths"
+	pk "github.com/edouard127/mc-go-1.12.2/packet"
+	"github.com/edouard127/mc-go-1.12.2/protocol"
+)
+
+func TestGame_placeAgainst(t *testing.T) {
+	g := &Game{World: World{Columns: make(map[ChunkPos]*Chunk)}}
+	g.Player.Position = Vector3{X: 1.5, Y: 66, Z: 3.5}
+	g.World.LoadChunk(ChunkPos{0, 0}, EmptyChunk(16), 0xFFFF, true)
+	g.World.SetBlock(Vector3{X: 1, Y: 64, Z: 1}, Block{Id: 1})
+	g.World.SetBlock(Vector3{X: 2, Y: 65, Z: 1}, Block{Id: 9}) // water isn't solid
+	g.World.SetBlock(Vector3{X: 3, Y: 65, Z: 1}, Block{Id: 5})
+
+	for _, test := range []struct {
+		v3, against Vector3
+		face        Face
+		ok          bool
+	}{
+		{Vector3{X: 1.5, Y: 65, Z: 1.2}, Vector3{X: 1, Y: 64, Z: 1}, Top, true},
+		{Vector3{X: 1, Y: 63, Z: 1}, Vector3{}, 0, false}, // the bottom of the stone can't be seen
+		{Vector3{X: 2, Y: 64, Z: 1}, Vector3{}, 0, false}, // the player is on the west side
+		{Vector3{X: 1, Y: 64, Z: 2}, Vector3{X: 1, Y: 64, Z: 1}, South, true},
+		{Vector3{X: 2, Y: 65, Z: 1}, Vector3{X: 3, Y: 65, Z: 1}, West, true},
+		{Vector3{X: 8, Y: 65, Z: 8}, Vector3{}, 0, false},
+	} {
+		against, face, ok := g.placeAgainst(test.v3)
+		if against != test.against || face != test.face || ok != test.ok {
+			t.Errorf("placeAgainst(%v) = %v, %v, %v, want %v, %v, %v", test.v3, against, face, ok, test.against, test.face, test.ok)
+		}
+	}
+}
+
+func TestGame_HandleGame(t *testing.T) {
+	newGame := func() (*Game, net.Conn) {
+		client, server := net.Pipe()
+		g := &Game{Conn: client, Receiver: bufio.NewReader(client), Sender: client, Events: NewEventBus()}
+		return g, server
+	}
+
+	// Kicked by the server
+	g, server := newGame()
+	go server.Write((&pk.Packet{ID: 0x1A, Data: pk.PackString(`{"text":"Server closed"}`)}).Pack(-1))
+	var d *DisconnectError
+	if err := g.HandleGame(context.Background()); !errors.As(err, &d) || d.Reason.Text != "Server closed" {
+		t.Errorf("kicked: %v", err)
+	}
+
+	// A packet which can't be read
+	g, server = newGame()
+	go server.Write((&pk.Packet{ID: 0x0B, Data: []byte{1, 2}}).Pack(-1))
+	g.Settings.ReciveMap = true
+	var p *ProtocolError
+	if err := g.HandleGame(context.Background()); !errors.As(err, &p) || p.PacketID != 0x0B {
+		t.Errorf("bad packet: %v", err)
+	}
+
+	// The connection is closed
+	g, server = newGame()
+	server.Close()
+	var n *NetworkError
+	if err := g.HandleGame(context.Background()); !errors.As(err, &n) || n.Op != "recv" {
+		t.Errorf("closed: %v", err)
+	}
+
+	// Cancelled, the connection is closed
+	g, server = newGame()
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := g.HandleGame(ctx); err != context.DeadlineExceeded {
+		t.Errorf("cancelled: %v", err)
+	}
+	if _, err := server.Read(make([]byte, 1)); err != io.EOF {
+		t.Errorf("the connection isn't closed: %v", err)
+	}
+}
+
+func TestGame_tick(t *testing.T) {
+	g := &Game{Events: NewEventBus()}
+	ticks, _ := SubscribeChan[TickEvent](g.Events, 4, PolicyBlock)
+	var order []int
+	g.OnTick(func() bool { order = append(order, 1); return len(order) < 3 })
+	g.OnTick(func() bool { order = append(order, 2); return true })
+
+	waited := make(chan error)
+	go func() { waited <- g.WaitTick(context.Background()) }()
+	time.Sleep(10 * time.Millisecond)
+	g.tick()
+	if err := <-waited; err != nil {
+		t.Errorf("WaitTick: %v", err)
+	}
+	g.tick()
+	g.tick()
+	if want := []int{1, 2, 1, 2, 2}; fmt.Sprint(order) != fmt.Sprint(want) {
+		t.Errorf("tick functions called in order %v, want %v", order, want)
+	}
+	for i := uint64(1); i <= 3; i++ {
+		if e := <-ticks; e.Tick != i {
+			t.Errorf("tick event %d, want %d", e.Tick, i)
+		}
+	}
+
+	go func() { waited <- g.WaitTick(context.Background()) }()
+	time.Sleep(10 * time.Millisecond)
+	g.stop()
+	if err := <-waited; err != ErrStopped {
+		t.Errorf("WaitTick after HandleGame returned: %v", err)
+	}
+}
+
+func TestGame_runTicks(t *testing.T) {
+	g := &Game{Events: NewEventBus()}
+	// cancelled before the ticks run
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := g.WalkToContext(ctx, Vector3{X: 8, Z: 8}); err != context.DeadlineExceeded {
+		t.Fatalf("WalkToContext: %v", err)
+	}
+	g.tick()
+	if len(g.tickFuncs) != 0 {
+		t.Error("the walk is still ticking")
+	}
+}
+
+func TestGame_Do(t *testing.T) {
+	client, server := net.Pipe()
+	g := &Game{Conn: client, Receiver: bufio.NewReader(client), Sender: client, Events: NewEventBus()}
+	ctx, cancel := context.WithCancel(context.Background())
+	handled := make(chan error)
+	go func() { handled <- g.HandleGame(ctx) }()
+
+	// The server changes the health while another goroutine reads it
+	go func() {
+		for i := 1; i <= 100; i++ {
+			data := append(pk.PackFloat(float32(i)), pk.PackVarInt(20)...)
+			data = append(data, pk.PackFloat(5)...)
+			if _, err := server.Write((&pk.Packet{ID: 0x41, Data: data}).Pack(-1)); err != nil {
+				return
+			}
+		}
+	}()
+	for health := float32(0); health < 100; {
+		p, err := g.PlayerSnapshot(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.Health < health || p.Food != 20 && p.Health > 0 {
+			t.Fatalf("health %v after %v, food %d", p.Health, health, p.Food)
+		}
+		health = p.Health
+	}
+
+	cancel()
+	if err := <-handled; err != context.Canceled {
+		t.Errorf("HandleGame: %v", err)
+	}
+	if err := g.Do(context.Background(), func() {}); err != ErrStopped {
+		t.Errorf("Do after HandleGame returned: %v", err)
+	}
+}
+
+func TestGame_OnPacket(t *testing.T) {
+	g := &Game{SendChan: make(chan pk.Packet, 4), Events: NewEventBus()}
+	var kept []int64
+	OnPacket(g, func(p *protocol.KeepAliveClientbound) error {
+		kept = append(kept, p.KeepAliveID)
+		return nil
+	})
+	OnPacket(g, func(p *protocol.ChatMessageClientbound) error {
+		return errors.New("stop")
+	})
+
+	// The keep alive is answered by the game, then passed to the handler
+	if err := HandlePack(g, &pk.Packet{ID: 0x1F, Data: pk.PackUint64(42)}); err != nil {
+		t.Fatal(err)
+	}
+	if p := <-g.SendChan; p.ID != 0x0B || !bytes.Equal(p.Data, pk.PackUint64(42)) {
+		t.Errorf("answer 0x%02X % X", p.ID, p.Data)
+	}
+	if fmt.Sprint(kept) != "[42]" {
+		t.Errorf("handled %v", kept)
+	}
+
+	var perr *ProtocolError
+	err := HandlePack(g, &pk.Packet{ID: 0x0F, Data: protocol.Marshal(&protocol.ChatMessageClientbound{JSONData: `{"text":"hi"}`})})
+	if !errors.As(err, &perr) || perr.PacketID != 0x0F || perr.Err.Error() != "stop" {
+		t.Errorf("error of the handler: %v", err)
+	}
+
+	// Window Items is 0x14 in 1.12.2
+	items := &protocol.WindowItems{SlotData: []protocol.Slot{{ID: -1}, {ID: 1, Count: 64}}}
+	if err := HandlePack(g, &pk.Packet{ID: 0x14, Data: protocol.Marshal(items)}); err != nil {
+		t.Fatal(err)
+	}
+	if want := []Slot{{}, {ID: 1, Count: 64}}; fmt.Sprint(g.Player.Inventory) != fmt.Sprint(want) {
+		t.Errorf("inventory %v, want %v", g.Player.Inventory, want)
+	}
+
+	if err := g.Send(&protocol.KeepAliveClientbound{}); err == nil {
+		t.Error("a clientbound packet is sent")
+	}
+}
+
+func TestHandleEntityMetadata(t *testing.T) {
+	g := &Game{Events: NewEventBus()}
+	g.World.Entities = make(map[int32]*Entity)
+	g.Player.ID = 1
+	events, _ := SubscribeChan[EntityMetadataEvent](g.Events, 1, PolicyBlock)
+
+	spawn := &protocol.SpawnMob{EntityID: 2, Type: MobZombie, Metadata: protocol.Metadata{{Index: 7, Type: protocol.MetaFloat, Value: float32(20)}}}
+	if err := HandlePack(g, &pk.Packet{ID: 0x03, Data: protocol.Marshal(spawn)}); err != nil {
+		t.Fatal(err)
+	}
+	// the metadata of a dropped item: on fire with a stack of stone
+	item := protocol.Metadata{
+		{Index: 0, Type: protocol.MetaByte, Value: byte(FlagOnFire)},
+		{Index: 6, Type: protocol.MetaSlot, Value: protocol.Slot{ID: 1, Count: 3}},
+	}
+	g.World.Entities[3] = &Entity{ID: 3}
+	for _, p := range []*protocol.EntityMetadata{
+		{EntityID: 2, Metadata: protocol.Metadata{
+			{Index: 0, Type: protocol.MetaByte, Value: byte(FlagCrouched | FlagSprinting)},
+			{Index: 2, Type: protocol.MetaString, Value: "Bob"},
+			{Index: 7, Type: protocol.MetaFloat, Value: float32(12.5)},
+		}},
+		{EntityID: 3, Metadata: item},
+		{EntityID: 1, Metadata: protocol.Metadata{{Index: 7, Type: protocol.MetaFloat, Value: float32(4)}}},
+		{EntityID: 4, Metadata: item}, // unknown
+	} {
+		if err := HandlePack(g, &pk.Packet{ID: 0x3C, Data: protocol.Marshal(p)}); err != nil {
+			t.Fatal(err)
+		}
+		if e := <-events; e.EntityID != p.EntityID || len(e.Metadata) != len(p.Metadata) {
+			t.Errorf("event %+v", e)
+		}
+	}
+
+	zombie := g.World.Entities[2]
+	if zombie.Type != MobZombie || zombie.Width != 0.6 || zombie.Height != 1.95 ||
+		zombie.CustomName != "Bob" || zombie.Health != 12.5 ||
+		!zombie.HasFlag(FlagCrouched) || zombie.HasFlag(FlagOnFire) {
+		t.Errorf("mob %+v", zombie)
+	}
+	if e := g.World.Entities[3]; !e.HasFlag(FlagOnFire) || e.Item == nil || e.Item.ID != 1 || e.Item.Count != 3 {
+		t.Errorf("dropped item %+v", e)
+	}
+	if g.Player.Health != 4 {
+		t.Errorf("health of the player %v", g.Player.Health)
+	}
+}
+
+func TestGame_Items(t *testing.T) {
+	g := &Game{SendChan: make(chan pk.Packet, 1), Events: NewEventBus()}
+	pickaxe, err := (Slot{ID: 278, Count: 1, Enchantments: []Enchantment{{ID: EnchEfficiency, Level: 4}}}).Tag()
+	if err != nil {
+		t.Fatal(err)
+	}
+	helmet, _ := (Slot{ID: 310, Count: 1, Enchantments: []Enchantment{{ID: EnchAquaAffinity, Level: 1}}}).Tag()
+	items := make([]protocol.Slot, 46)
+	for i := range items {
+		items[i].ID = -1
+	}
+	items[5] = protocol.Slot{ID: 310, Count: 1, NBT: helmet}
+	items[36] = protocol.Slot{ID: 278, Count: 1, Damage: 7, NBT: pickaxe}
+	if err := HandlePack(g, &pk.Packet{ID: 0x14, Data: protocol.Marshal(&protocol.WindowItems{SlotData: items})}); err != nil {
+		t.Fatal(err)
+	}
+	if d := g.Digger(); d.Efficiency != 4 || !d.AquaAffinity || d.Held != ToolItems[278] {
+		t.Errorf("digger %+v", d)
+	}
+
+	// The item is sent back like it was received
+	if err := SendCreativeInventoryActionPacket(g, 36, g.Player.Inventory[36]); err != nil {
+		t.Fatal(err)
+	}
+	var sent protocol.CreativeInventoryAction
+	if err := protocol.Unmarshal((<-g.SendChan).Data, &sent); err != nil {
+		t.Fatal(err)
+	}
+	if want := (protocol.CreativeInventoryAction{Slot: 36, ClickedItem: items[36]}); !reflect.DeepEqual(sent, want) {
+		t.Errorf("sent %+v, want %+v", sent, want)
+	}
+}
+
+func TestGame_protocol335(t *testing.T) {
+	g := &Game{SendChan: make(chan pk.Packet, 1), Events: NewEventBus(), protocol: protocol.Protocol335}
+	// Keep Alive has the same ID in 1.12 but a VarInt
+	if err := HandlePack(g, &pk.Packet{ID: 0x1F, Data: pk.PackVarInt(300)}); err != nil {
+		t.Fatal(err)
+	}
+	if p := <-g.SendChan; p.ID != 0x0C || !bytes.Equal(p.Data, pk.PackVarInt(300)) {
+		t.Errorf("answer 0x%02X % X", p.ID, p.Data)
+	}
+	if g.ProtocolVersion() != 335 {
+		t.Errorf("version %d", g.ProtocolVersion())
+	}
+}
+
+func TestHandleUnloadChunkPacket(t *testing.T) {
+	g := &Game{Events: NewEventBus()}
+	g.World.Entities = map[int32]*Entity{
+		1: {ID: 1, Position: Vector3{X: 20, Y: 64, Z: 5}}, // in the column 1, 0
+		2: {ID: 2, Position: Vector3{X: -3, Y: 64, Z: 5}}, // in the column -1, 0
+	}
+	g.World.Columns = make(map[ChunkPos]*Chunk)
+	// The column was never loaded, its entities go anyway
+	if err := HandlePack(g, &pk.Packet{ID: 0x1D, Data: protocol.Marshal(&protocol.UnloadChunk{ChunkX: 1})}); err != nil {
+		t.Fatal(err)
+	}
+	if g.World.HasEntity(1) || !g.World.HasEntity(2) {
+		t.Errorf("entities left %v", g.World.Entities)
+	}
+}
+
+func TestGame_waitBlockChange(t *testing.T) {
+	g := &Game{Events: NewEventBus(), World: World{Columns: make(map[ChunkPos]*Chunk)}}
+	v3 := Vector3{X: 1, Y: 64, Z: 1}
+	g.World.LoadChunk(ChunkPos{0, 0}, EmptyChunk(16), 0xFFFF, true)
+	g.World.SetBlock(v3, Block{Id: 1})
+
+	// The column unloads before the Block Change packet
+	waited := make(chan error)
+	go func() {
+		changed, err := g.waitBlockChange(context.Background(), v3, Block{Id: 1}, time.Second)
+		if changed {
+			err = fmt.Errorf("changed")
+		}
+		waited <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	g.World.UnloadChunk(ChunkPos{0, 0})
+	g.tick()
+	if err := <-waited; err == nil {
+		t.Errorf("unloaded block confirmed")
+	}
+}
+
+func TestGame_sprintAndVelocity(t *testing.T) {
+	g := &Game{SendChan: make(chan pk.Packet, 16), Events: NewEventBus(), World: World{Columns: make(map[ChunkPos]*Chunk)}, spawned: true}
+	g.World.LoadChunk(ChunkPos{0, 0}, EmptyChunk(16), 0xFFFF, true)
+	for x := 0; x < 16; x++ {
+		for z := 0; z < 16; z++ {
+			g.World.SetBlock(Vector3{X: float64(x), Y: 63, Z: float64(z)}, Block{Id: 1})
+		}
+	}
+	g.Player.ID, g.Player.Food = 7, 20
+	g.Player.SetPosition(Vector3{X: 8.5, Y: 64, Z: 2.5})
+	g.Player.OnGround = true
+	actions := func() (sent []int32) {
+		for len(g.SendChan) > 0 {
+			p := <-g.SendChan
+			pack, err := protocol.Latest.Decode(protocol.Play, protocol.Serverbound, int32(p.ID), p.Data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if a, ok := pack.(*protocol.EntityAction); ok {
+				if a.EntityID != 7 {
+					t.Errorf("action of the entity %d", a.EntityID)
+				}
+				sent = append(sent, a.ActionID)
+			}
+		}
+		return
+	}
+
+	g.SetControls(Controls{Forward: 1, Sprint: true, Sneak: true})
+	g.tick()
+	g.tick()
+	if got := actions(); fmt.Sprint(got) != fmt.Sprint([]int32{protocol.ActionStartSneaking}) {
+		t.Errorf("sneaking and sprinting: sent %v", got)
+	}
+	g.SetControls(Controls{Forward: 1, Sprint: true})
+	g.tick()
+	g.SetControls(Controls{})
+	g.tick()
+	want := []int32{protocol.ActionStartSprinting, protocol.ActionStopSneaking, protocol.ActionStopSprinting}
+	if got := actions(); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("sprinting then stopping: sent %v, want %v", got, want)
+	}
+
+	// Knocked back upward
+	if err := HandlePack(g, &pk.Packet{ID: 0x3E, Data: protocol.Marshal(&protocol.EntityVelocity{EntityID: 7, Velocity: [3]int16{0, 4000, 0}})}); err != nil {
+		t.Fatal(err)
+	}
+	if v := g.Player.Velocity; v != (Vector3{Y: 0.5}) {
+		t.Fatalf("velocity %v", v)
+	}
+	g.tick()
+	if g.Player.Position.Y != 64.5 || g.Player.OnGround {
+		t.Errorf("knocked back to %v", g.Player.Position)
+	}
+}
+
+func TestHandlePlayerPositionAndLookPacket(t *testing.T) {
+	g := &Game{SendChan: make(chan pk.Packet, 4), Events: NewEventBus()}
+	g.Player.SetPosition(Vector3{X: 8.5, Y: 64, Z: 2.5})
+	g.Player.Rotation = Vector2{X: 90, Y: 10}
+	// relative yaw and pitch
+	if err := HandlePlayerPositionAndLookPacket(g, &protocol.PlayerPositionAndLookClientbound{Position: Vector3{X: 1}, Yaw: 45, Pitch: -5, Flags: 0x08 | 0x10}); err != nil {
+		t.Fatal(err)
+	}
+	if r := g.Player.Rotation; r != (Vector2{X: 135, Y: 5}) {
+		t.Errorf("rotation %v", r)
+	}
+	if p := g.Player.Position; p != (Vector3{X: 1}) {
+		t.Errorf("position %v", p)
+	}
+}
+
+func TestGame_Send(t *testing.T) {
+	g := &Game{}
+	if err := g.Send(&protocol.KeepAliveServerbound{}); err == nil {
+		t.Error("sent before HandleGame")
+	}
+
+	// The writer fails while the game goroutine waits for room in SendChan
+	client, server := net.Pipe()
+	g = &Game{Conn: client, Receiver: bufio.NewReader(client), Sender: client, Events: NewEventBus()}
+	handled := make(chan error)
+	go func() { handled <- g.HandleGame(context.Background()) }()
+	sent := make(chan error, 1)
+	if err := g.Do(context.Background(), func() {
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			server.Close()
+		}()
+		var err error
+		for err == nil { // more than the buffer of SendChan
+			err = SendKeepAlivePacket(g, 42)
+		}
+		sent <- err
+	}); err != nil {
+		t.Fatal(err)
+	}
+	var n *NetworkError
+	if err := <-sent; !errors.As(err, &n) || n.Op != "send" {
+		t.Errorf("send after the writer stopped: %v", err)
+	}
+	if err := <-handled; !errors.As(err, &n) {
+		t.Errorf("HandleGame: %v", err)
+	}
+	if err := SendKeepAlivePacket(g, 42); !errors.Is(err, ErrStopped) && !errors.As(err, &n) {
+		t.Errorf("send after HandleGame returned: %v", err)
+	}
+}
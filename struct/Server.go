@@ -1,6 +1,22 @@
 package _struct
 
+import "encoding/json"
+
 type Server struct {
 	Addr string
 	Port int
 }
+
+// ServerStatus is the answer to a status ping, like in the server list of the game.
+// see JSON format at https://wiki.vg/Server_List_Ping#Response
+type ServerStatus struct {
+	Version struct {
+		Name     string `json:"name"`
+		Protocol int32  `json:"protocol"`
+	} `json:"version"`
+	Players struct {
+		Max    int `json:"max"`
+		Online int `json:"online"`
+	} `json:"players"`
+	Description json.RawMessage `json:"description"` // a chat message
+}
@@ -1,6 +1,9 @@
 package _struct
 
-import pk "github.com/edouard127/mc-go-1.12.2/packet"
+import (
+	"github.com/edouard127/mc-go-1.12.2/protocol"
+	"time"
+)
 
 // Settings 客户端设置
 type Settings struct {
@@ -11,6 +14,10 @@ type Settings struct {
 	DisplayedSkinParts uint8  //皮肤显示
 	MainHand           int    //主手
 	ReciveMap          bool   //接收地图数据
+	MaxColumns         int    //最多保留的区块列数, 0 为不限制 (视距内的区块不会被卸载)
+
+	DigTimeout   time.Duration //挖掘完成后等待服务器确认的最长时间
+	PlaceTimeout time.Duration //放置方块后等待服务器确认的最长时间
 }
 
 /*
@@ -35,15 +42,18 @@ var DefaultSettings = Settings{
 	DisplayedSkinParts: Jacket | LeftSleeve | RightSleeve | LeftPantsLeg | RightPantsLeg | Hat,
 	MainHand:           1,
 	ReciveMap:          true,
+	DigTimeout:         2 * time.Second,
+	PlaceTimeout:       2 * time.Second,
 }
 
-func (s *Settings) Pack() (p *pk.Packet) {
-	p = new(pk.Packet)
-	p.ID = 0x04
-	p.Data = append(p.Data, pk.PackString(s.Locale)...)
-	p.Data = append(p.Data, byte(s.ViewDistance))
-	p.Data = append(p.Data, pk.PackVarInt(int32(s.ChatMode))...)
-	p.Data = append(p.Data, pk.PackBoolean(s.ChatColors), byte(s.DisplayedSkinParts))
-	p.Data = append(p.Data, pk.PackVarInt(int32(s.MainHand))...)
-	return
+// Packet return the Client Settings packet sent to the server
+func (s *Settings) Packet() *protocol.ClientSettings {
+	return &protocol.ClientSettings{
+		Locale:             s.Locale,
+		ViewDistance:       int8(s.ViewDistance),
+		ChatMode:           int32(s.ChatMode),
+		ChatColors:         s.ChatColors,
+		DisplayedSkinParts: s.DisplayedSkinParts,
+		MainHand:           int32(s.MainHand),
+	}
 }